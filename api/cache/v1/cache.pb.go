@@ -23,10 +23,14 @@ const (
 )
 
 type SetStringRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	TtlSeconds    int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Types that are valid to be assigned to Expiry:
+	//
+	//	*SetStringRequest_TtlSeconds
+	//	*SetStringRequest_ExpiresAtUnixMs
+	Expiry        isSetStringRequest_Expiry `protobuf_oneof:"expiry"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -75,13 +79,47 @@ func (x *SetStringRequest) GetValue() string {
 	return ""
 }
 
+func (x *SetStringRequest) GetExpiry() isSetStringRequest_Expiry {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
 func (x *SetStringRequest) GetTtlSeconds() int32 {
 	if x != nil {
-		return x.TtlSeconds
+		if x, ok := x.Expiry.(*SetStringRequest_TtlSeconds); ok {
+			return x.TtlSeconds
+		}
+	}
+	return 0
+}
+
+func (x *SetStringRequest) GetExpiresAtUnixMs() int64 {
+	if x != nil {
+		if x, ok := x.Expiry.(*SetStringRequest_ExpiresAtUnixMs); ok {
+			return x.ExpiresAtUnixMs
+		}
 	}
 	return 0
 }
 
+type isSetStringRequest_Expiry interface {
+	isSetStringRequest_Expiry()
+}
+
+type SetStringRequest_TtlSeconds struct {
+	TtlSeconds int32 `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3,oneof"`
+}
+
+type SetStringRequest_ExpiresAtUnixMs struct {
+	ExpiresAtUnixMs int64 `protobuf:"varint,4,opt,name=expires_at_unix_ms,json=expiresAtUnixMs,proto3,oneof"`
+}
+
+func (*SetStringRequest_TtlSeconds) isSetStringRequest_Expiry() {}
+
+func (*SetStringRequest_ExpiresAtUnixMs) isSetStringRequest_Expiry() {}
+
 type SetStringResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -118,27 +156,29 @@ func (*SetStringResponse) Descriptor() ([]byte, []int) {
 	return file_cache_v1_cache_proto_rawDescGZIP(), []int{1}
 }
 
-type GetStringRequest struct {
+type PSetStringRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TtlMillis     int64                  `protobuf:"varint,3,opt,name=ttl_millis,json=ttlMillis,proto3" json:"ttl_millis,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStringRequest) Reset() {
-	*x = GetStringRequest{}
+func (x *PSetStringRequest) Reset() {
+	*x = PSetStringRequest{}
 	mi := &file_cache_v1_cache_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStringRequest) String() string {
+func (x *PSetStringRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStringRequest) ProtoMessage() {}
+func (*PSetStringRequest) ProtoMessage() {}
 
-func (x *GetStringRequest) ProtoReflect() protoreflect.Message {
+func (x *PSetStringRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cache_v1_cache_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -150,39 +190,52 @@ func (x *GetStringRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStringRequest.ProtoReflect.Descriptor instead.
-func (*GetStringRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PSetStringRequest.ProtoReflect.Descriptor instead.
+func (*PSetStringRequest) Descriptor() ([]byte, []int) {
 	return file_cache_v1_cache_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetStringRequest) GetKey() string {
+func (x *PSetStringRequest) GetKey() string {
 	if x != nil {
 		return x.Key
 	}
 	return ""
 }
 
-type GetStringResponse struct {
+func (x *PSetStringRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PSetStringRequest) GetTtlMillis() int64 {
+	if x != nil {
+		return x.TtlMillis
+	}
+	return 0
+}
+
+type PSetStringResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStringResponse) Reset() {
-	*x = GetStringResponse{}
+func (x *PSetStringResponse) Reset() {
+	*x = PSetStringResponse{}
 	mi := &file_cache_v1_cache_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStringResponse) String() string {
+func (x *PSetStringResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStringResponse) ProtoMessage() {}
+func (*PSetStringResponse) ProtoMessage() {}
 
-func (x *GetStringResponse) ProtoReflect() protoreflect.Message {
+func (x *PSetStringResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cache_v1_cache_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -194,39 +247,34 @@ func (x *GetStringResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStringResponse.ProtoReflect.Descriptor instead.
-func (*GetStringResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PSetStringResponse.ProtoReflect.Descriptor instead.
+func (*PSetStringResponse) Descriptor() ([]byte, []int) {
 	return file_cache_v1_cache_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetStringResponse) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
-type DelStringRequest struct {
+type SetStringNXRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DelStringRequest) Reset() {
-	*x = DelStringRequest{}
+func (x *SetStringNXRequest) Reset() {
+	*x = SetStringNXRequest{}
 	mi := &file_cache_v1_cache_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DelStringRequest) String() string {
+func (x *SetStringNXRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelStringRequest) ProtoMessage() {}
+func (*SetStringNXRequest) ProtoMessage() {}
 
-func (x *DelStringRequest) ProtoReflect() protoreflect.Message {
+func (x *SetStringNXRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cache_v1_cache_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -238,38 +286,53 @@ func (x *DelStringRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelStringRequest.ProtoReflect.Descriptor instead.
-func (*DelStringRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetStringNXRequest.ProtoReflect.Descriptor instead.
+func (*SetStringNXRequest) Descriptor() ([]byte, []int) {
 	return file_cache_v1_cache_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DelStringRequest) GetKey() string {
+func (x *SetStringNXRequest) GetKey() string {
 	if x != nil {
 		return x.Key
 	}
 	return ""
 }
 
-type DelStringResponse struct {
+func (x *SetStringNXRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SetStringNXRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type SetStringNXResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DelStringResponse) Reset() {
-	*x = DelStringResponse{}
+func (x *SetStringNXResponse) Reset() {
+	*x = SetStringNXResponse{}
 	mi := &file_cache_v1_cache_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DelStringResponse) String() string {
+func (x *SetStringNXResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelStringResponse) ProtoMessage() {}
+func (*SetStringNXResponse) ProtoMessage() {}
 
-func (x *DelStringResponse) ProtoReflect() protoreflect.Message {
+func (x *SetStringNXResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_cache_v1_cache_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -281,73 +344,7653 @@ func (x *DelStringResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelStringResponse.ProtoReflect.Descriptor instead.
-func (*DelStringResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetStringNXResponse.ProtoReflect.Descriptor instead.
+func (*SetStringNXResponse) Descriptor() ([]byte, []int) {
 	return file_cache_v1_cache_proto_rawDescGZIP(), []int{5}
 }
 
-var File_cache_v1_cache_proto protoreflect.FileDescriptor
+func (x *SetStringNXResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
 
-const file_cache_v1_cache_proto_rawDesc = "" +
-	"\n" +
-	"\x14cache/v1/cache.proto\x12\bcache.v1\x1a\x1cgoogle/api/annotations.proto\"[\n" +
-	"\x10SetStringRequest\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1f\n" +
-	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
-	"ttlSeconds\"\x13\n" +
-	"\x11SetStringResponse\"$\n" +
-	"\x10GetStringRequest\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\")\n" +
-	"\x11GetStringResponse\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"$\n" +
-	"\x10DelStringRequest\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\"\x13\n" +
-	"\x11DelStringResponse2\xc3\x02\n" +
-	"\fCacheService\x12g\n" +
-	"\tSetString\x12\x1a.cache.v1.SetStringRequest\x1a\x1b.cache.v1.SetStringResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/cache/string/{key}\x12d\n" +
-	"\tGetString\x12\x1a.cache.v1.GetStringRequest\x1a\x1b.cache.v1.GetStringResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/cache/string/{key}\x12d\n" +
-	"\tDelString\x12\x1a.cache.v1.DelStringRequest\x1a\x1b.cache.v1.DelStringResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/cache/string/{key}B!Z\x1fgocache-service/api/cache/v1;v1b\x06proto3"
+type SetStringIfNewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	EventTimeMs   int64                  `protobuf:"varint,4,opt,name=event_time_ms,json=eventTimeMs,proto3" json:"event_time_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var (
-	file_cache_v1_cache_proto_rawDescOnce sync.Once
-	file_cache_v1_cache_proto_rawDescData []byte
-)
+func (x *SetStringIfNewerRequest) Reset() {
+	*x = SetStringIfNewerRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func file_cache_v1_cache_proto_rawDescGZIP() []byte {
-	file_cache_v1_cache_proto_rawDescOnce.Do(func() {
-		file_cache_v1_cache_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cache_v1_cache_proto_rawDesc), len(file_cache_v1_cache_proto_rawDesc)))
-	})
-	return file_cache_v1_cache_proto_rawDescData
+func (x *SetStringIfNewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_cache_v1_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_cache_v1_cache_proto_goTypes = []any{
-	(*SetStringRequest)(nil),  // 0: cache.v1.SetStringRequest
-	(*SetStringResponse)(nil), // 1: cache.v1.SetStringResponse
-	(*GetStringRequest)(nil),  // 2: cache.v1.GetStringRequest
-	(*GetStringResponse)(nil), // 3: cache.v1.GetStringResponse
-	(*DelStringRequest)(nil),  // 4: cache.v1.DelStringRequest
-	(*DelStringResponse)(nil), // 5: cache.v1.DelStringResponse
+func (*SetStringIfNewerRequest) ProtoMessage() {}
+
+func (x *SetStringIfNewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_cache_v1_cache_proto_depIdxs = []int32{
-	0, // 0: cache.v1.CacheService.SetString:input_type -> cache.v1.SetStringRequest
-	2, // 1: cache.v1.CacheService.GetString:input_type -> cache.v1.GetStringRequest
-	4, // 2: cache.v1.CacheService.DelString:input_type -> cache.v1.DelStringRequest
-	1, // 3: cache.v1.CacheService.SetString:output_type -> cache.v1.SetStringResponse
-	3, // 4: cache.v1.CacheService.GetString:output_type -> cache.v1.GetStringResponse
-	5, // 5: cache.v1.CacheService.DelString:output_type -> cache.v1.DelStringResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Deprecated: Use SetStringIfNewerRequest.ProtoReflect.Descriptor instead.
+func (*SetStringIfNewerRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{6}
 }
 
-func init() { file_cache_v1_cache_proto_init() }
-func file_cache_v1_cache_proto_init() {
-	if File_cache_v1_cache_proto != nil {
-		return
+func (x *SetStringIfNewerRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetStringIfNewerRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SetStringIfNewerRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *SetStringIfNewerRequest) GetEventTimeMs() int64 {
+	if x != nil {
+		return x.EventTimeMs
+	}
+	return 0
+}
+
+type SetStringIfNewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	EventTimeMs   int64                  `protobuf:"varint,2,opt,name=event_time_ms,json=eventTimeMs,proto3" json:"event_time_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStringIfNewerResponse) Reset() {
+	*x = SetStringIfNewerResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStringIfNewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStringIfNewerResponse) ProtoMessage() {}
+
+func (x *SetStringIfNewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStringIfNewerResponse.ProtoReflect.Descriptor instead.
+func (*SetStringIfNewerResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetStringIfNewerResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *SetStringIfNewerResponse) GetEventTimeMs() int64 {
+	if x != nil {
+		return x.EventTimeMs
+	}
+	return 0
+}
+
+type GetStringRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Key             string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TouchTtlSeconds int32                  `protobuf:"varint,2,opt,name=touch_ttl_seconds,json=touchTtlSeconds,proto3" json:"touch_ttl_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetStringRequest) Reset() {
+	*x = GetStringRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStringRequest) ProtoMessage() {}
+
+func (x *GetStringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStringRequest.ProtoReflect.Descriptor instead.
+func (*GetStringRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetStringRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetStringRequest) GetTouchTtlSeconds() int32 {
+	if x != nil {
+		return x.TouchTtlSeconds
+	}
+	return 0
+}
+
+type GetStringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStringResponse) Reset() {
+	*x = GetStringResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStringResponse) ProtoMessage() {}
+
+func (x *GetStringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStringResponse.ProtoReflect.Descriptor instead.
+func (*GetStringResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetStringResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GetStringWithVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStringWithVersionRequest) Reset() {
+	*x = GetStringWithVersionRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStringWithVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStringWithVersionRequest) ProtoMessage() {}
+
+func (x *GetStringWithVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStringWithVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetStringWithVersionRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetStringWithVersionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetStringWithVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Version       uint64                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStringWithVersionResponse) Reset() {
+	*x = GetStringWithVersionResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStringWithVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStringWithVersionResponse) ProtoMessage() {}
+
+func (x *GetStringWithVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStringWithVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetStringWithVersionResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetStringWithVersionResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *GetStringWithVersionResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type SetStringIfVersionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Key             string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value           string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ExpectedVersion uint64                 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	TtlSeconds      int32                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetStringIfVersionRequest) Reset() {
+	*x = SetStringIfVersionRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStringIfVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStringIfVersionRequest) ProtoMessage() {}
+
+func (x *SetStringIfVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStringIfVersionRequest.ProtoReflect.Descriptor instead.
+func (*SetStringIfVersionRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetStringIfVersionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetStringIfVersionRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SetStringIfVersionRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *SetStringIfVersionRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type SetStringIfVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	Version       uint64                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStringIfVersionResponse) Reset() {
+	*x = SetStringIfVersionResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStringIfVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStringIfVersionResponse) ProtoMessage() {}
+
+func (x *SetStringIfVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStringIfVersionResponse.ProtoReflect.Descriptor instead.
+func (*SetStringIfVersionResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetStringIfVersionResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *SetStringIfVersionResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type SetBytesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBytesRequest) Reset() {
+	*x = SetBytesRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBytesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBytesRequest) ProtoMessage() {}
+
+func (x *SetBytesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBytesRequest.ProtoReflect.Descriptor instead.
+func (*SetBytesRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetBytesRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetBytesRequest) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SetBytesRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type SetBytesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBytesResponse) Reset() {
+	*x = SetBytesResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBytesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBytesResponse) ProtoMessage() {}
+
+func (x *SetBytesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBytesResponse.ProtoReflect.Descriptor instead.
+func (*SetBytesResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{15}
+}
+
+type GetBytesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBytesRequest) Reset() {
+	*x = GetBytesRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBytesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBytesRequest) ProtoMessage() {}
+
+func (x *GetBytesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBytesRequest.ProtoReflect.Descriptor instead.
+func (*GetBytesRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBytesRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetBytesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []byte                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBytesResponse) Reset() {
+	*x = GetBytesResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBytesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBytesResponse) ProtoMessage() {}
+
+func (x *GetBytesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBytesResponse.ProtoReflect.Descriptor instead.
+func (*GetBytesResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetBytesResponse) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type DelStringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelStringRequest) Reset() {
+	*x = DelStringRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelStringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelStringRequest) ProtoMessage() {}
+
+func (x *DelStringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelStringRequest.ProtoReflect.Descriptor instead.
+func (*DelStringRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DelStringRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DelStringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelStringResponse) Reset() {
+	*x = DelStringResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelStringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelStringResponse) ProtoMessage() {}
+
+func (x *DelStringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelStringResponse.ProtoReflect.Descriptor instead.
+func (*DelStringResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{19}
+}
+
+type CompareAndDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ExpectedValue string                 `protobuf:"bytes,2,opt,name=expected_value,json=expectedValue,proto3" json:"expected_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareAndDeleteRequest) Reset() {
+	*x = CompareAndDeleteRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareAndDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareAndDeleteRequest) ProtoMessage() {}
+
+func (x *CompareAndDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareAndDeleteRequest.ProtoReflect.Descriptor instead.
+func (*CompareAndDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CompareAndDeleteRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CompareAndDeleteRequest) GetExpectedValue() string {
+	if x != nil {
+		return x.ExpectedValue
+	}
+	return ""
+}
+
+type CompareAndDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareAndDeleteResponse) Reset() {
+	*x = CompareAndDeleteResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareAndDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareAndDeleteResponse) ProtoMessage() {}
+
+func (x *CompareAndDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareAndDeleteResponse.ProtoReflect.Descriptor instead.
+func (*CompareAndDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CompareAndDeleteResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type CompareAndSwapRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Key            string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ExpectedValue  string                 `protobuf:"bytes,2,opt,name=expected_value,json=expectedValue,proto3" json:"expected_value,omitempty"`
+	Value          string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	TtlSeconds     int32                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	MissingAsEmpty bool                   `protobuf:"varint,5,opt,name=missing_as_empty,json=missingAsEmpty,proto3" json:"missing_as_empty,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompareAndSwapRequest) Reset() {
+	*x = CompareAndSwapRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareAndSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareAndSwapRequest) ProtoMessage() {}
+
+func (x *CompareAndSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareAndSwapRequest.ProtoReflect.Descriptor instead.
+func (*CompareAndSwapRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CompareAndSwapRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CompareAndSwapRequest) GetExpectedValue() string {
+	if x != nil {
+		return x.ExpectedValue
+	}
+	return ""
+}
+
+func (x *CompareAndSwapRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CompareAndSwapRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *CompareAndSwapRequest) GetMissingAsEmpty() bool {
+	if x != nil {
+		return x.MissingAsEmpty
+	}
+	return false
+}
+
+type CompareAndSwapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Swapped       bool                   `protobuf:"varint,1,opt,name=swapped,proto3" json:"swapped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareAndSwapResponse) Reset() {
+	*x = CompareAndSwapResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareAndSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareAndSwapResponse) ProtoMessage() {}
+
+func (x *CompareAndSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareAndSwapResponse.ProtoReflect.Descriptor instead.
+func (*CompareAndSwapResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CompareAndSwapResponse) GetSwapped() bool {
+	if x != nil {
+		return x.Swapped
+	}
+	return false
+}
+
+type AppendRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Suffix        string                 `protobuf:"bytes,2,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	TtlSeconds    int64                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppendRequest) Reset() {
+	*x = AppendRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendRequest) ProtoMessage() {}
+
+func (x *AppendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendRequest.ProtoReflect.Descriptor instead.
+func (*AppendRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AppendRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AppendRequest) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
+
+func (x *AppendRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type AppendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppendResponse) Reset() {
+	*x = AppendResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendResponse) ProtoMessage() {}
+
+func (x *AppendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendResponse.ProtoReflect.Descriptor instead.
+func (*AppendResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AppendResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type StrlenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrlenRequest) Reset() {
+	*x = StrlenRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrlenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrlenRequest) ProtoMessage() {}
+
+func (x *StrlenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrlenRequest.ProtoReflect.Descriptor instead.
+func (*StrlenRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StrlenRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type StrlenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrlenResponse) Reset() {
+	*x = StrlenResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrlenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrlenResponse) ProtoMessage() {}
+
+func (x *StrlenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrlenResponse.ProtoReflect.Descriptor instead.
+func (*StrlenResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StrlenResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type GetDelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDelRequest) Reset() {
+	*x = GetDelRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDelRequest) ProtoMessage() {}
+
+func (x *GetDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDelRequest.ProtoReflect.Descriptor instead.
+func (*GetDelRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetDelRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetDelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDelResponse) Reset() {
+	*x = GetDelResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDelResponse) ProtoMessage() {}
+
+func (x *GetDelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDelResponse.ProtoReflect.Descriptor instead.
+func (*GetDelResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetDelResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GetSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSetRequest) Reset() {
+	*x = GetSetRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSetRequest) ProtoMessage() {}
+
+func (x *GetSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSetRequest.ProtoReflect.Descriptor instead.
+func (*GetSetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetSetRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GetSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSetResponse) Reset() {
+	*x = GetSetResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSetResponse) ProtoMessage() {}
+
+func (x *GetSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSetResponse.ProtoReflect.Descriptor instead.
+func (*GetSetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSetResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GetExRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExRequest) Reset() {
+	*x = GetExRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExRequest) ProtoMessage() {}
+
+func (x *GetExRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExRequest.ProtoReflect.Descriptor instead.
+func (*GetExRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetExRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetExRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type GetExResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExResponse) Reset() {
+	*x = GetExResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExResponse) ProtoMessage() {}
+
+func (x *GetExResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExResponse.ProtoReflect.Descriptor instead.
+func (*GetExResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetExResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type MSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         map[string]string      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TtlSeconds    int32                  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	EventTimesMs  map[string]int64       `protobuf:"bytes,3,rep,name=event_times_ms,json=eventTimesMs,proto3" json:"event_times_ms,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MSetRequest) Reset() {
+	*x = MSetRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetRequest) ProtoMessage() {}
+
+func (x *MSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetRequest.ProtoReflect.Descriptor instead.
+func (*MSetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MSetRequest) GetItems() map[string]string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *MSetRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *MSetRequest) GetEventTimesMs() map[string]int64 {
+	if x != nil {
+		return x.EventTimesMs
+	}
+	return nil
+}
+
+type MSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skipped       map[string]int64       `protobuf:"bytes,1,rep,name=skipped,proto3" json:"skipped,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MSetResponse) Reset() {
+	*x = MSetResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSetResponse) ProtoMessage() {}
+
+func (x *MSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSetResponse.ProtoReflect.Descriptor instead.
+func (*MSetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MSetResponse) GetSkipped() map[string]int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type MGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MGetRequest) Reset() {
+	*x = MGetRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetRequest) ProtoMessage() {}
+
+func (x *MGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetRequest.ProtoReflect.Descriptor instead.
+func (*MGetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MGetRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type MGetEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Found         bool                   `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MGetEntry) Reset() {
+	*x = MGetEntry{}
+	mi := &file_cache_v1_cache_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MGetEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetEntry) ProtoMessage() {}
+
+func (x *MGetEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetEntry.ProtoReflect.Descriptor instead.
+func (*MGetEntry) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MGetEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MGetEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *MGetEntry) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+type MGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         map[string]string      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Entries       []*MGetEntry           `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MGetResponse) Reset() {
+	*x = MGetResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MGetResponse) ProtoMessage() {}
+
+func (x *MGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MGetResponse.ProtoReflect.Descriptor instead.
+func (*MGetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MGetResponse) GetItems() map[string]string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *MGetResponse) GetEntries() []*MGetEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ExistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExistsRequest) Reset() {
+	*x = ExistsRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsRequest) ProtoMessage() {}
+
+func (x *ExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsRequest.ProtoReflect.Descriptor instead.
+func (*ExistsRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExistsRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ExistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExistsResponse) Reset() {
+	*x = ExistsResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExistsResponse) ProtoMessage() {}
+
+func (x *ExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExistsResponse.ProtoReflect.Descriptor instead.
+func (*ExistsResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExistsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type MDelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MDelRequest) Reset() {
+	*x = MDelRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MDelRequest) ProtoMessage() {}
+
+func (x *MDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MDelRequest.ProtoReflect.Descriptor instead.
+func (*MDelRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MDelRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type MDelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       int64                  `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MDelResponse) Reset() {
+	*x = MDelResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MDelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MDelResponse) ProtoMessage() {}
+
+func (x *MDelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MDelResponse.ProtoReflect.Descriptor instead.
+func (*MDelResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MDelResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type PipelineCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineCommand) Reset() {
+	*x = PipelineCommand{}
+	mi := &file_cache_v1_cache_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineCommand) ProtoMessage() {}
+
+func (x *PipelineCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineCommand.ProtoReflect.Descriptor instead.
+func (*PipelineCommand) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PipelineCommand) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *PipelineCommand) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PipelineCommand) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PipelineCommand) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type PipelineResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PipelineResult) Reset() {
+	*x = PipelineResult{}
+	mi := &file_cache_v1_cache_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PipelineResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PipelineResult) ProtoMessage() {}
+
+func (x *PipelineResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PipelineResult.ProtoReflect.Descriptor instead.
+func (*PipelineResult) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PipelineResult) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *PipelineResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PipelineResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExecRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Commands      []*PipelineCommand     `protobuf:"bytes,1,rep,name=commands,proto3" json:"commands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecRequest) Reset() {
+	*x = ExecRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecRequest) ProtoMessage() {}
+
+func (x *ExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecRequest.ProtoReflect.Descriptor instead.
+func (*ExecRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExecRequest) GetCommands() []*PipelineCommand {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+type ExecResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*PipelineResult      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecResponse) Reset() {
+	*x = ExecResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecResponse) ProtoMessage() {}
+
+func (x *ExecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecResponse.ProtoReflect.Descriptor instead.
+func (*ExecResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExecResponse) GetResults() []*PipelineResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ReplicateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplicationId string                 `protobuf:"bytes,1,opt,name=replication_id,json=replicationId,proto3" json:"replication_id,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplicateRequest) Reset() {
+	*x = ReplicateRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateRequest) ProtoMessage() {}
+
+func (x *ReplicateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateRequest.ProtoReflect.Descriptor instead.
+func (*ReplicateRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReplicateRequest) GetReplicationId() string {
+	if x != nil {
+		return x.ReplicationId
+	}
+	return ""
+}
+
+func (x *ReplicateRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ReplicateEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplicationId string                 `protobuf:"bytes,1,opt,name=replication_id,json=replicationId,proto3" json:"replication_id,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	FullSync      bool                   `protobuf:"varint,3,opt,name=full_sync,json=fullSync,proto3" json:"full_sync,omitempty"`
+	Command       []byte                 `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplicateEvent) Reset() {
+	*x = ReplicateEvent{}
+	mi := &file_cache_v1_cache_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicateEvent) ProtoMessage() {}
+
+func (x *ReplicateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicateEvent.ProtoReflect.Descriptor instead.
+func (*ReplicateEvent) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReplicateEvent) GetReplicationId() string {
+	if x != nil {
+		return x.ReplicationId
+	}
+	return ""
+}
+
+func (x *ReplicateEvent) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReplicateEvent) GetFullSync() bool {
+	if x != nil {
+		return x.FullSync
+	}
+	return false
+}
+
+func (x *ReplicateEvent) GetCommand() []byte {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+type IncrByRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Delta         int64                  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrByRequest) Reset() {
+	*x = IncrByRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrByRequest) ProtoMessage() {}
+
+func (x *IncrByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrByRequest.ProtoReflect.Descriptor instead.
+func (*IncrByRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *IncrByRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *IncrByRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type IncrByResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrByResponse) Reset() {
+	*x = IncrByResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrByResponse) ProtoMessage() {}
+
+func (x *IncrByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrByResponse.ProtoReflect.Descriptor instead.
+func (*IncrByResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *IncrByResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type DecrByRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Delta         int64                  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecrByRequest) Reset() {
+	*x = DecrByRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecrByRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrByRequest) ProtoMessage() {}
+
+func (x *DecrByRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrByRequest.ProtoReflect.Descriptor instead.
+func (*DecrByRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DecrByRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DecrByRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type DecrByResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int64                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecrByResponse) Reset() {
+	*x = DecrByResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecrByResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrByResponse) ProtoMessage() {}
+
+func (x *DecrByResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrByResponse.ProtoReflect.Descriptor instead.
+func (*DecrByResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DecrByResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type HSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HSetRequest) Reset() {
+	*x = HSetRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HSetRequest) ProtoMessage() {}
+
+func (x *HSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HSetRequest.ProtoReflect.Descriptor instead.
+func (*HSetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *HSetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HSetRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *HSetRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type HSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HSetResponse) Reset() {
+	*x = HSetResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HSetResponse) ProtoMessage() {}
+
+func (x *HSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HSetResponse.ProtoReflect.Descriptor instead.
+func (*HSetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{54}
+}
+
+type HGetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HGetRequest) Reset() {
+	*x = HGetRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetRequest) ProtoMessage() {}
+
+func (x *HGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetRequest.ProtoReflect.Descriptor instead.
+func (*HGetRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *HGetRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HGetRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type HGetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HGetResponse) Reset() {
+	*x = HGetResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HGetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetResponse) ProtoMessage() {}
+
+func (x *HGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetResponse.ProtoReflect.Descriptor instead.
+func (*HGetResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *HGetResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type HDelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HDelRequest) Reset() {
+	*x = HDelRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HDelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HDelRequest) ProtoMessage() {}
+
+func (x *HDelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HDelRequest.ProtoReflect.Descriptor instead.
+func (*HDelRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *HDelRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *HDelRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+type HDelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HDelResponse) Reset() {
+	*x = HDelResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HDelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HDelResponse) ProtoMessage() {}
+
+func (x *HDelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HDelResponse.ProtoReflect.Descriptor instead.
+func (*HDelResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *HDelResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type HGetAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HGetAllRequest) Reset() {
+	*x = HGetAllRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HGetAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetAllRequest) ProtoMessage() {}
+
+func (x *HGetAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetAllRequest.ProtoReflect.Descriptor instead.
+func (*HGetAllRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *HGetAllRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type HGetAllResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        map[string]string      `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HGetAllResponse) Reset() {
+	*x = HGetAllResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HGetAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGetAllResponse) ProtoMessage() {}
+
+func (x *HGetAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGetAllResponse.ProtoReflect.Descriptor instead.
+func (*HGetAllResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *HGetAllResponse) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type HLenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HLenRequest) Reset() {
+	*x = HLenRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HLenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HLenRequest) ProtoMessage() {}
+
+func (x *HLenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HLenRequest.ProtoReflect.Descriptor instead.
+func (*HLenRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *HLenRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type HLenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HLenResponse) Reset() {
+	*x = HLenResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HLenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HLenResponse) ProtoMessage() {}
+
+func (x *HLenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HLenResponse.ProtoReflect.Descriptor instead.
+func (*HLenResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *HLenResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type LPushRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LPushRequest) Reset() {
+	*x = LPushRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LPushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LPushRequest) ProtoMessage() {}
+
+func (x *LPushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LPushRequest.ProtoReflect.Descriptor instead.
+func (*LPushRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LPushRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LPushRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type LPushResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LPushResponse) Reset() {
+	*x = LPushResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LPushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LPushResponse) ProtoMessage() {}
+
+func (x *LPushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LPushResponse.ProtoReflect.Descriptor instead.
+func (*LPushResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *LPushResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type RPushRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RPushRequest) Reset() {
+	*x = RPushRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RPushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPushRequest) ProtoMessage() {}
+
+func (x *RPushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPushRequest.ProtoReflect.Descriptor instead.
+func (*RPushRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RPushRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RPushRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type RPushResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RPushResponse) Reset() {
+	*x = RPushResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RPushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPushResponse) ProtoMessage() {}
+
+func (x *RPushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPushResponse.ProtoReflect.Descriptor instead.
+func (*RPushResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RPushResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type LPopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LPopRequest) Reset() {
+	*x = LPopRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LPopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LPopRequest) ProtoMessage() {}
+
+func (x *LPopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LPopRequest.ProtoReflect.Descriptor instead.
+func (*LPopRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LPopRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type LPopResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LPopResponse) Reset() {
+	*x = LPopResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LPopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LPopResponse) ProtoMessage() {}
+
+func (x *LPopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LPopResponse.ProtoReflect.Descriptor instead.
+func (*LPopResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *LPopResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type RPopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RPopRequest) Reset() {
+	*x = RPopRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RPopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPopRequest) ProtoMessage() {}
+
+func (x *RPopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPopRequest.ProtoReflect.Descriptor instead.
+func (*RPopRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RPopRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type RPopResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RPopResponse) Reset() {
+	*x = RPopResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RPopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RPopResponse) ProtoMessage() {}
+
+func (x *RPopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RPopResponse.ProtoReflect.Descriptor instead.
+func (*RPopResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RPopResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type LRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Start         int64                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Stop          int64                  `protobuf:"varint,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LRangeRequest) Reset() {
+	*x = LRangeRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LRangeRequest) ProtoMessage() {}
+
+func (x *LRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LRangeRequest.ProtoReflect.Descriptor instead.
+func (*LRangeRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *LRangeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LRangeRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LRangeRequest) GetStop() int64 {
+	if x != nil {
+		return x.Stop
+	}
+	return 0
+}
+
+type LRangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LRangeResponse) Reset() {
+	*x = LRangeResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LRangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LRangeResponse) ProtoMessage() {}
+
+func (x *LRangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LRangeResponse.ProtoReflect.Descriptor instead.
+func (*LRangeResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *LRangeResponse) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type LLenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LLenRequest) Reset() {
+	*x = LLenRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LLenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLenRequest) ProtoMessage() {}
+
+func (x *LLenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLenRequest.ProtoReflect.Descriptor instead.
+func (*LLenRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *LLenRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type LLenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int64                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LLenResponse) Reset() {
+	*x = LLenResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LLenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LLenResponse) ProtoMessage() {}
+
+func (x *LLenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LLenResponse.ProtoReflect.Descriptor instead.
+func (*LLenResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *LLenResponse) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type KeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeysRequest) Reset() {
+	*x = KeysRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeysRequest) ProtoMessage() {}
+
+func (x *KeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeysRequest.ProtoReflect.Descriptor instead.
+func (*KeysRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *KeysRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type KeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeysResponse) Reset() {
+	*x = KeysResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeysResponse) ProtoMessage() {}
+
+func (x *KeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeysResponse.ProtoReflect.Descriptor instead.
+func (*KeysResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *KeysResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ScanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        uint64                 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanRequest) Reset() {
+	*x = ScanRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanRequest) ProtoMessage() {}
+
+func (x *ScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanRequest.ProtoReflect.Descriptor instead.
+func (*ScanRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ScanRequest) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ScanRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *ScanRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ScanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Cursor        uint64                 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanResponse) Reset() {
+	*x = ScanResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanResponse) ProtoMessage() {}
+
+func (x *ScanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanResponse.ProtoReflect.Descriptor instead.
+func (*ScanResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ScanResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ScanResponse) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+type WatchExpiredRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchExpiredRequest) Reset() {
+	*x = WatchExpiredRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchExpiredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchExpiredRequest) ProtoMessage() {}
+
+func (x *WatchExpiredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchExpiredRequest.ProtoReflect.Descriptor instead.
+func (*WatchExpiredRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *WatchExpiredRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type ExpiredEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ExpiresAtMs   int64                  `protobuf:"varint,2,opt,name=expires_at_ms,json=expiresAtMs,proto3" json:"expires_at_ms,omitempty"`
+	Dropped       uint64                 `protobuf:"varint,3,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpiredEvent) Reset() {
+	*x = ExpiredEvent{}
+	mi := &file_cache_v1_cache_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpiredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiredEvent) ProtoMessage() {}
+
+func (x *ExpiredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiredEvent.ProtoReflect.Descriptor instead.
+func (*ExpiredEvent) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ExpiredEvent) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExpiredEvent) GetExpiresAtMs() int64 {
+	if x != nil {
+		return x.ExpiresAtMs
+	}
+	return 0
+}
+
+func (x *ExpiredEvent) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type RandomKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RandomKeyRequest) Reset() {
+	*x = RandomKeyRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomKeyRequest) ProtoMessage() {}
+
+func (x *RandomKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomKeyRequest.ProtoReflect.Descriptor instead.
+func (*RandomKeyRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{81}
+}
+
+type RandomKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RandomKeyResponse) Reset() {
+	*x = RandomKeyResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandomKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandomKeyResponse) ProtoMessage() {}
+
+func (x *RandomKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandomKeyResponse.ProtoReflect.Descriptor instead.
+func (*RandomKeyResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RandomKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type TypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypeRequest) Reset() {
+	*x = TypeRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeRequest) ProtoMessage() {}
+
+func (x *TypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeRequest.ProtoReflect.Descriptor instead.
+func (*TypeRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *TypeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type TypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypeResponse) Reset() {
+	*x = TypeResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeResponse) ProtoMessage() {}
+
+func (x *TypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeResponse.ProtoReflect.Descriptor instead.
+func (*TypeResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *TypeResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type DBSizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBSizeRequest) Reset() {
+	*x = DBSizeRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBSizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBSizeRequest) ProtoMessage() {}
+
+func (x *DBSizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBSizeRequest.ProtoReflect.Descriptor instead.
+func (*DBSizeRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{85}
+}
+
+type DBSizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          int64                  `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	ShardKeys     []int64                `protobuf:"varint,2,rep,packed,name=shard_keys,json=shardKeys,proto3" json:"shard_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DBSizeResponse) Reset() {
+	*x = DBSizeResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBSizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBSizeResponse) ProtoMessage() {}
+
+func (x *DBSizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBSizeResponse.ProtoReflect.Descriptor instead.
+func (*DBSizeResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DBSizeResponse) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *DBSizeResponse) GetShardKeys() []int64 {
+	if x != nil {
+		return x.ShardKeys
+	}
+	return nil
+}
+
+type MemoryUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoryUsageRequest) Reset() {
+	*x = MemoryUsageRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryUsageRequest) ProtoMessage() {}
+
+func (x *MemoryUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryUsageRequest.ProtoReflect.Descriptor instead.
+func (*MemoryUsageRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *MemoryUsageRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type MemoryUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bytes         int64                  `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoryUsageResponse) Reset() {
+	*x = MemoryUsageResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryUsageResponse) ProtoMessage() {}
+
+func (x *MemoryUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryUsageResponse.ProtoReflect.Descriptor instead.
+func (*MemoryUsageResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *MemoryUsageResponse) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type GetTTLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTTLRequest) Reset() {
+	*x = GetTTLRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTTLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTTLRequest) ProtoMessage() {}
+
+func (x *GetTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTTLRequest.ProtoReflect.Descriptor instead.
+func (*GetTTLRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetTTLRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetTTLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TtlSeconds    int64                  `protobuf:"varint,1,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	Persistent    bool                   `protobuf:"varint,2,opt,name=persistent,proto3" json:"persistent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTTLResponse) Reset() {
+	*x = GetTTLResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTTLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTTLResponse) ProtoMessage() {}
+
+func (x *GetTTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTTLResponse.ProtoReflect.Descriptor instead.
+func (*GetTTLResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetTTLResponse) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *GetTTLResponse) GetPersistent() bool {
+	if x != nil {
+		return x.Persistent
+	}
+	return false
+}
+
+type PTTLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PTTLRequest) Reset() {
+	*x = PTTLRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PTTLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PTTLRequest) ProtoMessage() {}
+
+func (x *PTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PTTLRequest.ProtoReflect.Descriptor instead.
+func (*PTTLRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PTTLRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type PTTLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TtlMillis     int64                  `protobuf:"varint,1,opt,name=ttl_millis,json=ttlMillis,proto3" json:"ttl_millis,omitempty"`
+	Persistent    bool                   `protobuf:"varint,2,opt,name=persistent,proto3" json:"persistent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PTTLResponse) Reset() {
+	*x = PTTLResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PTTLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PTTLResponse) ProtoMessage() {}
+
+func (x *PTTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PTTLResponse.ProtoReflect.Descriptor instead.
+func (*PTTLResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PTTLResponse) GetTtlMillis() int64 {
+	if x != nil {
+		return x.TtlMillis
+	}
+	return 0
+}
+
+func (x *PTTLResponse) GetPersistent() bool {
+	if x != nil {
+		return x.Persistent
+	}
+	return false
+}
+
+type ExpireRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireRequest) Reset() {
+	*x = ExpireRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireRequest) ProtoMessage() {}
+
+func (x *ExpireRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireRequest.ProtoReflect.Descriptor instead.
+func (*ExpireRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ExpireRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExpireRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ExpireResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       bool                   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireResponse) Reset() {
+	*x = ExpireResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireResponse) ProtoMessage() {}
+
+func (x *ExpireResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireResponse.ProtoReflect.Descriptor instead.
+func (*ExpireResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ExpireResponse) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+type TouchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TouchRequest) Reset() {
+	*x = TouchRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TouchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchRequest) ProtoMessage() {}
+
+func (x *TouchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchRequest.ProtoReflect.Descriptor instead.
+func (*TouchRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *TouchRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TouchRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type TouchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Touched       bool                   `protobuf:"varint,1,opt,name=touched,proto3" json:"touched,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TouchResponse) Reset() {
+	*x = TouchResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TouchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchResponse) ProtoMessage() {}
+
+func (x *TouchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchResponse.ProtoReflect.Descriptor instead.
+func (*TouchResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *TouchResponse) GetTouched() bool {
+	if x != nil {
+		return x.Touched
+	}
+	return false
+}
+
+type TouchKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TouchKeysRequest) Reset() {
+	*x = TouchKeysRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TouchKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchKeysRequest) ProtoMessage() {}
+
+func (x *TouchKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchKeysRequest.ProtoReflect.Descriptor instead.
+func (*TouchKeysRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *TouchKeysRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type TouchKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TouchKeysResponse) Reset() {
+	*x = TouchKeysResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TouchKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchKeysResponse) ProtoMessage() {}
+
+func (x *TouchKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchKeysResponse.ProtoReflect.Descriptor instead.
+func (*TouchKeysResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *TouchKeysResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RenameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	NewKey        string                 `protobuf:"bytes,2,opt,name=new_key,json=newKey,proto3" json:"new_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameRequest) Reset() {
+	*x = RenameRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameRequest) ProtoMessage() {}
+
+func (x *RenameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameRequest.ProtoReflect.Descriptor instead.
+func (*RenameRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RenameRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RenameRequest) GetNewKey() string {
+	if x != nil {
+		return x.NewKey
+	}
+	return ""
+}
+
+type RenameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameResponse) Reset() {
+	*x = RenameResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameResponse) ProtoMessage() {}
+
+func (x *RenameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameResponse.ProtoReflect.Descriptor instead.
+func (*RenameResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{100}
+}
+
+type PersistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersistRequest) Reset() {
+	*x = PersistRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistRequest) ProtoMessage() {}
+
+func (x *PersistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistRequest.ProtoReflect.Descriptor instead.
+func (*PersistRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PersistRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type PersistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Updated       bool                   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersistResponse) Reset() {
+	*x = PersistResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistResponse) ProtoMessage() {}
+
+func (x *PersistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistResponse.ProtoReflect.Descriptor instead.
+func (*PersistResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *PersistResponse) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+type PinRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Key            string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PinTtlOverride bool                   `protobuf:"varint,2,opt,name=pin_ttl_override,json=pinTtlOverride,proto3" json:"pin_ttl_override,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PinRequest) Reset() {
+	*x = PinRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinRequest) ProtoMessage() {}
+
+func (x *PinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinRequest.ProtoReflect.Descriptor instead.
+func (*PinRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *PinRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PinRequest) GetPinTtlOverride() bool {
+	if x != nil {
+		return x.PinTtlOverride
+	}
+	return false
+}
+
+type PinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinResponse) Reset() {
+	*x = PinResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinResponse) ProtoMessage() {}
+
+func (x *PinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinResponse.ProtoReflect.Descriptor instead.
+func (*PinResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{104}
+}
+
+type UnpinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinRequest) Reset() {
+	*x = UnpinRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinRequest) ProtoMessage() {}
+
+func (x *UnpinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinRequest.ProtoReflect.Descriptor instead.
+func (*UnpinRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *UnpinRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type UnpinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinResponse) Reset() {
+	*x = UnpinResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinResponse) ProtoMessage() {}
+
+func (x *UnpinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinResponse.ProtoReflect.Descriptor instead.
+func (*UnpinResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{106}
+}
+
+type ListPinnedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPinnedRequest) Reset() {
+	*x = ListPinnedRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedRequest) ProtoMessage() {}
+
+func (x *ListPinnedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedRequest.ProtoReflect.Descriptor instead.
+func (*ListPinnedRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{107}
+}
+
+type ListPinnedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Bytes         int64                  `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPinnedResponse) Reset() {
+	*x = ListPinnedResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedResponse) ProtoMessage() {}
+
+func (x *ListPinnedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedResponse.ProtoReflect.Descriptor instead.
+func (*ListPinnedResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListPinnedResponse) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ListPinnedResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ListPinnedResponse) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type StatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{109}
+}
+
+type ShardStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          int64                  `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	PeakKeys      int64                  `protobuf:"varint,2,opt,name=peak_keys,json=peakKeys,proto3" json:"peak_keys,omitempty"`
+	LockWait      *LockWaitStats         `protobuf:"bytes,3,opt,name=lock_wait,json=lockWait,proto3" json:"lock_wait,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShardStats) Reset() {
+	*x = ShardStats{}
+	mi := &file_cache_v1_cache_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShardStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardStats) ProtoMessage() {}
+
+func (x *ShardStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardStats.ProtoReflect.Descriptor instead.
+func (*ShardStats) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ShardStats) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *ShardStats) GetPeakKeys() int64 {
+	if x != nil {
+		return x.PeakKeys
+	}
+	return 0
+}
+
+func (x *ShardStats) GetLockWait() *LockWaitStats {
+	if x != nil {
+		return x.LockWait
+	}
+	return nil
+}
+
+type LockWaitStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketSeconds []float64              `protobuf:"fixed64,1,rep,packed,name=bucket_seconds,json=bucketSeconds,proto3" json:"bucket_seconds,omitempty"`
+	Counts        []uint64               `protobuf:"varint,2,rep,packed,name=counts,proto3" json:"counts,omitempty"`
+	Count         uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	SumSeconds    float64                `protobuf:"fixed64,4,opt,name=sum_seconds,json=sumSeconds,proto3" json:"sum_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockWaitStats) Reset() {
+	*x = LockWaitStats{}
+	mi := &file_cache_v1_cache_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockWaitStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockWaitStats) ProtoMessage() {}
+
+func (x *LockWaitStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockWaitStats.ProtoReflect.Descriptor instead.
+func (*LockWaitStats) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *LockWaitStats) GetBucketSeconds() []float64 {
+	if x != nil {
+		return x.BucketSeconds
+	}
+	return nil
+}
+
+func (x *LockWaitStats) GetCounts() []uint64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *LockWaitStats) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *LockWaitStats) GetSumSeconds() float64 {
+	if x != nil {
+		return x.SumSeconds
+	}
+	return 0
+}
+
+type DefragStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Passes           uint64                 `protobuf:"varint,1,opt,name=passes,proto3" json:"passes,omitempty"`
+	ShardsRebuilt    uint64                 `protobuf:"varint,2,opt,name=shards_rebuilt,json=shardsRebuilt,proto3" json:"shards_rebuilt,omitempty"`
+	ReclaimedBuckets uint64                 `protobuf:"varint,3,opt,name=reclaimed_buckets,json=reclaimedBuckets,proto3" json:"reclaimed_buckets,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DefragStats) Reset() {
+	*x = DefragStats{}
+	mi := &file_cache_v1_cache_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefragStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefragStats) ProtoMessage() {}
+
+func (x *DefragStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefragStats.ProtoReflect.Descriptor instead.
+func (*DefragStats) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DefragStats) GetPasses() uint64 {
+	if x != nil {
+		return x.Passes
+	}
+	return 0
+}
+
+func (x *DefragStats) GetShardsRebuilt() uint64 {
+	if x != nil {
+		return x.ShardsRebuilt
+	}
+	return 0
+}
+
+func (x *DefragStats) GetReclaimedBuckets() uint64 {
+	if x != nil {
+		return x.ReclaimedBuckets
+	}
+	return 0
+}
+
+type EvictionStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        string                 `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	MaxKeys       int64                  `protobuf:"varint,2,opt,name=max_keys,json=maxKeys,proto3" json:"max_keys,omitempty"`
+	Evicted       uint64                 `protobuf:"varint,3,opt,name=evicted,proto3" json:"evicted,omitempty"`
+	Rejected      uint64                 `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	MaxBytes      int64                  `protobuf:"varint,5,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	UsedBytes     int64                  `protobuf:"varint,6,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvictionStats) Reset() {
+	*x = EvictionStats{}
+	mi := &file_cache_v1_cache_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvictionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictionStats) ProtoMessage() {}
+
+func (x *EvictionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictionStats.ProtoReflect.Descriptor instead.
+func (*EvictionStats) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *EvictionStats) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *EvictionStats) GetMaxKeys() int64 {
+	if x != nil {
+		return x.MaxKeys
+	}
+	return 0
+}
+
+func (x *EvictionStats) GetEvicted() uint64 {
+	if x != nil {
+		return x.Evicted
+	}
+	return 0
+}
+
+func (x *EvictionStats) GetRejected() uint64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *EvictionStats) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *EvictionStats) GetUsedBytes() int64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+type StatsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Keys              int64                  `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	Shards            []*ShardStats          `protobuf:"bytes,2,rep,name=shards,proto3" json:"shards,omitempty"`
+	Defrag            *DefragStats           `protobuf:"bytes,3,opt,name=defrag,proto3" json:"defrag,omitempty"`
+	Eviction          *EvictionStats         `protobuf:"bytes,4,opt,name=eviction,proto3" json:"eviction,omitempty"`
+	Hits              uint64                 `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses            uint64                 `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty"`
+	Expired           uint64                 `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+	AofFileSize       int64                  `protobuf:"varint,8,opt,name=aof_file_size,json=aofFileSize,proto3" json:"aof_file_size,omitempty"`
+	AofQueueDepth     int64                  `protobuf:"varint,9,opt,name=aof_queue_depth,json=aofQueueDepth,proto3" json:"aof_queue_depth,omitempty"`
+	UptimeSeconds     int64                  `protobuf:"varint,10,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	AofWriteErrors    uint64                 `protobuf:"varint,11,opt,name=aof_write_errors,json=aofWriteErrors,proto3" json:"aof_write_errors,omitempty"`
+	AofQueueRejected  uint64                 `protobuf:"varint,12,opt,name=aof_queue_rejected,json=aofQueueRejected,proto3" json:"aof_queue_rejected,omitempty"`
+	Sets              uint64                 `protobuf:"varint,13,opt,name=sets,proto3" json:"sets,omitempty"`
+	Deletes           uint64                 `protobuf:"varint,14,opt,name=deletes,proto3" json:"deletes,omitempty"`
+	HitRatio          float64                `protobuf:"fixed64,15,opt,name=hit_ratio,json=hitRatio,proto3" json:"hit_ratio,omitempty"`
+	AofReplayed       int64                  `protobuf:"varint,16,opt,name=aof_replayed,json=aofReplayed,proto3" json:"aof_replayed,omitempty"`
+	AofReplaySkipped  int64                  `protobuf:"varint,17,opt,name=aof_replay_skipped,json=aofReplaySkipped,proto3" json:"aof_replay_skipped,omitempty"`
+	Replication       *ReplicationStats      `protobuf:"bytes,18,opt,name=replication,proto3" json:"replication,omitempty"`
+	ExpireSample      *ExpireSampleStats     `protobuf:"bytes,19,opt,name=expire_sample,json=expireSample,proto3" json:"expire_sample,omitempty"`
+	PinnedKeys        int64                  `protobuf:"varint,20,opt,name=pinned_keys,json=pinnedKeys,proto3" json:"pinned_keys,omitempty"`
+	PinnedBytes       int64                  `protobuf:"varint,21,opt,name=pinned_bytes,json=pinnedBytes,proto3" json:"pinned_bytes,omitempty"`
+	TimeWheelLockWait *LockWaitStats         `protobuf:"bytes,22,opt,name=time_wheel_lock_wait,json=timeWheelLockWait,proto3" json:"time_wheel_lock_wait,omitempty"`
+	Disk              *DiskStats             `protobuf:"bytes,23,opt,name=disk,proto3" json:"disk,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *StatsResponse) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetShards() []*ShardStats {
+	if x != nil {
+		return x.Shards
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetDefrag() *DefragStats {
+	if x != nil {
+		return x.Defrag
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetEviction() *EvictionStats {
+	if x != nil {
+		return x.Eviction
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetMisses() uint64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetExpired() uint64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetAofFileSize() int64 {
+	if x != nil {
+		return x.AofFileSize
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetAofQueueDepth() int64 {
+	if x != nil {
+		return x.AofQueueDepth
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetAofWriteErrors() uint64 {
+	if x != nil {
+		return x.AofWriteErrors
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetAofQueueRejected() uint64 {
+	if x != nil {
+		return x.AofQueueRejected
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetSets() uint64 {
+	if x != nil {
+		return x.Sets
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetDeletes() uint64 {
+	if x != nil {
+		return x.Deletes
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetHitRatio() float64 {
+	if x != nil {
+		return x.HitRatio
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetAofReplayed() int64 {
+	if x != nil {
+		return x.AofReplayed
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetAofReplaySkipped() int64 {
+	if x != nil {
+		return x.AofReplaySkipped
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetReplication() *ReplicationStats {
+	if x != nil {
+		return x.Replication
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetExpireSample() *ExpireSampleStats {
+	if x != nil {
+		return x.ExpireSample
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetPinnedKeys() int64 {
+	if x != nil {
+		return x.PinnedKeys
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetPinnedBytes() int64 {
+	if x != nil {
+		return x.PinnedBytes
+	}
+	return 0
+}
+
+func (x *StatsResponse) GetTimeWheelLockWait() *LockWaitStats {
+	if x != nil {
+		return x.TimeWheelLockWait
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetDisk() *DiskStats {
+	if x != nil {
+		return x.Disk
+	}
+	return nil
+}
+
+type DiskStats struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	State                string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	FreeBytes            int64                  `protobuf:"varint,2,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	TotalBytes           int64                  `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	GrowthBytesPerSecond float64                `protobuf:"fixed64,4,opt,name=growth_bytes_per_second,json=growthBytesPerSecond,proto3" json:"growth_bytes_per_second,omitempty"`
+	TimeToFullSeconds    int64                  `protobuf:"varint,5,opt,name=time_to_full_seconds,json=timeToFullSeconds,proto3" json:"time_to_full_seconds,omitempty"`
+	Compactions          uint64                 `protobuf:"varint,6,opt,name=compactions,proto3" json:"compactions,omitempty"`
+	RejectedWrites       uint64                 `protobuf:"varint,7,opt,name=rejected_writes,json=rejectedWrites,proto3" json:"rejected_writes,omitempty"`
+	DroppedCommands      uint64                 `protobuf:"varint,8,opt,name=dropped_commands,json=droppedCommands,proto3" json:"dropped_commands,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DiskStats) Reset() {
+	*x = DiskStats{}
+	mi := &file_cache_v1_cache_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskStats) ProtoMessage() {}
+
+func (x *DiskStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskStats.ProtoReflect.Descriptor instead.
+func (*DiskStats) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DiskStats) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DiskStats) GetFreeBytes() int64 {
+	if x != nil {
+		return x.FreeBytes
+	}
+	return 0
+}
+
+func (x *DiskStats) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *DiskStats) GetGrowthBytesPerSecond() float64 {
+	if x != nil {
+		return x.GrowthBytesPerSecond
+	}
+	return 0
+}
+
+func (x *DiskStats) GetTimeToFullSeconds() int64 {
+	if x != nil {
+		return x.TimeToFullSeconds
+	}
+	return 0
+}
+
+func (x *DiskStats) GetCompactions() uint64 {
+	if x != nil {
+		return x.Compactions
+	}
+	return 0
+}
+
+func (x *DiskStats) GetRejectedWrites() uint64 {
+	if x != nil {
+		return x.RejectedWrites
+	}
+	return 0
+}
+
+func (x *DiskStats) GetDroppedCommands() uint64 {
+	if x != nil {
+		return x.DroppedCommands
+	}
+	return 0
+}
+
+type ExpireSampleStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Examined      uint64                 `protobuf:"varint,1,opt,name=examined,proto3" json:"examined,omitempty"`
+	Expired       uint64                 `protobuf:"varint,2,opt,name=expired,proto3" json:"expired,omitempty"`
+	TimeLimited   uint64                 `protobuf:"varint,3,opt,name=time_limited,json=timeLimited,proto3" json:"time_limited,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireSampleStats) Reset() {
+	*x = ExpireSampleStats{}
+	mi := &file_cache_v1_cache_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireSampleStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireSampleStats) ProtoMessage() {}
+
+func (x *ExpireSampleStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireSampleStats.ProtoReflect.Descriptor instead.
+func (*ExpireSampleStats) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ExpireSampleStats) GetExamined() uint64 {
+	if x != nil {
+		return x.Examined
+	}
+	return 0
+}
+
+func (x *ExpireSampleStats) GetExpired() uint64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *ExpireSampleStats) GetTimeLimited() uint64 {
+	if x != nil {
+		return x.TimeLimited
+	}
+	return 0
+}
+
+type ReplicationStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Role            string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	ReplicationId   string                 `protobuf:"bytes,2,opt,name=replication_id,json=replicationId,proto3" json:"replication_id,omitempty"`
+	Offset          int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Followers       int64                  `protobuf:"varint,4,opt,name=followers,proto3" json:"followers,omitempty"`
+	LeaderConnected bool                   `protobuf:"varint,5,opt,name=leader_connected,json=leaderConnected,proto3" json:"leader_connected,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReplicationStats) Reset() {
+	*x = ReplicationStats{}
+	mi := &file_cache_v1_cache_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicationStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicationStats) ProtoMessage() {}
+
+func (x *ReplicationStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicationStats.ProtoReflect.Descriptor instead.
+func (*ReplicationStats) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ReplicationStats) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ReplicationStats) GetReplicationId() string {
+	if x != nil {
+		return x.ReplicationId
+	}
+	return ""
+}
+
+func (x *ReplicationStats) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReplicationStats) GetFollowers() int64 {
+	if x != nil {
+		return x.Followers
+	}
+	return 0
+}
+
+func (x *ReplicationStats) GetLeaderConnected() bool {
+	if x != nil {
+		return x.LeaderConnected
+	}
+	return false
+}
+
+type DefragRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Force         bool                   `protobuf:"varint,1,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefragRequest) Reset() {
+	*x = DefragRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefragRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefragRequest) ProtoMessage() {}
+
+func (x *DefragRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefragRequest.ProtoReflect.Descriptor instead.
+func (*DefragRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *DefragRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DefragResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ShardsRebuilt    int64                  `protobuf:"varint,1,opt,name=shards_rebuilt,json=shardsRebuilt,proto3" json:"shards_rebuilt,omitempty"`
+	ReclaimedBuckets uint64                 `protobuf:"varint,2,opt,name=reclaimed_buckets,json=reclaimedBuckets,proto3" json:"reclaimed_buckets,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DefragResponse) Reset() {
+	*x = DefragResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefragResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefragResponse) ProtoMessage() {}
+
+func (x *DefragResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefragResponse.ProtoReflect.Descriptor instead.
+func (*DefragResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DefragResponse) GetShardsRebuilt() int64 {
+	if x != nil {
+		return x.ShardsRebuilt
+	}
+	return 0
+}
+
+func (x *DefragResponse) GetReclaimedBuckets() uint64 {
+	if x != nil {
+		return x.ReclaimedBuckets
+	}
+	return 0
+}
+
+type RewriteAOFRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewriteAOFRequest) Reset() {
+	*x = RewriteAOFRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewriteAOFRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewriteAOFRequest) ProtoMessage() {}
+
+func (x *RewriteAOFRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewriteAOFRequest.ProtoReflect.Descriptor instead.
+func (*RewriteAOFRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{120}
+}
+
+type RewriteAOFResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          int64                  `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	OldSize       int64                  `protobuf:"varint,2,opt,name=old_size,json=oldSize,proto3" json:"old_size,omitempty"`
+	NewSize       int64                  `protobuf:"varint,3,opt,name=new_size,json=newSize,proto3" json:"new_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewriteAOFResponse) Reset() {
+	*x = RewriteAOFResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewriteAOFResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewriteAOFResponse) ProtoMessage() {}
+
+func (x *RewriteAOFResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewriteAOFResponse.ProtoReflect.Descriptor instead.
+func (*RewriteAOFResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *RewriteAOFResponse) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *RewriteAOFResponse) GetOldSize() int64 {
+	if x != nil {
+		return x.OldSize
+	}
+	return 0
+}
+
+func (x *RewriteAOFResponse) GetNewSize() int64 {
+	if x != nil {
+		return x.NewSize
+	}
+	return 0
+}
+
+type ExportEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Hash          map[string][]byte      `protobuf:"bytes,3,rep,name=hash,proto3" json:"hash,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	List          [][]byte               `protobuf:"bytes,4,rep,name=list,proto3" json:"list,omitempty"`
+	ExpiresAtMs   int64                  `protobuf:"varint,5,opt,name=expires_at_ms,json=expiresAtMs,proto3" json:"expires_at_ms,omitempty"`
+	EventTimeMs   int64                  `protobuf:"varint,6,opt,name=event_time_ms,json=eventTimeMs,proto3" json:"event_time_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEntry) Reset() {
+	*x = ExportEntry{}
+	mi := &file_cache_v1_cache_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEntry) ProtoMessage() {}
+
+func (x *ExportEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEntry.ProtoReflect.Descriptor instead.
+func (*ExportEntry) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ExportEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExportEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ExportEntry) GetHash() map[string][]byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ExportEntry) GetList() [][]byte {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ExportEntry) GetExpiresAtMs() int64 {
+	if x != nil {
+		return x.ExpiresAtMs
+	}
+	return 0
+}
+
+func (x *ExportEntry) GetEventTimeMs() int64 {
+	if x != nil {
+		return x.EventTimeMs
+	}
+	return 0
+}
+
+type ExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BatchSize     int32                  `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ExportRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type ExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*ExportEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportResponse) Reset() {
+	*x = ExportResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportResponse) ProtoMessage() {}
+
+func (x *ExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportResponse.ProtoReflect.Descriptor instead.
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ExportResponse) GetEntries() []*ExportEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*ExportEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Replace       bool                   `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRequest) Reset() {
+	*x = ImportRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRequest) ProtoMessage() {}
+
+func (x *ImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ImportRequest) GetEntries() []*ExportEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ImportRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type ImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      int64                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Skipped       int64                  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Overwritten   int64                  `protobuf:"varint,3,opt,name=overwritten,proto3" json:"overwritten,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResponse) Reset() {
+	*x = ImportResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResponse) ProtoMessage() {}
+
+func (x *ImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResponse.ProtoReflect.Descriptor instead.
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ImportResponse) GetImported() int64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportResponse) GetOverwritten() int64 {
+	if x != nil {
+		return x.Overwritten
+	}
+	return 0
+}
+
+type FlushAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IncludePinned bool                   `protobuf:"varint,1,opt,name=include_pinned,json=includePinned,proto3" json:"include_pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushAllRequest) Reset() {
+	*x = FlushAllRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushAllRequest) ProtoMessage() {}
+
+func (x *FlushAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushAllRequest.ProtoReflect.Descriptor instead.
+func (*FlushAllRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *FlushAllRequest) GetIncludePinned() bool {
+	if x != nil {
+		return x.IncludePinned
+	}
+	return false
+}
+
+type FlushAllResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          int64                  `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushAllResponse) Reset() {
+	*x = FlushAllResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushAllResponse) ProtoMessage() {}
+
+func (x *FlushAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushAllResponse.ProtoReflect.Descriptor instead.
+func (*FlushAllResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *FlushAllResponse) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+type FlushByPrefixRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	IncludePinned bool                   `protobuf:"varint,2,opt,name=include_pinned,json=includePinned,proto3" json:"include_pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushByPrefixRequest) Reset() {
+	*x = FlushByPrefixRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushByPrefixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushByPrefixRequest) ProtoMessage() {}
+
+func (x *FlushByPrefixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushByPrefixRequest.ProtoReflect.Descriptor instead.
+func (*FlushByPrefixRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *FlushByPrefixRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *FlushByPrefixRequest) GetIncludePinned() bool {
+	if x != nil {
+		return x.IncludePinned
+	}
+	return false
+}
+
+type FlushByPrefixResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          int64                  `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushByPrefixResponse) Reset() {
+	*x = FlushByPrefixResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushByPrefixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushByPrefixResponse) ProtoMessage() {}
+
+func (x *FlushByPrefixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushByPrefixResponse.ProtoReflect.Descriptor instead.
+func (*FlushByPrefixResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *FlushByPrefixResponse) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+type SaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveRequest) Reset() {
+	*x = SaveRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRequest) ProtoMessage() {}
+
+func (x *SaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveRequest.ProtoReflect.Descriptor instead.
+func (*SaveRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{131}
+}
+
+type SaveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          int64                  `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveResponse) Reset() {
+	*x = SaveResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveResponse) ProtoMessage() {}
+
+func (x *SaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveResponse.ProtoReflect.Descriptor instead.
+func (*SaveResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *SaveResponse) GetKeys() int64 {
+	if x != nil {
+		return x.Keys
+	}
+	return 0
+}
+
+func (x *SaveResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type TopKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	N             int32                  `protobuf:"varint,1,opt,name=n,proto3" json:"n,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopKeysRequest) Reset() {
+	*x = TopKeysRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopKeysRequest) ProtoMessage() {}
+
+func (x *TopKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopKeysRequest.ProtoReflect.Descriptor instead.
+func (*TopKeysRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *TopKeysRequest) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type KeyStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Hits          int64                  `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses        int64                  `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	Writes        int64                  `protobuf:"varint,4,opt,name=writes,proto3" json:"writes,omitempty"`
+	Qps           float64                `protobuf:"fixed64,5,opt,name=qps,proto3" json:"qps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyStat) Reset() {
+	*x = KeyStat{}
+	mi := &file_cache_v1_cache_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyStat) ProtoMessage() {}
+
+func (x *KeyStat) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyStat.ProtoReflect.Descriptor instead.
+func (*KeyStat) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *KeyStat) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyStat) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *KeyStat) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *KeyStat) GetWrites() int64 {
+	if x != nil {
+		return x.Writes
+	}
+	return 0
+}
+
+func (x *KeyStat) GetQps() float64 {
+	if x != nil {
+		return x.Qps
+	}
+	return 0
+}
+
+type TopKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*KeyStat             `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopKeysResponse) Reset() {
+	*x = TopKeysResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopKeysResponse) ProtoMessage() {}
+
+func (x *TopKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopKeysResponse.ProtoReflect.Descriptor instead.
+func (*TopKeysResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *TopKeysResponse) GetKeys() []*KeyStat {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type CapabilitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapabilitiesRequest) Reset() {
+	*x = CapabilitiesRequest{}
+	mi := &file_cache_v1_cache_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilitiesRequest) ProtoMessage() {}
+
+func (x *CapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*CapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{136}
+}
+
+type CapabilitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Features      map[string]bool        `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	AofFormats    []string               `protobuf:"bytes,3,rep,name=aof_formats,json=aofFormats,proto3" json:"aof_formats,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Limits        *CapabilityLimits      `protobuf:"bytes,5,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapabilitiesResponse) Reset() {
+	*x = CapabilitiesResponse{}
+	mi := &file_cache_v1_cache_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilitiesResponse) ProtoMessage() {}
+
+func (x *CapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*CapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CapabilitiesResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *CapabilitiesResponse) GetFeatures() map[string]bool {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *CapabilitiesResponse) GetAofFormats() []string {
+	if x != nil {
+		return x.AofFormats
+	}
+	return nil
+}
+
+func (x *CapabilitiesResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CapabilitiesResponse) GetLimits() *CapabilityLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type CapabilityLimits struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxValueSize  int64                  `protobuf:"varint,1,opt,name=max_value_size,json=maxValueSize,proto3" json:"max_value_size,omitempty"`
+	MaxKeys       int64                  `protobuf:"varint,2,opt,name=max_keys,json=maxKeys,proto3" json:"max_keys,omitempty"`
+	MaxBytes      int64                  `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapabilityLimits) Reset() {
+	*x = CapabilityLimits{}
+	mi := &file_cache_v1_cache_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapabilityLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilityLimits) ProtoMessage() {}
+
+func (x *CapabilityLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_v1_cache_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilityLimits.ProtoReflect.Descriptor instead.
+func (*CapabilityLimits) Descriptor() ([]byte, []int) {
+	return file_cache_v1_cache_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *CapabilityLimits) GetMaxValueSize() int64 {
+	if x != nil {
+		return x.MaxValueSize
+	}
+	return 0
+}
+
+func (x *CapabilityLimits) GetMaxKeys() int64 {
+	if x != nil {
+		return x.MaxKeys
+	}
+	return 0
+}
+
+func (x *CapabilityLimits) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+var File_cache_v1_cache_proto protoreflect.FileDescriptor
+
+const file_cache_v1_cache_proto_rawDesc = "" +
+	"\n" +
+	"\x14cache/v1/cache.proto\x12\bcache.v1\x1a\x1cgoogle/api/annotations.proto\"\x96\x01\n" +
+	"\x10SetStringRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12!\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05H\x00R\n" +
+	"ttlSeconds\x12-\n" +
+	"\x12expires_at_unix_ms\x18\x04 \x01(\x03H\x00R\x0fexpiresAtUnixMsB\b\n" +
+	"\x06expiry\"\x13\n" +
+	"\x11SetStringResponse\"Z\n" +
+	"\x11PSetStringRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"ttl_millis\x18\x03 \x01(\x03R\tttlMillis\"\x14\n" +
+	"\x12PSetStringResponse\"]\n" +
+	"\x12SetStringNXRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds\"/\n" +
+	"\x13SetStringNXResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x86\x01\n" +
+	"\x17SetStringIfNewerRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds\x12\"\n" +
+	"\revent_time_ms\x18\x04 \x01(\x03R\veventTimeMs\"X\n" +
+	"\x18SetStringIfNewerResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x12\"\n" +
+	"\revent_time_ms\x18\x02 \x01(\x03R\veventTimeMs\"P\n" +
+	"\x10GetStringRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x11touch_ttl_seconds\x18\x02 \x01(\x05R\x0ftouchTtlSeconds\")\n" +
+	"\x11GetStringResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"/\n" +
+	"\x1bGetStringWithVersionRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"N\n" +
+	"\x1cGetStringWithVersionResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\"\x8f\x01\n" +
+	"\x19SetStringIfVersionRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x04R\x0fexpectedVersion\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x05R\n" +
+	"ttlSeconds\"P\n" +
+	"\x1aSetStringIfVersionResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\"Z\n" +
+	"\x0fSetBytesRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds\"\x12\n" +
+	"\x10SetBytesResponse\"#\n" +
+	"\x0fGetBytesRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"(\n" +
+	"\x10GetBytesResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\fR\x05value\"$\n" +
+	"\x10DelStringRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x13\n" +
+	"\x11DelStringResponse\"R\n" +
+	"\x17CompareAndDeleteRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12%\n" +
+	"\x0eexpected_value\x18\x02 \x01(\tR\rexpectedValue\"4\n" +
+	"\x18CompareAndDeleteResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"\xb1\x01\n" +
+	"\x15CompareAndSwapRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12%\n" +
+	"\x0eexpected_value\x18\x02 \x01(\tR\rexpectedValue\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x05R\n" +
+	"ttlSeconds\x12(\n" +
+	"\x10missing_as_empty\x18\x05 \x01(\bR\x0emissingAsEmpty\"2\n" +
+	"\x16CompareAndSwapResponse\x12\x18\n" +
+	"\aswapped\x18\x01 \x01(\bR\aswapped\"Z\n" +
+	"\rAppendRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06suffix\x18\x02 \x01(\tR\x06suffix\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x03R\n" +
+	"ttlSeconds\"(\n" +
+	"\x0eAppendResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"!\n" +
+	"\rStrlenRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"(\n" +
+	"\x0eStrlenResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"!\n" +
+	"\rGetDelRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"&\n" +
+	"\x0eGetDelResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"7\n" +
+	"\rGetSetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"&\n" +
+	"\x0eGetSetResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"A\n" +
+	"\fGetExRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x05R\n" +
+	"ttlSeconds\"%\n" +
+	"\rGetExResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\xb0\x02\n" +
+	"\vMSetRequest\x126\n" +
+	"\x05items\x18\x01 \x03(\v2 .cache.v1.MSetRequest.ItemsEntryR\x05items\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x05R\n" +
+	"ttlSeconds\x12M\n" +
+	"\x0eevent_times_ms\x18\x03 \x03(\v2'.cache.v1.MSetRequest.EventTimesMsEntryR\feventTimesMs\x1a8\n" +
+	"\n" +
+	"ItemsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11EventTimesMsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x89\x01\n" +
+	"\fMSetResponse\x12=\n" +
+	"\askipped\x18\x01 \x03(\v2#.cache.v1.MSetResponse.SkippedEntryR\askipped\x1a:\n" +
+	"\fSkippedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"!\n" +
+	"\vMGetRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"I\n" +
+	"\tMGetEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x14\n" +
+	"\x05found\x18\x03 \x01(\bR\x05found\"\xb0\x01\n" +
+	"\fMGetResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.cache.v1.MGetResponse.ItemsEntryR\x05items\x12-\n" +
+	"\aentries\x18\x02 \x03(\v2\x13.cache.v1.MGetEntryR\aentries\x1a8\n" +
+	"\n" +
+	"ItemsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"#\n" +
+	"\rExistsRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"&\n" +
+	"\x0eExistsResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"!\n" +
+	"\vMDelRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"(\n" +
+	"\fMDelResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x03R\adeleted\"j\n" +
+	"\x0fPipelineCommand\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\x05R\n" +
+	"ttlSeconds\"R\n" +
+	"\x0ePipelineResult\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"D\n" +
+	"\vExecRequest\x125\n" +
+	"\bcommands\x18\x01 \x03(\v2\x19.cache.v1.PipelineCommandR\bcommands\"B\n" +
+	"\fExecResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.cache.v1.PipelineResultR\aresults\"Q\n" +
+	"\x10ReplicateRequest\x12%\n" +
+	"\x0ereplication_id\x18\x01 \x01(\tR\rreplicationId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\"\x86\x01\n" +
+	"\x0eReplicateEvent\x12%\n" +
+	"\x0ereplication_id\x18\x01 \x01(\tR\rreplicationId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x1b\n" +
+	"\tfull_sync\x18\x03 \x01(\bR\bfullSync\x12\x18\n" +
+	"\acommand\x18\x04 \x01(\fR\acommand\"7\n" +
+	"\rIncrByRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x03R\x05delta\"&\n" +
+	"\x0eIncrByResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\"7\n" +
+	"\rDecrByRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x03R\x05delta\"&\n" +
+	"\x0eDecrByResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x03R\x05value\"K\n" +
+	"\vHSetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\x0e\n" +
+	"\fHSetResponse\"5\n" +
+	"\vHGetRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\"$\n" +
+	"\fHGetResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"5\n" +
+	"\vHDelRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\"(\n" +
+	"\fHDelResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"\"\n" +
+	"\x0eHGetAllRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x8b\x01\n" +
+	"\x0fHGetAllResponse\x12=\n" +
+	"\x06fields\x18\x01 \x03(\v2%.cache.v1.HGetAllResponse.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x1f\n" +
+	"\vHLenRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"&\n" +
+	"\fHLenResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"8\n" +
+	"\fLPushRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"'\n" +
+	"\rLPushResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"8\n" +
+	"\fRPushRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\"'\n" +
+	"\rRPushResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"\x1f\n" +
+	"\vLPopRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"$\n" +
+	"\fLPopResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"\x1f\n" +
+	"\vRPopRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"$\n" +
+	"\fRPopResponse\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\"K\n" +
+	"\rLRangeRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x03R\x05start\x12\x12\n" +
+	"\x04stop\x18\x03 \x01(\x03R\x04stop\"(\n" +
+	"\x0eLRangeResponse\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\x1f\n" +
+	"\vLLenRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"&\n" +
+	"\fLLenResponse\x12\x16\n" +
+	"\x06length\x18\x01 \x01(\x03R\x06length\"'\n" +
+	"\vKeysRequest\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\"\"\n" +
+	"\fKeysResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"U\n" +
+	"\vScanRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\x04R\x06cursor\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\":\n" +
+	"\fScanResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\x04R\x06cursor\"/\n" +
+	"\x13WatchExpiredRequest\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\"^\n" +
+	"\fExpiredEvent\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\"\n" +
+	"\rexpires_at_ms\x18\x02 \x01(\x03R\vexpiresAtMs\x12\x18\n" +
+	"\adropped\x18\x03 \x01(\x04R\adropped\"\x12\n" +
+	"\x10RandomKeyRequest\"%\n" +
+	"\x11RandomKeyResponse\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x1f\n" +
+	"\vTypeRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\"\n" +
+	"\fTypeResponse\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\"\x0f\n" +
+	"\rDBSizeRequest\"C\n" +
+	"\x0eDBSizeResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x01(\x03R\x04keys\x12\x1d\n" +
+	"\n" +
+	"shard_keys\x18\x02 \x03(\x03R\tshardKeys\"&\n" +
+	"\x12MemoryUsageRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"+\n" +
+	"\x13MemoryUsageResponse\x12\x14\n" +
+	"\x05bytes\x18\x01 \x01(\x03R\x05bytes\"!\n" +
+	"\rGetTTLRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"Q\n" +
+	"\x0eGetTTLResponse\x12\x1f\n" +
+	"\vttl_seconds\x18\x01 \x01(\x03R\n" +
+	"ttlSeconds\x12\x1e\n" +
+	"\n" +
+	"persistent\x18\x02 \x01(\bR\n" +
+	"persistent\"\x1f\n" +
+	"\vPTTLRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"M\n" +
+	"\fPTTLResponse\x12\x1d\n" +
+	"\n" +
+	"ttl_millis\x18\x01 \x01(\x03R\tttlMillis\x12\x1e\n" +
+	"\n" +
+	"persistent\x18\x02 \x01(\bR\n" +
+	"persistent\"B\n" +
+	"\rExpireRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x05R\n" +
+	"ttlSeconds\"*\n" +
+	"\x0eExpireResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\bR\aupdated\"A\n" +
+	"\fTouchRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x05R\n" +
+	"ttlSeconds\")\n" +
+	"\rTouchResponse\x12\x18\n" +
+	"\atouched\x18\x01 \x01(\bR\atouched\"&\n" +
+	"\x10TouchKeysRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\")\n" +
+	"\x11TouchKeysResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\":\n" +
+	"\rRenameRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x17\n" +
+	"\anew_key\x18\x02 \x01(\tR\x06newKey\"\x10\n" +
+	"\x0eRenameResponse\"\"\n" +
+	"\x0ePersistRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"+\n" +
+	"\x0fPersistResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\bR\aupdated\"H\n" +
+	"\n" +
+	"PinRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
+	"\x10pin_ttl_override\x18\x02 \x01(\bR\x0epinTtlOverride\"\r\n" +
+	"\vPinResponse\" \n" +
+	"\fUnpinRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\x0f\n" +
+	"\rUnpinResponse\"\x13\n" +
+	"\x11ListPinnedRequest\"T\n" +
+	"\x12ListPinnedResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x14\n" +
+	"\x05bytes\x18\x03 \x01(\x03R\x05bytes\"\x0e\n" +
+	"\fStatsRequest\"s\n" +
+	"\n" +
+	"ShardStats\x12\x12\n" +
+	"\x04keys\x18\x01 \x01(\x03R\x04keys\x12\x1b\n" +
+	"\tpeak_keys\x18\x02 \x01(\x03R\bpeakKeys\x124\n" +
+	"\tlock_wait\x18\x03 \x01(\v2\x17.cache.v1.LockWaitStatsR\blockWait\"\x85\x01\n" +
+	"\rLockWaitStats\x12%\n" +
+	"\x0ebucket_seconds\x18\x01 \x03(\x01R\rbucketSeconds\x12\x16\n" +
+	"\x06counts\x18\x02 \x03(\x04R\x06counts\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\x12\x1f\n" +
+	"\vsum_seconds\x18\x04 \x01(\x01R\n" +
+	"sumSeconds\"y\n" +
+	"\vDefragStats\x12\x16\n" +
+	"\x06passes\x18\x01 \x01(\x04R\x06passes\x12%\n" +
+	"\x0eshards_rebuilt\x18\x02 \x01(\x04R\rshardsRebuilt\x12+\n" +
+	"\x11reclaimed_buckets\x18\x03 \x01(\x04R\x10reclaimedBuckets\"\xb4\x01\n" +
+	"\rEvictionStats\x12\x16\n" +
+	"\x06policy\x18\x01 \x01(\tR\x06policy\x12\x19\n" +
+	"\bmax_keys\x18\x02 \x01(\x03R\amaxKeys\x12\x18\n" +
+	"\aevicted\x18\x03 \x01(\x04R\aevicted\x12\x1a\n" +
+	"\brejected\x18\x04 \x01(\x04R\brejected\x12\x1b\n" +
+	"\tmax_bytes\x18\x05 \x01(\x03R\bmaxBytes\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x06 \x01(\x03R\tusedBytes\"\x99\a\n" +
+	"\rStatsResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x01(\x03R\x04keys\x12,\n" +
+	"\x06shards\x18\x02 \x03(\v2\x14.cache.v1.ShardStatsR\x06shards\x12-\n" +
+	"\x06defrag\x18\x03 \x01(\v2\x15.cache.v1.DefragStatsR\x06defrag\x123\n" +
+	"\beviction\x18\x04 \x01(\v2\x17.cache.v1.EvictionStatsR\beviction\x12\x12\n" +
+	"\x04hits\x18\x05 \x01(\x04R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x06 \x01(\x04R\x06misses\x12\x18\n" +
+	"\aexpired\x18\a \x01(\x04R\aexpired\x12\"\n" +
+	"\raof_file_size\x18\b \x01(\x03R\vaofFileSize\x12&\n" +
+	"\x0faof_queue_depth\x18\t \x01(\x03R\raofQueueDepth\x12%\n" +
+	"\x0euptime_seconds\x18\n" +
+	" \x01(\x03R\ruptimeSeconds\x12(\n" +
+	"\x10aof_write_errors\x18\v \x01(\x04R\x0eaofWriteErrors\x12,\n" +
+	"\x12aof_queue_rejected\x18\f \x01(\x04R\x10aofQueueRejected\x12\x12\n" +
+	"\x04sets\x18\r \x01(\x04R\x04sets\x12\x18\n" +
+	"\adeletes\x18\x0e \x01(\x04R\adeletes\x12\x1b\n" +
+	"\thit_ratio\x18\x0f \x01(\x01R\bhitRatio\x12!\n" +
+	"\faof_replayed\x18\x10 \x01(\x03R\vaofReplayed\x12,\n" +
+	"\x12aof_replay_skipped\x18\x11 \x01(\x03R\x10aofReplaySkipped\x12<\n" +
+	"\vreplication\x18\x12 \x01(\v2\x1a.cache.v1.ReplicationStatsR\vreplication\x12@\n" +
+	"\rexpire_sample\x18\x13 \x01(\v2\x1b.cache.v1.ExpireSampleStatsR\fexpireSample\x12\x1f\n" +
+	"\vpinned_keys\x18\x14 \x01(\x03R\n" +
+	"pinnedKeys\x12!\n" +
+	"\fpinned_bytes\x18\x15 \x01(\x03R\vpinnedBytes\x12H\n" +
+	"\x14time_wheel_lock_wait\x18\x16 \x01(\v2\x17.cache.v1.LockWaitStatsR\x11timeWheelLockWait\x12'\n" +
+	"\x04disk\x18\x17 \x01(\v2\x13.cache.v1.DiskStatsR\x04disk\"\xbf\x02\n" +
+	"\tDiskStats\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"free_bytes\x18\x02 \x01(\x03R\tfreeBytes\x12\x1f\n" +
+	"\vtotal_bytes\x18\x03 \x01(\x03R\n" +
+	"totalBytes\x125\n" +
+	"\x17growth_bytes_per_second\x18\x04 \x01(\x01R\x14growthBytesPerSecond\x12/\n" +
+	"\x14time_to_full_seconds\x18\x05 \x01(\x03R\x11timeToFullSeconds\x12 \n" +
+	"\vcompactions\x18\x06 \x01(\x04R\vcompactions\x12'\n" +
+	"\x0frejected_writes\x18\a \x01(\x04R\x0erejectedWrites\x12)\n" +
+	"\x10dropped_commands\x18\b \x01(\x04R\x0fdroppedCommands\"l\n" +
+	"\x11ExpireSampleStats\x12\x1a\n" +
+	"\bexamined\x18\x01 \x01(\x04R\bexamined\x12\x18\n" +
+	"\aexpired\x18\x02 \x01(\x04R\aexpired\x12!\n" +
+	"\ftime_limited\x18\x03 \x01(\x04R\vtimeLimited\"\xae\x01\n" +
+	"\x10ReplicationStats\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12%\n" +
+	"\x0ereplication_id\x18\x02 \x01(\tR\rreplicationId\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\x12\x1c\n" +
+	"\tfollowers\x18\x04 \x01(\x03R\tfollowers\x12)\n" +
+	"\x10leader_connected\x18\x05 \x01(\bR\x0fleaderConnected\"%\n" +
+	"\rDefragRequest\x12\x14\n" +
+	"\x05force\x18\x01 \x01(\bR\x05force\"d\n" +
+	"\x0eDefragResponse\x12%\n" +
+	"\x0eshards_rebuilt\x18\x01 \x01(\x03R\rshardsRebuilt\x12+\n" +
+	"\x11reclaimed_buckets\x18\x02 \x01(\x04R\x10reclaimedBuckets\"\x13\n" +
+	"\x11RewriteAOFRequest\"^\n" +
+	"\x12RewriteAOFResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x01(\x03R\x04keys\x12\x19\n" +
+	"\bold_size\x18\x02 \x01(\x03R\aoldSize\x12\x19\n" +
+	"\bnew_size\x18\x03 \x01(\x03R\anewSize\"\xff\x01\n" +
+	"\vExportEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x123\n" +
+	"\x04hash\x18\x03 \x03(\v2\x1f.cache.v1.ExportEntry.HashEntryR\x04hash\x12\x12\n" +
+	"\x04list\x18\x04 \x03(\fR\x04list\x12\"\n" +
+	"\rexpires_at_ms\x18\x05 \x01(\x03R\vexpiresAtMs\x12\"\n" +
+	"\revent_time_ms\x18\x06 \x01(\x03R\veventTimeMs\x1a7\n" +
+	"\tHashEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\".\n" +
+	"\rExportRequest\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x01 \x01(\x05R\tbatchSize\"A\n" +
+	"\x0eExportResponse\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.cache.v1.ExportEntryR\aentries\"Z\n" +
+	"\rImportRequest\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.cache.v1.ExportEntryR\aentries\x12\x18\n" +
+	"\areplace\x18\x02 \x01(\bR\areplace\"h\n" +
+	"\x0eImportResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x03R\bimported\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x03R\askipped\x12 \n" +
+	"\voverwritten\x18\x03 \x01(\x03R\voverwritten\"8\n" +
+	"\x0fFlushAllRequest\x12%\n" +
+	"\x0einclude_pinned\x18\x01 \x01(\bR\rincludePinned\"&\n" +
+	"\x10FlushAllResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x01(\x03R\x04keys\"U\n" +
+	"\x14FlushByPrefixRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12%\n" +
+	"\x0einclude_pinned\x18\x02 \x01(\bR\rincludePinned\"+\n" +
+	"\x15FlushByPrefixResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x01(\x03R\x04keys\"\r\n" +
+	"\vSaveRequest\"6\n" +
+	"\fSaveResponse\x12\x12\n" +
+	"\x04keys\x18\x01 \x01(\x03R\x04keys\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\"\x1e\n" +
+	"\x0eTopKeysRequest\x12\f\n" +
+	"\x01n\x18\x01 \x01(\x05R\x01n\"q\n" +
+	"\aKeyStat\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04hits\x18\x02 \x01(\x03R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x03 \x01(\x03R\x06misses\x12\x16\n" +
+	"\x06writes\x18\x04 \x01(\x03R\x06writes\x12\x10\n" +
+	"\x03qps\x18\x05 \x01(\x01R\x03qps\"8\n" +
+	"\x0fTopKeysResponse\x12%\n" +
+	"\x04keys\x18\x01 \x03(\v2\x11.cache.v1.KeyStatR\x04keys\"\x15\n" +
+	"\x13CapabilitiesRequest\"\xa0\x02\n" +
+	"\x14CapabilitiesResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12H\n" +
+	"\bfeatures\x18\x02 \x03(\v2,.cache.v1.CapabilitiesResponse.FeaturesEntryR\bfeatures\x12\x1f\n" +
+	"\vaof_formats\x18\x03 \x03(\tR\n" +
+	"aofFormats\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x122\n" +
+	"\x06limits\x18\x05 \x01(\v2\x1a.cache.v1.CapabilityLimitsR\x06limits\x1a;\n" +
+	"\rFeaturesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"p\n" +
+	"\x10CapabilityLimits\x12$\n" +
+	"\x0emax_value_size\x18\x01 \x01(\x03R\fmaxValueSize\x12\x19\n" +
+	"\bmax_keys\x18\x02 \x01(\x03R\amaxKeys\x12\x1b\n" +
+	"\tmax_bytes\x18\x03 \x01(\x03R\bmaxBytes2\xfc/\n" +
+	"\fCacheService\x12g\n" +
+	"\tSetString\x12\x1a.cache.v1.SetStringRequest\x1a\x1b.cache.v1.SetStringResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/cache/string/{key}\x12m\n" +
+	"\n" +
+	"PSetString\x12\x1b.cache.v1.PSetStringRequest\x1a\x1c.cache.v1.PSetStringResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/cache/string/{key}/px\x12p\n" +
+	"\vSetStringNX\x12\x1c.cache.v1.SetStringNXRequest\x1a\x1d.cache.v1.SetStringNXResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/cache/string/{key}/nx\x12\x85\x01\n" +
+	"\x10SetStringIfNewer\x12!.cache.v1.SetStringIfNewerRequest\x1a\".cache.v1.SetStringIfNewerResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/cache/string/{key}/if-newer\x12d\n" +
+	"\tGetString\x12\x1a.cache.v1.GetStringRequest\x1a\x1b.cache.v1.GetStringResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/cache/string/{key}\x12\x8d\x01\n" +
+	"\x14GetStringWithVersion\x12%.cache.v1.GetStringWithVersionRequest\x1a&.cache.v1.GetStringWithVersionResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/cache/string/{key}/version\x12\x8d\x01\n" +
+	"\x12SetStringIfVersion\x12#.cache.v1.SetStringIfVersionRequest\x1a$.cache.v1.SetStringIfVersionResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/cache/string/{key}/if-version\x12c\n" +
+	"\bSetBytes\x12\x19.cache.v1.SetBytesRequest\x1a\x1a.cache.v1.SetBytesResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/cache/bytes/{key}\x12`\n" +
+	"\bGetBytes\x12\x19.cache.v1.GetBytesRequest\x1a\x1a.cache.v1.GetBytesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/cache/bytes/{key}\x12d\n" +
+	"\tDelString\x12\x1a.cache.v1.DelStringRequest\x1a\x1b.cache.v1.DelStringResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/cache/string/{key}\x12\x80\x01\n" +
+	"\x10CompareAndDelete\x12!.cache.v1.CompareAndDeleteRequest\x1a\".cache.v1.CompareAndDeleteResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/cache/string/{key}/cad\x12z\n" +
+	"\x0eCompareAndSwap\x12\x1f.cache.v1.CompareAndSwapRequest\x1a .cache.v1.CompareAndSwapResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/cache/string/{key}/cas\x12e\n" +
+	"\x06Append\x12\x17.cache.v1.AppendRequest\x1a\x18.cache.v1.AppendResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/cache/string/{key}/append\x12[\n" +
+	"\x06Strlen\x12\x17.cache.v1.StrlenRequest\x1a\x18.cache.v1.StrlenResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/cache/strlen/{key}\x12e\n" +
+	"\x06GetDel\x12\x17.cache.v1.GetDelRequest\x1a\x18.cache.v1.GetDelResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/cache/string/{key}/getdel\x12e\n" +
+	"\x06GetSet\x12\x17.cache.v1.GetSetRequest\x1a\x18.cache.v1.GetSetResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/cache/string/{key}/getset\x12a\n" +
+	"\x05GetEx\x12\x16.cache.v1.GetExRequest\x1a\x17.cache.v1.GetExResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/cache/string/{key}/getex\x12P\n" +
+	"\x04MSet\x12\x15.cache.v1.MSetRequest\x1a\x16.cache.v1.MSetResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/cache/mset\x12M\n" +
+	"\x04MGet\x12\x15.cache.v1.MGetRequest\x1a\x16.cache.v1.MGetResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/cache/mget\x12U\n" +
+	"\x06Exists\x12\x17.cache.v1.ExistsRequest\x1a\x18.cache.v1.ExistsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/cache/exists\x12P\n" +
+	"\x04MDel\x12\x15.cache.v1.MDelRequest\x1a\x16.cache.v1.MDelResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/cache/mdel\x12P\n" +
+	"\x04Exec\x12\x15.cache.v1.ExecRequest\x1a\x16.cache.v1.ExecResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/cache/exec\x12C\n" +
+	"\tReplicate\x12\x1a.cache.v1.ReplicateRequest\x1a\x18.cache.v1.ReplicateEvent0\x01\x12\\\n" +
+	"\x06IncrBy\x12\x17.cache.v1.IncrByRequest\x1a\x18.cache.v1.IncrByResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/cache/incr/{key}\x12\\\n" +
+	"\x06DecrBy\x12\x17.cache.v1.DecrByRequest\x1a\x18.cache.v1.DecrByResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/cache/decr/{key}\x12V\n" +
+	"\x04HSet\x12\x15.cache.v1.HSetRequest\x1a\x16.cache.v1.HSetResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/cache/hash/{key}\x12[\n" +
+	"\x04HGet\x12\x15.cache.v1.HGetRequest\x1a\x16.cache.v1.HGetResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/cache/hash/{key}/{field}\x12[\n" +
+	"\x04HDel\x12\x15.cache.v1.HDelRequest\x1a\x16.cache.v1.HDelResponse\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/cache/hash/{key}/{field}\x12\\\n" +
+	"\aHGetAll\x12\x18.cache.v1.HGetAllRequest\x1a\x19.cache.v1.HGetAllResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/cache/hash/{key}\x12S\n" +
+	"\x04HLen\x12\x15.cache.v1.HLenRequest\x1a\x16.cache.v1.HLenResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/cache/hlen/{key}\x12_\n" +
+	"\x05LPush\x12\x16.cache.v1.LPushRequest\x1a\x17.cache.v1.LPushResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/cache/list/{key}/lpush\x12_\n" +
+	"\x05RPush\x12\x16.cache.v1.RPushRequest\x1a\x17.cache.v1.RPushResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/cache/list/{key}/rpush\x12[\n" +
+	"\x04LPop\x12\x15.cache.v1.LPopRequest\x1a\x16.cache.v1.LPopResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/cache/list/{key}/lpop\x12[\n" +
+	"\x04RPop\x12\x15.cache.v1.RPopRequest\x1a\x16.cache.v1.RPopResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/cache/list/{key}/rpop\x12Y\n" +
+	"\x06LRange\x12\x17.cache.v1.LRangeRequest\x1a\x18.cache.v1.LRangeResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/cache/list/{key}\x12S\n" +
+	"\x04LLen\x12\x15.cache.v1.LLenRequest\x1a\x16.cache.v1.LLenResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/cache/llen/{key}\x12M\n" +
+	"\x04Keys\x12\x15.cache.v1.KeysRequest\x1a\x16.cache.v1.KeysResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/cache/keys\x12M\n" +
+	"\x04Scan\x12\x15.cache.v1.ScanRequest\x1a\x16.cache.v1.ScanResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/cache/scan\x12=\n" +
+	"\n" +
+	"ScanStream\x12\x15.cache.v1.ScanRequest\x1a\x16.cache.v1.ScanResponse0\x01\x12G\n" +
+	"\fWatchExpired\x12\x1d.cache.v1.WatchExpiredRequest\x1a\x16.cache.v1.ExpiredEvent0\x01\x12a\n" +
+	"\tRandomKey\x12\x1a.cache.v1.RandomKeyRequest\x1a\x1b.cache.v1.RandomKeyResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/cache/randomkey\x12S\n" +
+	"\x04Type\x12\x15.cache.v1.TypeRequest\x1a\x16.cache.v1.TypeResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/cache/type/{key}\x12U\n" +
+	"\x06DBSize\x12\x17.cache.v1.DBSizeRequest\x1a\x18.cache.v1.DBSizeResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/cache/dbsize\x12j\n" +
+	"\vMemoryUsage\x12\x1c.cache.v1.MemoryUsageRequest\x1a\x1d.cache.v1.MemoryUsageResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/cache/memory/{key}\x12X\n" +
+	"\x06GetTTL\x12\x17.cache.v1.GetTTLRequest\x1a\x18.cache.v1.GetTTLResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/cache/ttl/{key}\x12S\n" +
+	"\x04PTTL\x12\x15.cache.v1.PTTLRequest\x1a\x16.cache.v1.PTTLResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/cache/pttl/{key}\x12^\n" +
+	"\x06Expire\x12\x17.cache.v1.ExpireRequest\x1a\x18.cache.v1.ExpireResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/cache/expire/{key}\x12Z\n" +
+	"\x05Touch\x12\x16.cache.v1.TouchRequest\x1a\x17.cache.v1.TouchResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/cache/touch/{key}\x12`\n" +
+	"\tTouchKeys\x12\x1a.cache.v1.TouchKeysRequest\x1a\x1b.cache.v1.TouchKeysResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/cache/touch\x12^\n" +
+	"\x06Rename\x12\x17.cache.v1.RenameRequest\x1a\x18.cache.v1.RenameResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/cache/rename/{key}\x12b\n" +
+	"\aPersist\x12\x18.cache.v1.PersistRequest\x1a\x19.cache.v1.PersistResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/cache/persist/{key}\x12R\n" +
+	"\x03Pin\x12\x14.cache.v1.PinRequest\x1a\x15.cache.v1.PinResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/cache/pin/{key}\x12U\n" +
+	"\x05Unpin\x12\x16.cache.v1.UnpinRequest\x1a\x17.cache.v1.UnpinResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/cache/pin/{key}\x12a\n" +
+	"\n" +
+	"ListPinned\x12\x1b.cache.v1.ListPinnedRequest\x1a\x1c.cache.v1.ListPinnedResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/cache/pinned\x12Q\n" +
+	"\x05Stats\x12\x16.cache.v1.StatsRequest\x1a\x17.cache.v1.StatsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/cache/stats\x12^\n" +
+	"\x06Defrag\x12\x17.cache.v1.DefragRequest\x1a\x18.cache.v1.DefragResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/cache/admin/defrag\x12o\n" +
+	"\n" +
+	"RewriteAOF\x12\x1b.cache.v1.RewriteAOFRequest\x1a\x1c.cache.v1.RewriteAOFResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/cache/admin/rewrite-aof\x12f\n" +
+	"\bFlushAll\x12\x19.cache.v1.FlushAllRequest\x1a\x1a.cache.v1.FlushAllResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/cache/admin/flushall\x12y\n" +
+	"\rFlushByPrefix\x12\x1e.cache.v1.FlushByPrefixRequest\x1a\x1f.cache.v1.FlushByPrefixResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/cache/admin/flush-prefix\x12V\n" +
+	"\x04Save\x12\x15.cache.v1.SaveRequest\x1a\x16.cache.v1.SaveResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/cache/admin/save\x12=\n" +
+	"\x06Export\x12\x17.cache.v1.ExportRequest\x1a\x18.cache.v1.ExportResponse0\x01\x12=\n" +
+	"\x06Import\x12\x17.cache.v1.ImportRequest\x1a\x18.cache.v1.ImportResponse(\x01\x12Y\n" +
+	"\aTopKeys\x12\x18.cache.v1.TopKeysRequest\x1a\x19.cache.v1.TopKeysResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/cache/hotkeys\x12m\n" +
+	"\fCapabilities\x12\x1d.cache.v1.CapabilitiesRequest\x1a\x1e.cache.v1.CapabilitiesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/cache/capabilitiesB!Z\x1fgocache-service/api/cache/v1;v1b\x06proto3"
+
+var (
+	file_cache_v1_cache_proto_rawDescOnce sync.Once
+	file_cache_v1_cache_proto_rawDescData []byte
+)
+
+func file_cache_v1_cache_proto_rawDescGZIP() []byte {
+	file_cache_v1_cache_proto_rawDescOnce.Do(func() {
+		file_cache_v1_cache_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cache_v1_cache_proto_rawDesc), len(file_cache_v1_cache_proto_rawDesc)))
+	})
+	return file_cache_v1_cache_proto_rawDescData
+}
+
+var file_cache_v1_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 146)
+var file_cache_v1_cache_proto_goTypes = []any{
+	(*SetStringRequest)(nil),             // 0: cache.v1.SetStringRequest
+	(*SetStringResponse)(nil),            // 1: cache.v1.SetStringResponse
+	(*PSetStringRequest)(nil),            // 2: cache.v1.PSetStringRequest
+	(*PSetStringResponse)(nil),           // 3: cache.v1.PSetStringResponse
+	(*SetStringNXRequest)(nil),           // 4: cache.v1.SetStringNXRequest
+	(*SetStringNXResponse)(nil),          // 5: cache.v1.SetStringNXResponse
+	(*SetStringIfNewerRequest)(nil),      // 6: cache.v1.SetStringIfNewerRequest
+	(*SetStringIfNewerResponse)(nil),     // 7: cache.v1.SetStringIfNewerResponse
+	(*GetStringRequest)(nil),             // 8: cache.v1.GetStringRequest
+	(*GetStringResponse)(nil),            // 9: cache.v1.GetStringResponse
+	(*GetStringWithVersionRequest)(nil),  // 10: cache.v1.GetStringWithVersionRequest
+	(*GetStringWithVersionResponse)(nil), // 11: cache.v1.GetStringWithVersionResponse
+	(*SetStringIfVersionRequest)(nil),    // 12: cache.v1.SetStringIfVersionRequest
+	(*SetStringIfVersionResponse)(nil),   // 13: cache.v1.SetStringIfVersionResponse
+	(*SetBytesRequest)(nil),              // 14: cache.v1.SetBytesRequest
+	(*SetBytesResponse)(nil),             // 15: cache.v1.SetBytesResponse
+	(*GetBytesRequest)(nil),              // 16: cache.v1.GetBytesRequest
+	(*GetBytesResponse)(nil),             // 17: cache.v1.GetBytesResponse
+	(*DelStringRequest)(nil),             // 18: cache.v1.DelStringRequest
+	(*DelStringResponse)(nil),            // 19: cache.v1.DelStringResponse
+	(*CompareAndDeleteRequest)(nil),      // 20: cache.v1.CompareAndDeleteRequest
+	(*CompareAndDeleteResponse)(nil),     // 21: cache.v1.CompareAndDeleteResponse
+	(*CompareAndSwapRequest)(nil),        // 22: cache.v1.CompareAndSwapRequest
+	(*CompareAndSwapResponse)(nil),       // 23: cache.v1.CompareAndSwapResponse
+	(*AppendRequest)(nil),                // 24: cache.v1.AppendRequest
+	(*AppendResponse)(nil),               // 25: cache.v1.AppendResponse
+	(*StrlenRequest)(nil),                // 26: cache.v1.StrlenRequest
+	(*StrlenResponse)(nil),               // 27: cache.v1.StrlenResponse
+	(*GetDelRequest)(nil),                // 28: cache.v1.GetDelRequest
+	(*GetDelResponse)(nil),               // 29: cache.v1.GetDelResponse
+	(*GetSetRequest)(nil),                // 30: cache.v1.GetSetRequest
+	(*GetSetResponse)(nil),               // 31: cache.v1.GetSetResponse
+	(*GetExRequest)(nil),                 // 32: cache.v1.GetExRequest
+	(*GetExResponse)(nil),                // 33: cache.v1.GetExResponse
+	(*MSetRequest)(nil),                  // 34: cache.v1.MSetRequest
+	(*MSetResponse)(nil),                 // 35: cache.v1.MSetResponse
+	(*MGetRequest)(nil),                  // 36: cache.v1.MGetRequest
+	(*MGetEntry)(nil),                    // 37: cache.v1.MGetEntry
+	(*MGetResponse)(nil),                 // 38: cache.v1.MGetResponse
+	(*ExistsRequest)(nil),                // 39: cache.v1.ExistsRequest
+	(*ExistsResponse)(nil),               // 40: cache.v1.ExistsResponse
+	(*MDelRequest)(nil),                  // 41: cache.v1.MDelRequest
+	(*MDelResponse)(nil),                 // 42: cache.v1.MDelResponse
+	(*PipelineCommand)(nil),              // 43: cache.v1.PipelineCommand
+	(*PipelineResult)(nil),               // 44: cache.v1.PipelineResult
+	(*ExecRequest)(nil),                  // 45: cache.v1.ExecRequest
+	(*ExecResponse)(nil),                 // 46: cache.v1.ExecResponse
+	(*ReplicateRequest)(nil),             // 47: cache.v1.ReplicateRequest
+	(*ReplicateEvent)(nil),               // 48: cache.v1.ReplicateEvent
+	(*IncrByRequest)(nil),                // 49: cache.v1.IncrByRequest
+	(*IncrByResponse)(nil),               // 50: cache.v1.IncrByResponse
+	(*DecrByRequest)(nil),                // 51: cache.v1.DecrByRequest
+	(*DecrByResponse)(nil),               // 52: cache.v1.DecrByResponse
+	(*HSetRequest)(nil),                  // 53: cache.v1.HSetRequest
+	(*HSetResponse)(nil),                 // 54: cache.v1.HSetResponse
+	(*HGetRequest)(nil),                  // 55: cache.v1.HGetRequest
+	(*HGetResponse)(nil),                 // 56: cache.v1.HGetResponse
+	(*HDelRequest)(nil),                  // 57: cache.v1.HDelRequest
+	(*HDelResponse)(nil),                 // 58: cache.v1.HDelResponse
+	(*HGetAllRequest)(nil),               // 59: cache.v1.HGetAllRequest
+	(*HGetAllResponse)(nil),              // 60: cache.v1.HGetAllResponse
+	(*HLenRequest)(nil),                  // 61: cache.v1.HLenRequest
+	(*HLenResponse)(nil),                 // 62: cache.v1.HLenResponse
+	(*LPushRequest)(nil),                 // 63: cache.v1.LPushRequest
+	(*LPushResponse)(nil),                // 64: cache.v1.LPushResponse
+	(*RPushRequest)(nil),                 // 65: cache.v1.RPushRequest
+	(*RPushResponse)(nil),                // 66: cache.v1.RPushResponse
+	(*LPopRequest)(nil),                  // 67: cache.v1.LPopRequest
+	(*LPopResponse)(nil),                 // 68: cache.v1.LPopResponse
+	(*RPopRequest)(nil),                  // 69: cache.v1.RPopRequest
+	(*RPopResponse)(nil),                 // 70: cache.v1.RPopResponse
+	(*LRangeRequest)(nil),                // 71: cache.v1.LRangeRequest
+	(*LRangeResponse)(nil),               // 72: cache.v1.LRangeResponse
+	(*LLenRequest)(nil),                  // 73: cache.v1.LLenRequest
+	(*LLenResponse)(nil),                 // 74: cache.v1.LLenResponse
+	(*KeysRequest)(nil),                  // 75: cache.v1.KeysRequest
+	(*KeysResponse)(nil),                 // 76: cache.v1.KeysResponse
+	(*ScanRequest)(nil),                  // 77: cache.v1.ScanRequest
+	(*ScanResponse)(nil),                 // 78: cache.v1.ScanResponse
+	(*WatchExpiredRequest)(nil),          // 79: cache.v1.WatchExpiredRequest
+	(*ExpiredEvent)(nil),                 // 80: cache.v1.ExpiredEvent
+	(*RandomKeyRequest)(nil),             // 81: cache.v1.RandomKeyRequest
+	(*RandomKeyResponse)(nil),            // 82: cache.v1.RandomKeyResponse
+	(*TypeRequest)(nil),                  // 83: cache.v1.TypeRequest
+	(*TypeResponse)(nil),                 // 84: cache.v1.TypeResponse
+	(*DBSizeRequest)(nil),                // 85: cache.v1.DBSizeRequest
+	(*DBSizeResponse)(nil),               // 86: cache.v1.DBSizeResponse
+	(*MemoryUsageRequest)(nil),           // 87: cache.v1.MemoryUsageRequest
+	(*MemoryUsageResponse)(nil),          // 88: cache.v1.MemoryUsageResponse
+	(*GetTTLRequest)(nil),                // 89: cache.v1.GetTTLRequest
+	(*GetTTLResponse)(nil),               // 90: cache.v1.GetTTLResponse
+	(*PTTLRequest)(nil),                  // 91: cache.v1.PTTLRequest
+	(*PTTLResponse)(nil),                 // 92: cache.v1.PTTLResponse
+	(*ExpireRequest)(nil),                // 93: cache.v1.ExpireRequest
+	(*ExpireResponse)(nil),               // 94: cache.v1.ExpireResponse
+	(*TouchRequest)(nil),                 // 95: cache.v1.TouchRequest
+	(*TouchResponse)(nil),                // 96: cache.v1.TouchResponse
+	(*TouchKeysRequest)(nil),             // 97: cache.v1.TouchKeysRequest
+	(*TouchKeysResponse)(nil),            // 98: cache.v1.TouchKeysResponse
+	(*RenameRequest)(nil),                // 99: cache.v1.RenameRequest
+	(*RenameResponse)(nil),               // 100: cache.v1.RenameResponse
+	(*PersistRequest)(nil),               // 101: cache.v1.PersistRequest
+	(*PersistResponse)(nil),              // 102: cache.v1.PersistResponse
+	(*PinRequest)(nil),                   // 103: cache.v1.PinRequest
+	(*PinResponse)(nil),                  // 104: cache.v1.PinResponse
+	(*UnpinRequest)(nil),                 // 105: cache.v1.UnpinRequest
+	(*UnpinResponse)(nil),                // 106: cache.v1.UnpinResponse
+	(*ListPinnedRequest)(nil),            // 107: cache.v1.ListPinnedRequest
+	(*ListPinnedResponse)(nil),           // 108: cache.v1.ListPinnedResponse
+	(*StatsRequest)(nil),                 // 109: cache.v1.StatsRequest
+	(*ShardStats)(nil),                   // 110: cache.v1.ShardStats
+	(*LockWaitStats)(nil),                // 111: cache.v1.LockWaitStats
+	(*DefragStats)(nil),                  // 112: cache.v1.DefragStats
+	(*EvictionStats)(nil),                // 113: cache.v1.EvictionStats
+	(*StatsResponse)(nil),                // 114: cache.v1.StatsResponse
+	(*DiskStats)(nil),                    // 115: cache.v1.DiskStats
+	(*ExpireSampleStats)(nil),            // 116: cache.v1.ExpireSampleStats
+	(*ReplicationStats)(nil),             // 117: cache.v1.ReplicationStats
+	(*DefragRequest)(nil),                // 118: cache.v1.DefragRequest
+	(*DefragResponse)(nil),               // 119: cache.v1.DefragResponse
+	(*RewriteAOFRequest)(nil),            // 120: cache.v1.RewriteAOFRequest
+	(*RewriteAOFResponse)(nil),           // 121: cache.v1.RewriteAOFResponse
+	(*ExportEntry)(nil),                  // 122: cache.v1.ExportEntry
+	(*ExportRequest)(nil),                // 123: cache.v1.ExportRequest
+	(*ExportResponse)(nil),               // 124: cache.v1.ExportResponse
+	(*ImportRequest)(nil),                // 125: cache.v1.ImportRequest
+	(*ImportResponse)(nil),               // 126: cache.v1.ImportResponse
+	(*FlushAllRequest)(nil),              // 127: cache.v1.FlushAllRequest
+	(*FlushAllResponse)(nil),             // 128: cache.v1.FlushAllResponse
+	(*FlushByPrefixRequest)(nil),         // 129: cache.v1.FlushByPrefixRequest
+	(*FlushByPrefixResponse)(nil),        // 130: cache.v1.FlushByPrefixResponse
+	(*SaveRequest)(nil),                  // 131: cache.v1.SaveRequest
+	(*SaveResponse)(nil),                 // 132: cache.v1.SaveResponse
+	(*TopKeysRequest)(nil),               // 133: cache.v1.TopKeysRequest
+	(*KeyStat)(nil),                      // 134: cache.v1.KeyStat
+	(*TopKeysResponse)(nil),              // 135: cache.v1.TopKeysResponse
+	(*CapabilitiesRequest)(nil),          // 136: cache.v1.CapabilitiesRequest
+	(*CapabilitiesResponse)(nil),         // 137: cache.v1.CapabilitiesResponse
+	(*CapabilityLimits)(nil),             // 138: cache.v1.CapabilityLimits
+	nil,                                  // 139: cache.v1.MSetRequest.ItemsEntry
+	nil,                                  // 140: cache.v1.MSetRequest.EventTimesMsEntry
+	nil,                                  // 141: cache.v1.MSetResponse.SkippedEntry
+	nil,                                  // 142: cache.v1.MGetResponse.ItemsEntry
+	nil,                                  // 143: cache.v1.HGetAllResponse.FieldsEntry
+	nil,                                  // 144: cache.v1.ExportEntry.HashEntry
+	nil,                                  // 145: cache.v1.CapabilitiesResponse.FeaturesEntry
+}
+var file_cache_v1_cache_proto_depIdxs = []int32{
+	139, // 0: cache.v1.MSetRequest.items:type_name -> cache.v1.MSetRequest.ItemsEntry
+	140, // 1: cache.v1.MSetRequest.event_times_ms:type_name -> cache.v1.MSetRequest.EventTimesMsEntry
+	141, // 2: cache.v1.MSetResponse.skipped:type_name -> cache.v1.MSetResponse.SkippedEntry
+	142, // 3: cache.v1.MGetResponse.items:type_name -> cache.v1.MGetResponse.ItemsEntry
+	37,  // 4: cache.v1.MGetResponse.entries:type_name -> cache.v1.MGetEntry
+	43,  // 5: cache.v1.ExecRequest.commands:type_name -> cache.v1.PipelineCommand
+	44,  // 6: cache.v1.ExecResponse.results:type_name -> cache.v1.PipelineResult
+	143, // 7: cache.v1.HGetAllResponse.fields:type_name -> cache.v1.HGetAllResponse.FieldsEntry
+	111, // 8: cache.v1.ShardStats.lock_wait:type_name -> cache.v1.LockWaitStats
+	110, // 9: cache.v1.StatsResponse.shards:type_name -> cache.v1.ShardStats
+	112, // 10: cache.v1.StatsResponse.defrag:type_name -> cache.v1.DefragStats
+	113, // 11: cache.v1.StatsResponse.eviction:type_name -> cache.v1.EvictionStats
+	117, // 12: cache.v1.StatsResponse.replication:type_name -> cache.v1.ReplicationStats
+	116, // 13: cache.v1.StatsResponse.expire_sample:type_name -> cache.v1.ExpireSampleStats
+	111, // 14: cache.v1.StatsResponse.time_wheel_lock_wait:type_name -> cache.v1.LockWaitStats
+	115, // 15: cache.v1.StatsResponse.disk:type_name -> cache.v1.DiskStats
+	144, // 16: cache.v1.ExportEntry.hash:type_name -> cache.v1.ExportEntry.HashEntry
+	122, // 17: cache.v1.ExportResponse.entries:type_name -> cache.v1.ExportEntry
+	122, // 18: cache.v1.ImportRequest.entries:type_name -> cache.v1.ExportEntry
+	134, // 19: cache.v1.TopKeysResponse.keys:type_name -> cache.v1.KeyStat
+	145, // 20: cache.v1.CapabilitiesResponse.features:type_name -> cache.v1.CapabilitiesResponse.FeaturesEntry
+	138, // 21: cache.v1.CapabilitiesResponse.limits:type_name -> cache.v1.CapabilityLimits
+	0,   // 22: cache.v1.CacheService.SetString:input_type -> cache.v1.SetStringRequest
+	2,   // 23: cache.v1.CacheService.PSetString:input_type -> cache.v1.PSetStringRequest
+	4,   // 24: cache.v1.CacheService.SetStringNX:input_type -> cache.v1.SetStringNXRequest
+	6,   // 25: cache.v1.CacheService.SetStringIfNewer:input_type -> cache.v1.SetStringIfNewerRequest
+	8,   // 26: cache.v1.CacheService.GetString:input_type -> cache.v1.GetStringRequest
+	10,  // 27: cache.v1.CacheService.GetStringWithVersion:input_type -> cache.v1.GetStringWithVersionRequest
+	12,  // 28: cache.v1.CacheService.SetStringIfVersion:input_type -> cache.v1.SetStringIfVersionRequest
+	14,  // 29: cache.v1.CacheService.SetBytes:input_type -> cache.v1.SetBytesRequest
+	16,  // 30: cache.v1.CacheService.GetBytes:input_type -> cache.v1.GetBytesRequest
+	18,  // 31: cache.v1.CacheService.DelString:input_type -> cache.v1.DelStringRequest
+	20,  // 32: cache.v1.CacheService.CompareAndDelete:input_type -> cache.v1.CompareAndDeleteRequest
+	22,  // 33: cache.v1.CacheService.CompareAndSwap:input_type -> cache.v1.CompareAndSwapRequest
+	24,  // 34: cache.v1.CacheService.Append:input_type -> cache.v1.AppendRequest
+	26,  // 35: cache.v1.CacheService.Strlen:input_type -> cache.v1.StrlenRequest
+	28,  // 36: cache.v1.CacheService.GetDel:input_type -> cache.v1.GetDelRequest
+	30,  // 37: cache.v1.CacheService.GetSet:input_type -> cache.v1.GetSetRequest
+	32,  // 38: cache.v1.CacheService.GetEx:input_type -> cache.v1.GetExRequest
+	34,  // 39: cache.v1.CacheService.MSet:input_type -> cache.v1.MSetRequest
+	36,  // 40: cache.v1.CacheService.MGet:input_type -> cache.v1.MGetRequest
+	39,  // 41: cache.v1.CacheService.Exists:input_type -> cache.v1.ExistsRequest
+	41,  // 42: cache.v1.CacheService.MDel:input_type -> cache.v1.MDelRequest
+	45,  // 43: cache.v1.CacheService.Exec:input_type -> cache.v1.ExecRequest
+	47,  // 44: cache.v1.CacheService.Replicate:input_type -> cache.v1.ReplicateRequest
+	49,  // 45: cache.v1.CacheService.IncrBy:input_type -> cache.v1.IncrByRequest
+	51,  // 46: cache.v1.CacheService.DecrBy:input_type -> cache.v1.DecrByRequest
+	53,  // 47: cache.v1.CacheService.HSet:input_type -> cache.v1.HSetRequest
+	55,  // 48: cache.v1.CacheService.HGet:input_type -> cache.v1.HGetRequest
+	57,  // 49: cache.v1.CacheService.HDel:input_type -> cache.v1.HDelRequest
+	59,  // 50: cache.v1.CacheService.HGetAll:input_type -> cache.v1.HGetAllRequest
+	61,  // 51: cache.v1.CacheService.HLen:input_type -> cache.v1.HLenRequest
+	63,  // 52: cache.v1.CacheService.LPush:input_type -> cache.v1.LPushRequest
+	65,  // 53: cache.v1.CacheService.RPush:input_type -> cache.v1.RPushRequest
+	67,  // 54: cache.v1.CacheService.LPop:input_type -> cache.v1.LPopRequest
+	69,  // 55: cache.v1.CacheService.RPop:input_type -> cache.v1.RPopRequest
+	71,  // 56: cache.v1.CacheService.LRange:input_type -> cache.v1.LRangeRequest
+	73,  // 57: cache.v1.CacheService.LLen:input_type -> cache.v1.LLenRequest
+	75,  // 58: cache.v1.CacheService.Keys:input_type -> cache.v1.KeysRequest
+	77,  // 59: cache.v1.CacheService.Scan:input_type -> cache.v1.ScanRequest
+	77,  // 60: cache.v1.CacheService.ScanStream:input_type -> cache.v1.ScanRequest
+	79,  // 61: cache.v1.CacheService.WatchExpired:input_type -> cache.v1.WatchExpiredRequest
+	81,  // 62: cache.v1.CacheService.RandomKey:input_type -> cache.v1.RandomKeyRequest
+	83,  // 63: cache.v1.CacheService.Type:input_type -> cache.v1.TypeRequest
+	85,  // 64: cache.v1.CacheService.DBSize:input_type -> cache.v1.DBSizeRequest
+	87,  // 65: cache.v1.CacheService.MemoryUsage:input_type -> cache.v1.MemoryUsageRequest
+	89,  // 66: cache.v1.CacheService.GetTTL:input_type -> cache.v1.GetTTLRequest
+	91,  // 67: cache.v1.CacheService.PTTL:input_type -> cache.v1.PTTLRequest
+	93,  // 68: cache.v1.CacheService.Expire:input_type -> cache.v1.ExpireRequest
+	95,  // 69: cache.v1.CacheService.Touch:input_type -> cache.v1.TouchRequest
+	97,  // 70: cache.v1.CacheService.TouchKeys:input_type -> cache.v1.TouchKeysRequest
+	99,  // 71: cache.v1.CacheService.Rename:input_type -> cache.v1.RenameRequest
+	101, // 72: cache.v1.CacheService.Persist:input_type -> cache.v1.PersistRequest
+	103, // 73: cache.v1.CacheService.Pin:input_type -> cache.v1.PinRequest
+	105, // 74: cache.v1.CacheService.Unpin:input_type -> cache.v1.UnpinRequest
+	107, // 75: cache.v1.CacheService.ListPinned:input_type -> cache.v1.ListPinnedRequest
+	109, // 76: cache.v1.CacheService.Stats:input_type -> cache.v1.StatsRequest
+	118, // 77: cache.v1.CacheService.Defrag:input_type -> cache.v1.DefragRequest
+	120, // 78: cache.v1.CacheService.RewriteAOF:input_type -> cache.v1.RewriteAOFRequest
+	127, // 79: cache.v1.CacheService.FlushAll:input_type -> cache.v1.FlushAllRequest
+	129, // 80: cache.v1.CacheService.FlushByPrefix:input_type -> cache.v1.FlushByPrefixRequest
+	131, // 81: cache.v1.CacheService.Save:input_type -> cache.v1.SaveRequest
+	123, // 82: cache.v1.CacheService.Export:input_type -> cache.v1.ExportRequest
+	125, // 83: cache.v1.CacheService.Import:input_type -> cache.v1.ImportRequest
+	133, // 84: cache.v1.CacheService.TopKeys:input_type -> cache.v1.TopKeysRequest
+	136, // 85: cache.v1.CacheService.Capabilities:input_type -> cache.v1.CapabilitiesRequest
+	1,   // 86: cache.v1.CacheService.SetString:output_type -> cache.v1.SetStringResponse
+	3,   // 87: cache.v1.CacheService.PSetString:output_type -> cache.v1.PSetStringResponse
+	5,   // 88: cache.v1.CacheService.SetStringNX:output_type -> cache.v1.SetStringNXResponse
+	7,   // 89: cache.v1.CacheService.SetStringIfNewer:output_type -> cache.v1.SetStringIfNewerResponse
+	9,   // 90: cache.v1.CacheService.GetString:output_type -> cache.v1.GetStringResponse
+	11,  // 91: cache.v1.CacheService.GetStringWithVersion:output_type -> cache.v1.GetStringWithVersionResponse
+	13,  // 92: cache.v1.CacheService.SetStringIfVersion:output_type -> cache.v1.SetStringIfVersionResponse
+	15,  // 93: cache.v1.CacheService.SetBytes:output_type -> cache.v1.SetBytesResponse
+	17,  // 94: cache.v1.CacheService.GetBytes:output_type -> cache.v1.GetBytesResponse
+	19,  // 95: cache.v1.CacheService.DelString:output_type -> cache.v1.DelStringResponse
+	21,  // 96: cache.v1.CacheService.CompareAndDelete:output_type -> cache.v1.CompareAndDeleteResponse
+	23,  // 97: cache.v1.CacheService.CompareAndSwap:output_type -> cache.v1.CompareAndSwapResponse
+	25,  // 98: cache.v1.CacheService.Append:output_type -> cache.v1.AppendResponse
+	27,  // 99: cache.v1.CacheService.Strlen:output_type -> cache.v1.StrlenResponse
+	29,  // 100: cache.v1.CacheService.GetDel:output_type -> cache.v1.GetDelResponse
+	31,  // 101: cache.v1.CacheService.GetSet:output_type -> cache.v1.GetSetResponse
+	33,  // 102: cache.v1.CacheService.GetEx:output_type -> cache.v1.GetExResponse
+	35,  // 103: cache.v1.CacheService.MSet:output_type -> cache.v1.MSetResponse
+	38,  // 104: cache.v1.CacheService.MGet:output_type -> cache.v1.MGetResponse
+	40,  // 105: cache.v1.CacheService.Exists:output_type -> cache.v1.ExistsResponse
+	42,  // 106: cache.v1.CacheService.MDel:output_type -> cache.v1.MDelResponse
+	46,  // 107: cache.v1.CacheService.Exec:output_type -> cache.v1.ExecResponse
+	48,  // 108: cache.v1.CacheService.Replicate:output_type -> cache.v1.ReplicateEvent
+	50,  // 109: cache.v1.CacheService.IncrBy:output_type -> cache.v1.IncrByResponse
+	52,  // 110: cache.v1.CacheService.DecrBy:output_type -> cache.v1.DecrByResponse
+	54,  // 111: cache.v1.CacheService.HSet:output_type -> cache.v1.HSetResponse
+	56,  // 112: cache.v1.CacheService.HGet:output_type -> cache.v1.HGetResponse
+	58,  // 113: cache.v1.CacheService.HDel:output_type -> cache.v1.HDelResponse
+	60,  // 114: cache.v1.CacheService.HGetAll:output_type -> cache.v1.HGetAllResponse
+	62,  // 115: cache.v1.CacheService.HLen:output_type -> cache.v1.HLenResponse
+	64,  // 116: cache.v1.CacheService.LPush:output_type -> cache.v1.LPushResponse
+	66,  // 117: cache.v1.CacheService.RPush:output_type -> cache.v1.RPushResponse
+	68,  // 118: cache.v1.CacheService.LPop:output_type -> cache.v1.LPopResponse
+	70,  // 119: cache.v1.CacheService.RPop:output_type -> cache.v1.RPopResponse
+	72,  // 120: cache.v1.CacheService.LRange:output_type -> cache.v1.LRangeResponse
+	74,  // 121: cache.v1.CacheService.LLen:output_type -> cache.v1.LLenResponse
+	76,  // 122: cache.v1.CacheService.Keys:output_type -> cache.v1.KeysResponse
+	78,  // 123: cache.v1.CacheService.Scan:output_type -> cache.v1.ScanResponse
+	78,  // 124: cache.v1.CacheService.ScanStream:output_type -> cache.v1.ScanResponse
+	80,  // 125: cache.v1.CacheService.WatchExpired:output_type -> cache.v1.ExpiredEvent
+	82,  // 126: cache.v1.CacheService.RandomKey:output_type -> cache.v1.RandomKeyResponse
+	84,  // 127: cache.v1.CacheService.Type:output_type -> cache.v1.TypeResponse
+	86,  // 128: cache.v1.CacheService.DBSize:output_type -> cache.v1.DBSizeResponse
+	88,  // 129: cache.v1.CacheService.MemoryUsage:output_type -> cache.v1.MemoryUsageResponse
+	90,  // 130: cache.v1.CacheService.GetTTL:output_type -> cache.v1.GetTTLResponse
+	92,  // 131: cache.v1.CacheService.PTTL:output_type -> cache.v1.PTTLResponse
+	94,  // 132: cache.v1.CacheService.Expire:output_type -> cache.v1.ExpireResponse
+	96,  // 133: cache.v1.CacheService.Touch:output_type -> cache.v1.TouchResponse
+	98,  // 134: cache.v1.CacheService.TouchKeys:output_type -> cache.v1.TouchKeysResponse
+	100, // 135: cache.v1.CacheService.Rename:output_type -> cache.v1.RenameResponse
+	102, // 136: cache.v1.CacheService.Persist:output_type -> cache.v1.PersistResponse
+	104, // 137: cache.v1.CacheService.Pin:output_type -> cache.v1.PinResponse
+	106, // 138: cache.v1.CacheService.Unpin:output_type -> cache.v1.UnpinResponse
+	108, // 139: cache.v1.CacheService.ListPinned:output_type -> cache.v1.ListPinnedResponse
+	114, // 140: cache.v1.CacheService.Stats:output_type -> cache.v1.StatsResponse
+	119, // 141: cache.v1.CacheService.Defrag:output_type -> cache.v1.DefragResponse
+	121, // 142: cache.v1.CacheService.RewriteAOF:output_type -> cache.v1.RewriteAOFResponse
+	128, // 143: cache.v1.CacheService.FlushAll:output_type -> cache.v1.FlushAllResponse
+	130, // 144: cache.v1.CacheService.FlushByPrefix:output_type -> cache.v1.FlushByPrefixResponse
+	132, // 145: cache.v1.CacheService.Save:output_type -> cache.v1.SaveResponse
+	124, // 146: cache.v1.CacheService.Export:output_type -> cache.v1.ExportResponse
+	126, // 147: cache.v1.CacheService.Import:output_type -> cache.v1.ImportResponse
+	135, // 148: cache.v1.CacheService.TopKeys:output_type -> cache.v1.TopKeysResponse
+	137, // 149: cache.v1.CacheService.Capabilities:output_type -> cache.v1.CapabilitiesResponse
+	86,  // [86:150] is the sub-list for method output_type
+	22,  // [22:86] is the sub-list for method input_type
+	22,  // [22:22] is the sub-list for extension type_name
+	22,  // [22:22] is the sub-list for extension extendee
+	0,   // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_cache_v1_cache_proto_init() }
+func file_cache_v1_cache_proto_init() {
+	if File_cache_v1_cache_proto != nil {
+		return
+	}
+	file_cache_v1_cache_proto_msgTypes[0].OneofWrappers = []any{
+		(*SetStringRequest_TtlSeconds)(nil),
+		(*SetStringRequest_ExpiresAtUnixMs)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -355,7 +7998,7 @@ func file_cache_v1_cache_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cache_v1_cache_proto_rawDesc), len(file_cache_v1_cache_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   146,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,70 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CacheService_SetString_FullMethodName = "/cache.v1.CacheService/SetString"
-	CacheService_GetString_FullMethodName = "/cache.v1.CacheService/GetString"
-	CacheService_DelString_FullMethodName = "/cache.v1.CacheService/DelString"
+	CacheService_SetString_FullMethodName            = "/cache.v1.CacheService/SetString"
+	CacheService_PSetString_FullMethodName           = "/cache.v1.CacheService/PSetString"
+	CacheService_SetStringNX_FullMethodName          = "/cache.v1.CacheService/SetStringNX"
+	CacheService_SetStringIfNewer_FullMethodName     = "/cache.v1.CacheService/SetStringIfNewer"
+	CacheService_GetString_FullMethodName            = "/cache.v1.CacheService/GetString"
+	CacheService_GetStringWithVersion_FullMethodName = "/cache.v1.CacheService/GetStringWithVersion"
+	CacheService_SetStringIfVersion_FullMethodName   = "/cache.v1.CacheService/SetStringIfVersion"
+	CacheService_SetBytes_FullMethodName             = "/cache.v1.CacheService/SetBytes"
+	CacheService_GetBytes_FullMethodName             = "/cache.v1.CacheService/GetBytes"
+	CacheService_DelString_FullMethodName            = "/cache.v1.CacheService/DelString"
+	CacheService_CompareAndDelete_FullMethodName     = "/cache.v1.CacheService/CompareAndDelete"
+	CacheService_CompareAndSwap_FullMethodName       = "/cache.v1.CacheService/CompareAndSwap"
+	CacheService_Append_FullMethodName               = "/cache.v1.CacheService/Append"
+	CacheService_Strlen_FullMethodName               = "/cache.v1.CacheService/Strlen"
+	CacheService_GetDel_FullMethodName               = "/cache.v1.CacheService/GetDel"
+	CacheService_GetSet_FullMethodName               = "/cache.v1.CacheService/GetSet"
+	CacheService_GetEx_FullMethodName                = "/cache.v1.CacheService/GetEx"
+	CacheService_MSet_FullMethodName                 = "/cache.v1.CacheService/MSet"
+	CacheService_MGet_FullMethodName                 = "/cache.v1.CacheService/MGet"
+	CacheService_Exists_FullMethodName               = "/cache.v1.CacheService/Exists"
+	CacheService_MDel_FullMethodName                 = "/cache.v1.CacheService/MDel"
+	CacheService_Exec_FullMethodName                 = "/cache.v1.CacheService/Exec"
+	CacheService_Replicate_FullMethodName            = "/cache.v1.CacheService/Replicate"
+	CacheService_IncrBy_FullMethodName               = "/cache.v1.CacheService/IncrBy"
+	CacheService_DecrBy_FullMethodName               = "/cache.v1.CacheService/DecrBy"
+	CacheService_HSet_FullMethodName                 = "/cache.v1.CacheService/HSet"
+	CacheService_HGet_FullMethodName                 = "/cache.v1.CacheService/HGet"
+	CacheService_HDel_FullMethodName                 = "/cache.v1.CacheService/HDel"
+	CacheService_HGetAll_FullMethodName              = "/cache.v1.CacheService/HGetAll"
+	CacheService_HLen_FullMethodName                 = "/cache.v1.CacheService/HLen"
+	CacheService_LPush_FullMethodName                = "/cache.v1.CacheService/LPush"
+	CacheService_RPush_FullMethodName                = "/cache.v1.CacheService/RPush"
+	CacheService_LPop_FullMethodName                 = "/cache.v1.CacheService/LPop"
+	CacheService_RPop_FullMethodName                 = "/cache.v1.CacheService/RPop"
+	CacheService_LRange_FullMethodName               = "/cache.v1.CacheService/LRange"
+	CacheService_LLen_FullMethodName                 = "/cache.v1.CacheService/LLen"
+	CacheService_Keys_FullMethodName                 = "/cache.v1.CacheService/Keys"
+	CacheService_Scan_FullMethodName                 = "/cache.v1.CacheService/Scan"
+	CacheService_ScanStream_FullMethodName           = "/cache.v1.CacheService/ScanStream"
+	CacheService_WatchExpired_FullMethodName         = "/cache.v1.CacheService/WatchExpired"
+	CacheService_RandomKey_FullMethodName            = "/cache.v1.CacheService/RandomKey"
+	CacheService_Type_FullMethodName                 = "/cache.v1.CacheService/Type"
+	CacheService_DBSize_FullMethodName               = "/cache.v1.CacheService/DBSize"
+	CacheService_MemoryUsage_FullMethodName          = "/cache.v1.CacheService/MemoryUsage"
+	CacheService_GetTTL_FullMethodName               = "/cache.v1.CacheService/GetTTL"
+	CacheService_PTTL_FullMethodName                 = "/cache.v1.CacheService/PTTL"
+	CacheService_Expire_FullMethodName               = "/cache.v1.CacheService/Expire"
+	CacheService_Touch_FullMethodName                = "/cache.v1.CacheService/Touch"
+	CacheService_TouchKeys_FullMethodName            = "/cache.v1.CacheService/TouchKeys"
+	CacheService_Rename_FullMethodName               = "/cache.v1.CacheService/Rename"
+	CacheService_Persist_FullMethodName              = "/cache.v1.CacheService/Persist"
+	CacheService_Pin_FullMethodName                  = "/cache.v1.CacheService/Pin"
+	CacheService_Unpin_FullMethodName                = "/cache.v1.CacheService/Unpin"
+	CacheService_ListPinned_FullMethodName           = "/cache.v1.CacheService/ListPinned"
+	CacheService_Stats_FullMethodName                = "/cache.v1.CacheService/Stats"
+	CacheService_Defrag_FullMethodName               = "/cache.v1.CacheService/Defrag"
+	CacheService_RewriteAOF_FullMethodName           = "/cache.v1.CacheService/RewriteAOF"
+	CacheService_FlushAll_FullMethodName             = "/cache.v1.CacheService/FlushAll"
+	CacheService_FlushByPrefix_FullMethodName        = "/cache.v1.CacheService/FlushByPrefix"
+	CacheService_Save_FullMethodName                 = "/cache.v1.CacheService/Save"
+	CacheService_Export_FullMethodName               = "/cache.v1.CacheService/Export"
+	CacheService_Import_FullMethodName               = "/cache.v1.CacheService/Import"
+	CacheService_TopKeys_FullMethodName              = "/cache.v1.CacheService/TopKeys"
+	CacheService_Capabilities_FullMethodName         = "/cache.v1.CacheService/Capabilities"
 )
 
 // CacheServiceClient is the client API for CacheService service.
@@ -29,8 +90,69 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CacheServiceClient interface {
 	SetString(ctx context.Context, in *SetStringRequest, opts ...grpc.CallOption) (*SetStringResponse, error)
+	PSetString(ctx context.Context, in *PSetStringRequest, opts ...grpc.CallOption) (*PSetStringResponse, error)
+	SetStringNX(ctx context.Context, in *SetStringNXRequest, opts ...grpc.CallOption) (*SetStringNXResponse, error)
+	SetStringIfNewer(ctx context.Context, in *SetStringIfNewerRequest, opts ...grpc.CallOption) (*SetStringIfNewerResponse, error)
 	GetString(ctx context.Context, in *GetStringRequest, opts ...grpc.CallOption) (*GetStringResponse, error)
+	GetStringWithVersion(ctx context.Context, in *GetStringWithVersionRequest, opts ...grpc.CallOption) (*GetStringWithVersionResponse, error)
+	SetStringIfVersion(ctx context.Context, in *SetStringIfVersionRequest, opts ...grpc.CallOption) (*SetStringIfVersionResponse, error)
+	SetBytes(ctx context.Context, in *SetBytesRequest, opts ...grpc.CallOption) (*SetBytesResponse, error)
+	GetBytes(ctx context.Context, in *GetBytesRequest, opts ...grpc.CallOption) (*GetBytesResponse, error)
 	DelString(ctx context.Context, in *DelStringRequest, opts ...grpc.CallOption) (*DelStringResponse, error)
+	CompareAndDelete(ctx context.Context, in *CompareAndDeleteRequest, opts ...grpc.CallOption) (*CompareAndDeleteResponse, error)
+	CompareAndSwap(ctx context.Context, in *CompareAndSwapRequest, opts ...grpc.CallOption) (*CompareAndSwapResponse, error)
+	Append(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*AppendResponse, error)
+	Strlen(ctx context.Context, in *StrlenRequest, opts ...grpc.CallOption) (*StrlenResponse, error)
+	GetDel(ctx context.Context, in *GetDelRequest, opts ...grpc.CallOption) (*GetDelResponse, error)
+	GetSet(ctx context.Context, in *GetSetRequest, opts ...grpc.CallOption) (*GetSetResponse, error)
+	GetEx(ctx context.Context, in *GetExRequest, opts ...grpc.CallOption) (*GetExResponse, error)
+	MSet(ctx context.Context, in *MSetRequest, opts ...grpc.CallOption) (*MSetResponse, error)
+	MGet(ctx context.Context, in *MGetRequest, opts ...grpc.CallOption) (*MGetResponse, error)
+	Exists(ctx context.Context, in *ExistsRequest, opts ...grpc.CallOption) (*ExistsResponse, error)
+	MDel(ctx context.Context, in *MDelRequest, opts ...grpc.CallOption) (*MDelResponse, error)
+	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error)
+	Replicate(ctx context.Context, in *ReplicateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReplicateEvent], error)
+	IncrBy(ctx context.Context, in *IncrByRequest, opts ...grpc.CallOption) (*IncrByResponse, error)
+	DecrBy(ctx context.Context, in *DecrByRequest, opts ...grpc.CallOption) (*DecrByResponse, error)
+	HSet(ctx context.Context, in *HSetRequest, opts ...grpc.CallOption) (*HSetResponse, error)
+	HGet(ctx context.Context, in *HGetRequest, opts ...grpc.CallOption) (*HGetResponse, error)
+	HDel(ctx context.Context, in *HDelRequest, opts ...grpc.CallOption) (*HDelResponse, error)
+	HGetAll(ctx context.Context, in *HGetAllRequest, opts ...grpc.CallOption) (*HGetAllResponse, error)
+	HLen(ctx context.Context, in *HLenRequest, opts ...grpc.CallOption) (*HLenResponse, error)
+	LPush(ctx context.Context, in *LPushRequest, opts ...grpc.CallOption) (*LPushResponse, error)
+	RPush(ctx context.Context, in *RPushRequest, opts ...grpc.CallOption) (*RPushResponse, error)
+	LPop(ctx context.Context, in *LPopRequest, opts ...grpc.CallOption) (*LPopResponse, error)
+	RPop(ctx context.Context, in *RPopRequest, opts ...grpc.CallOption) (*RPopResponse, error)
+	LRange(ctx context.Context, in *LRangeRequest, opts ...grpc.CallOption) (*LRangeResponse, error)
+	LLen(ctx context.Context, in *LLenRequest, opts ...grpc.CallOption) (*LLenResponse, error)
+	Keys(ctx context.Context, in *KeysRequest, opts ...grpc.CallOption) (*KeysResponse, error)
+	Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*ScanResponse, error)
+	ScanStream(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScanResponse], error)
+	WatchExpired(ctx context.Context, in *WatchExpiredRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExpiredEvent], error)
+	RandomKey(ctx context.Context, in *RandomKeyRequest, opts ...grpc.CallOption) (*RandomKeyResponse, error)
+	Type(ctx context.Context, in *TypeRequest, opts ...grpc.CallOption) (*TypeResponse, error)
+	DBSize(ctx context.Context, in *DBSizeRequest, opts ...grpc.CallOption) (*DBSizeResponse, error)
+	MemoryUsage(ctx context.Context, in *MemoryUsageRequest, opts ...grpc.CallOption) (*MemoryUsageResponse, error)
+	GetTTL(ctx context.Context, in *GetTTLRequest, opts ...grpc.CallOption) (*GetTTLResponse, error)
+	PTTL(ctx context.Context, in *PTTLRequest, opts ...grpc.CallOption) (*PTTLResponse, error)
+	Expire(ctx context.Context, in *ExpireRequest, opts ...grpc.CallOption) (*ExpireResponse, error)
+	Touch(ctx context.Context, in *TouchRequest, opts ...grpc.CallOption) (*TouchResponse, error)
+	TouchKeys(ctx context.Context, in *TouchKeysRequest, opts ...grpc.CallOption) (*TouchKeysResponse, error)
+	Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*RenameResponse, error)
+	Persist(ctx context.Context, in *PersistRequest, opts ...grpc.CallOption) (*PersistResponse, error)
+	Pin(ctx context.Context, in *PinRequest, opts ...grpc.CallOption) (*PinResponse, error)
+	Unpin(ctx context.Context, in *UnpinRequest, opts ...grpc.CallOption) (*UnpinResponse, error)
+	ListPinned(ctx context.Context, in *ListPinnedRequest, opts ...grpc.CallOption) (*ListPinnedResponse, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	Defrag(ctx context.Context, in *DefragRequest, opts ...grpc.CallOption) (*DefragResponse, error)
+	RewriteAOF(ctx context.Context, in *RewriteAOFRequest, opts ...grpc.CallOption) (*RewriteAOFResponse, error)
+	FlushAll(ctx context.Context, in *FlushAllRequest, opts ...grpc.CallOption) (*FlushAllResponse, error)
+	FlushByPrefix(ctx context.Context, in *FlushByPrefixRequest, opts ...grpc.CallOption) (*FlushByPrefixResponse, error)
+	Save(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveResponse, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportResponse], error)
+	Import(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ImportResponse], error)
+	TopKeys(ctx context.Context, in *TopKeysRequest, opts ...grpc.CallOption) (*TopKeysResponse, error)
+	Capabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error)
 }
 
 type cacheServiceClient struct {
@@ -51,6 +173,36 @@ func (c *cacheServiceClient) SetString(ctx context.Context, in *SetStringRequest
 	return out, nil
 }
 
+func (c *cacheServiceClient) PSetString(ctx context.Context, in *PSetStringRequest, opts ...grpc.CallOption) (*PSetStringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PSetStringResponse)
+	err := c.cc.Invoke(ctx, CacheService_PSetString_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SetStringNX(ctx context.Context, in *SetStringNXRequest, opts ...grpc.CallOption) (*SetStringNXResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStringNXResponse)
+	err := c.cc.Invoke(ctx, CacheService_SetStringNX_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SetStringIfNewer(ctx context.Context, in *SetStringIfNewerRequest, opts ...grpc.CallOption) (*SetStringIfNewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStringIfNewerResponse)
+	err := c.cc.Invoke(ctx, CacheService_SetStringIfNewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cacheServiceClient) GetString(ctx context.Context, in *GetStringRequest, opts ...grpc.CallOption) (*GetStringResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetStringResponse)
@@ -58,116 +210,2038 @@ func (c *cacheServiceClient) GetString(ctx context.Context, in *GetStringRequest
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetStringWithVersion(ctx context.Context, in *GetStringWithVersionRequest, opts ...grpc.CallOption) (*GetStringWithVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStringWithVersionResponse)
+	err := c.cc.Invoke(ctx, CacheService_GetStringWithVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SetStringIfVersion(ctx context.Context, in *SetStringIfVersionRequest, opts ...grpc.CallOption) (*SetStringIfVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStringIfVersionResponse)
+	err := c.cc.Invoke(ctx, CacheService_SetStringIfVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SetBytes(ctx context.Context, in *SetBytesRequest, opts ...grpc.CallOption) (*SetBytesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBytesResponse)
+	err := c.cc.Invoke(ctx, CacheService_SetBytes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetBytes(ctx context.Context, in *GetBytesRequest, opts ...grpc.CallOption) (*GetBytesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBytesResponse)
+	err := c.cc.Invoke(ctx, CacheService_GetBytes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DelString(ctx context.Context, in *DelStringRequest, opts ...grpc.CallOption) (*DelStringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelStringResponse)
+	err := c.cc.Invoke(ctx, CacheService_DelString_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CompareAndDelete(ctx context.Context, in *CompareAndDeleteRequest, opts ...grpc.CallOption) (*CompareAndDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareAndDeleteResponse)
+	err := c.cc.Invoke(ctx, CacheService_CompareAndDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CompareAndSwap(ctx context.Context, in *CompareAndSwapRequest, opts ...grpc.CallOption) (*CompareAndSwapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareAndSwapResponse)
+	err := c.cc.Invoke(ctx, CacheService_CompareAndSwap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Append(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*AppendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppendResponse)
+	err := c.cc.Invoke(ctx, CacheService_Append_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Strlen(ctx context.Context, in *StrlenRequest, opts ...grpc.CallOption) (*StrlenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StrlenResponse)
+	err := c.cc.Invoke(ctx, CacheService_Strlen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetDel(ctx context.Context, in *GetDelRequest, opts ...grpc.CallOption) (*GetDelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDelResponse)
+	err := c.cc.Invoke(ctx, CacheService_GetDel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetSet(ctx context.Context, in *GetSetRequest, opts ...grpc.CallOption) (*GetSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSetResponse)
+	err := c.cc.Invoke(ctx, CacheService_GetSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetEx(ctx context.Context, in *GetExRequest, opts ...grpc.CallOption) (*GetExResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExResponse)
+	err := c.cc.Invoke(ctx, CacheService_GetEx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) MSet(ctx context.Context, in *MSetRequest, opts ...grpc.CallOption) (*MSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MSetResponse)
+	err := c.cc.Invoke(ctx, CacheService_MSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) MGet(ctx context.Context, in *MGetRequest, opts ...grpc.CallOption) (*MGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MGetResponse)
+	err := c.cc.Invoke(ctx, CacheService_MGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Exists(ctx context.Context, in *ExistsRequest, opts ...grpc.CallOption) (*ExistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExistsResponse)
+	err := c.cc.Invoke(ctx, CacheService_Exists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) MDel(ctx context.Context, in *MDelRequest, opts ...grpc.CallOption) (*MDelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MDelResponse)
+	err := c.cc.Invoke(ctx, CacheService_MDel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecResponse)
+	err := c.cc.Invoke(ctx, CacheService_Exec_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Replicate(ctx context.Context, in *ReplicateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReplicateEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CacheService_ServiceDesc.Streams[0], CacheService_Replicate_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReplicateRequest, ReplicateEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CacheService_ReplicateClient = grpc.ServerStreamingClient[ReplicateEvent]
+
+func (c *cacheServiceClient) IncrBy(ctx context.Context, in *IncrByRequest, opts ...grpc.CallOption) (*IncrByResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncrByResponse)
+	err := c.cc.Invoke(ctx, CacheService_IncrBy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DecrBy(ctx context.Context, in *DecrByRequest, opts ...grpc.CallOption) (*DecrByResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecrByResponse)
+	err := c.cc.Invoke(ctx, CacheService_DecrBy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) HSet(ctx context.Context, in *HSetRequest, opts ...grpc.CallOption) (*HSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HSetResponse)
+	err := c.cc.Invoke(ctx, CacheService_HSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) HGet(ctx context.Context, in *HGetRequest, opts ...grpc.CallOption) (*HGetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HGetResponse)
+	err := c.cc.Invoke(ctx, CacheService_HGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) HDel(ctx context.Context, in *HDelRequest, opts ...grpc.CallOption) (*HDelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HDelResponse)
+	err := c.cc.Invoke(ctx, CacheService_HDel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) HGetAll(ctx context.Context, in *HGetAllRequest, opts ...grpc.CallOption) (*HGetAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HGetAllResponse)
+	err := c.cc.Invoke(ctx, CacheService_HGetAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) HLen(ctx context.Context, in *HLenRequest, opts ...grpc.CallOption) (*HLenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HLenResponse)
+	err := c.cc.Invoke(ctx, CacheService_HLen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) LPush(ctx context.Context, in *LPushRequest, opts ...grpc.CallOption) (*LPushResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LPushResponse)
+	err := c.cc.Invoke(ctx, CacheService_LPush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RPush(ctx context.Context, in *RPushRequest, opts ...grpc.CallOption) (*RPushResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RPushResponse)
+	err := c.cc.Invoke(ctx, CacheService_RPush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) LPop(ctx context.Context, in *LPopRequest, opts ...grpc.CallOption) (*LPopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LPopResponse)
+	err := c.cc.Invoke(ctx, CacheService_LPop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RPop(ctx context.Context, in *RPopRequest, opts ...grpc.CallOption) (*RPopResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RPopResponse)
+	err := c.cc.Invoke(ctx, CacheService_RPop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) LRange(ctx context.Context, in *LRangeRequest, opts ...grpc.CallOption) (*LRangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LRangeResponse)
+	err := c.cc.Invoke(ctx, CacheService_LRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) LLen(ctx context.Context, in *LLenRequest, opts ...grpc.CallOption) (*LLenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LLenResponse)
+	err := c.cc.Invoke(ctx, CacheService_LLen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Keys(ctx context.Context, in *KeysRequest, opts ...grpc.CallOption) (*KeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KeysResponse)
+	err := c.cc.Invoke(ctx, CacheService_Keys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (*ScanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScanResponse)
+	err := c.cc.Invoke(ctx, CacheService_Scan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) ScanStream(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScanResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CacheService_ServiceDesc.Streams[1], CacheService_ScanStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ScanRequest, ScanResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CacheService_ScanStreamClient = grpc.ServerStreamingClient[ScanResponse]
+
+func (c *cacheServiceClient) WatchExpired(ctx context.Context, in *WatchExpiredRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExpiredEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CacheService_ServiceDesc.Streams[2], CacheService_WatchExpired_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchExpiredRequest, ExpiredEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CacheService_WatchExpiredClient = grpc.ServerStreamingClient[ExpiredEvent]
+
+func (c *cacheServiceClient) RandomKey(ctx context.Context, in *RandomKeyRequest, opts ...grpc.CallOption) (*RandomKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RandomKeyResponse)
+	err := c.cc.Invoke(ctx, CacheService_RandomKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Type(ctx context.Context, in *TypeRequest, opts ...grpc.CallOption) (*TypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TypeResponse)
+	err := c.cc.Invoke(ctx, CacheService_Type_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DBSize(ctx context.Context, in *DBSizeRequest, opts ...grpc.CallOption) (*DBSizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DBSizeResponse)
+	err := c.cc.Invoke(ctx, CacheService_DBSize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) MemoryUsage(ctx context.Context, in *MemoryUsageRequest, opts ...grpc.CallOption) (*MemoryUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoryUsageResponse)
+	err := c.cc.Invoke(ctx, CacheService_MemoryUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetTTL(ctx context.Context, in *GetTTLRequest, opts ...grpc.CallOption) (*GetTTLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTTLResponse)
+	err := c.cc.Invoke(ctx, CacheService_GetTTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PTTL(ctx context.Context, in *PTTLRequest, opts ...grpc.CallOption) (*PTTLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PTTLResponse)
+	err := c.cc.Invoke(ctx, CacheService_PTTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Expire(ctx context.Context, in *ExpireRequest, opts ...grpc.CallOption) (*ExpireResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpireResponse)
+	err := c.cc.Invoke(ctx, CacheService_Expire_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Touch(ctx context.Context, in *TouchRequest, opts ...grpc.CallOption) (*TouchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TouchResponse)
+	err := c.cc.Invoke(ctx, CacheService_Touch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TouchKeys(ctx context.Context, in *TouchKeysRequest, opts ...grpc.CallOption) (*TouchKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TouchKeysResponse)
+	err := c.cc.Invoke(ctx, CacheService_TouchKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*RenameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameResponse)
+	err := c.cc.Invoke(ctx, CacheService_Rename_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Persist(ctx context.Context, in *PersistRequest, opts ...grpc.CallOption) (*PersistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PersistResponse)
+	err := c.cc.Invoke(ctx, CacheService_Persist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Pin(ctx context.Context, in *PinRequest, opts ...grpc.CallOption) (*PinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinResponse)
+	err := c.cc.Invoke(ctx, CacheService_Pin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Unpin(ctx context.Context, in *UnpinRequest, opts ...grpc.CallOption) (*UnpinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinResponse)
+	err := c.cc.Invoke(ctx, CacheService_Unpin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) ListPinned(ctx context.Context, in *ListPinnedRequest, opts ...grpc.CallOption) (*ListPinnedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPinnedResponse)
+	err := c.cc.Invoke(ctx, CacheService_ListPinned_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, CacheService_Stats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Defrag(ctx context.Context, in *DefragRequest, opts ...grpc.CallOption) (*DefragResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefragResponse)
+	err := c.cc.Invoke(ctx, CacheService_Defrag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RewriteAOF(ctx context.Context, in *RewriteAOFRequest, opts ...grpc.CallOption) (*RewriteAOFResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewriteAOFResponse)
+	err := c.cc.Invoke(ctx, CacheService_RewriteAOF_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) FlushAll(ctx context.Context, in *FlushAllRequest, opts ...grpc.CallOption) (*FlushAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlushAllResponse)
+	err := c.cc.Invoke(ctx, CacheService_FlushAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) FlushByPrefix(ctx context.Context, in *FlushByPrefixRequest, opts ...grpc.CallOption) (*FlushByPrefixResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlushByPrefixResponse)
+	err := c.cc.Invoke(ctx, CacheService_FlushByPrefix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Save(ctx context.Context, in *SaveRequest, opts ...grpc.CallOption) (*SaveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveResponse)
+	err := c.cc.Invoke(ctx, CacheService_Save_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CacheService_ServiceDesc.Streams[3], CacheService_Export_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, ExportResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CacheService_ExportClient = grpc.ServerStreamingClient[ExportResponse]
+
+func (c *cacheServiceClient) Import(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRequest, ImportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CacheService_ServiceDesc.Streams[4], CacheService_Import_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportRequest, ImportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CacheService_ImportClient = grpc.ClientStreamingClient[ImportRequest, ImportResponse]
+
+func (c *cacheServiceClient) TopKeys(ctx context.Context, in *TopKeysRequest, opts ...grpc.CallOption) (*TopKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopKeysResponse)
+	err := c.cc.Invoke(ctx, CacheService_TopKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Capabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*CapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CapabilitiesResponse)
+	err := c.cc.Invoke(ctx, CacheService_Capabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CacheServiceServer is the server API for CacheService service.
+// All implementations must embed UnimplementedCacheServiceServer
+// for forward compatibility.
+type CacheServiceServer interface {
+	SetString(context.Context, *SetStringRequest) (*SetStringResponse, error)
+	PSetString(context.Context, *PSetStringRequest) (*PSetStringResponse, error)
+	SetStringNX(context.Context, *SetStringNXRequest) (*SetStringNXResponse, error)
+	SetStringIfNewer(context.Context, *SetStringIfNewerRequest) (*SetStringIfNewerResponse, error)
+	GetString(context.Context, *GetStringRequest) (*GetStringResponse, error)
+	GetStringWithVersion(context.Context, *GetStringWithVersionRequest) (*GetStringWithVersionResponse, error)
+	SetStringIfVersion(context.Context, *SetStringIfVersionRequest) (*SetStringIfVersionResponse, error)
+	SetBytes(context.Context, *SetBytesRequest) (*SetBytesResponse, error)
+	GetBytes(context.Context, *GetBytesRequest) (*GetBytesResponse, error)
+	DelString(context.Context, *DelStringRequest) (*DelStringResponse, error)
+	CompareAndDelete(context.Context, *CompareAndDeleteRequest) (*CompareAndDeleteResponse, error)
+	CompareAndSwap(context.Context, *CompareAndSwapRequest) (*CompareAndSwapResponse, error)
+	Append(context.Context, *AppendRequest) (*AppendResponse, error)
+	Strlen(context.Context, *StrlenRequest) (*StrlenResponse, error)
+	GetDel(context.Context, *GetDelRequest) (*GetDelResponse, error)
+	GetSet(context.Context, *GetSetRequest) (*GetSetResponse, error)
+	GetEx(context.Context, *GetExRequest) (*GetExResponse, error)
+	MSet(context.Context, *MSetRequest) (*MSetResponse, error)
+	MGet(context.Context, *MGetRequest) (*MGetResponse, error)
+	Exists(context.Context, *ExistsRequest) (*ExistsResponse, error)
+	MDel(context.Context, *MDelRequest) (*MDelResponse, error)
+	Exec(context.Context, *ExecRequest) (*ExecResponse, error)
+	Replicate(*ReplicateRequest, grpc.ServerStreamingServer[ReplicateEvent]) error
+	IncrBy(context.Context, *IncrByRequest) (*IncrByResponse, error)
+	DecrBy(context.Context, *DecrByRequest) (*DecrByResponse, error)
+	HSet(context.Context, *HSetRequest) (*HSetResponse, error)
+	HGet(context.Context, *HGetRequest) (*HGetResponse, error)
+	HDel(context.Context, *HDelRequest) (*HDelResponse, error)
+	HGetAll(context.Context, *HGetAllRequest) (*HGetAllResponse, error)
+	HLen(context.Context, *HLenRequest) (*HLenResponse, error)
+	LPush(context.Context, *LPushRequest) (*LPushResponse, error)
+	RPush(context.Context, *RPushRequest) (*RPushResponse, error)
+	LPop(context.Context, *LPopRequest) (*LPopResponse, error)
+	RPop(context.Context, *RPopRequest) (*RPopResponse, error)
+	LRange(context.Context, *LRangeRequest) (*LRangeResponse, error)
+	LLen(context.Context, *LLenRequest) (*LLenResponse, error)
+	Keys(context.Context, *KeysRequest) (*KeysResponse, error)
+	Scan(context.Context, *ScanRequest) (*ScanResponse, error)
+	ScanStream(*ScanRequest, grpc.ServerStreamingServer[ScanResponse]) error
+	WatchExpired(*WatchExpiredRequest, grpc.ServerStreamingServer[ExpiredEvent]) error
+	RandomKey(context.Context, *RandomKeyRequest) (*RandomKeyResponse, error)
+	Type(context.Context, *TypeRequest) (*TypeResponse, error)
+	DBSize(context.Context, *DBSizeRequest) (*DBSizeResponse, error)
+	MemoryUsage(context.Context, *MemoryUsageRequest) (*MemoryUsageResponse, error)
+	GetTTL(context.Context, *GetTTLRequest) (*GetTTLResponse, error)
+	PTTL(context.Context, *PTTLRequest) (*PTTLResponse, error)
+	Expire(context.Context, *ExpireRequest) (*ExpireResponse, error)
+	Touch(context.Context, *TouchRequest) (*TouchResponse, error)
+	TouchKeys(context.Context, *TouchKeysRequest) (*TouchKeysResponse, error)
+	Rename(context.Context, *RenameRequest) (*RenameResponse, error)
+	Persist(context.Context, *PersistRequest) (*PersistResponse, error)
+	Pin(context.Context, *PinRequest) (*PinResponse, error)
+	Unpin(context.Context, *UnpinRequest) (*UnpinResponse, error)
+	ListPinned(context.Context, *ListPinnedRequest) (*ListPinnedResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	Defrag(context.Context, *DefragRequest) (*DefragResponse, error)
+	RewriteAOF(context.Context, *RewriteAOFRequest) (*RewriteAOFResponse, error)
+	FlushAll(context.Context, *FlushAllRequest) (*FlushAllResponse, error)
+	FlushByPrefix(context.Context, *FlushByPrefixRequest) (*FlushByPrefixResponse, error)
+	Save(context.Context, *SaveRequest) (*SaveResponse, error)
+	Export(*ExportRequest, grpc.ServerStreamingServer[ExportResponse]) error
+	Import(grpc.ClientStreamingServer[ImportRequest, ImportResponse]) error
+	TopKeys(context.Context, *TopKeysRequest) (*TopKeysResponse, error)
+	Capabilities(context.Context, *CapabilitiesRequest) (*CapabilitiesResponse, error)
+	mustEmbedUnimplementedCacheServiceServer()
+}
+
+// UnimplementedCacheServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCacheServiceServer struct{}
+
+func (UnimplementedCacheServiceServer) SetString(context.Context, *SetStringRequest) (*SetStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetString not implemented")
+}
+func (UnimplementedCacheServiceServer) PSetString(context.Context, *PSetStringRequest) (*PSetStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PSetString not implemented")
+}
+func (UnimplementedCacheServiceServer) SetStringNX(context.Context, *SetStringNXRequest) (*SetStringNXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStringNX not implemented")
+}
+func (UnimplementedCacheServiceServer) SetStringIfNewer(context.Context, *SetStringIfNewerRequest) (*SetStringIfNewerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStringIfNewer not implemented")
+}
+func (UnimplementedCacheServiceServer) GetString(context.Context, *GetStringRequest) (*GetStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetString not implemented")
+}
+func (UnimplementedCacheServiceServer) GetStringWithVersion(context.Context, *GetStringWithVersionRequest) (*GetStringWithVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStringWithVersion not implemented")
+}
+func (UnimplementedCacheServiceServer) SetStringIfVersion(context.Context, *SetStringIfVersionRequest) (*SetStringIfVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStringIfVersion not implemented")
+}
+func (UnimplementedCacheServiceServer) SetBytes(context.Context, *SetBytesRequest) (*SetBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBytes not implemented")
+}
+func (UnimplementedCacheServiceServer) GetBytes(context.Context, *GetBytesRequest) (*GetBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBytes not implemented")
+}
+func (UnimplementedCacheServiceServer) DelString(context.Context, *DelStringRequest) (*DelStringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelString not implemented")
+}
+func (UnimplementedCacheServiceServer) CompareAndDelete(context.Context, *CompareAndDeleteRequest) (*CompareAndDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareAndDelete not implemented")
+}
+func (UnimplementedCacheServiceServer) CompareAndSwap(context.Context, *CompareAndSwapRequest) (*CompareAndSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareAndSwap not implemented")
+}
+func (UnimplementedCacheServiceServer) Append(context.Context, *AppendRequest) (*AppendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Append not implemented")
+}
+func (UnimplementedCacheServiceServer) Strlen(context.Context, *StrlenRequest) (*StrlenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Strlen not implemented")
+}
+func (UnimplementedCacheServiceServer) GetDel(context.Context, *GetDelRequest) (*GetDelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDel not implemented")
+}
+func (UnimplementedCacheServiceServer) GetSet(context.Context, *GetSetRequest) (*GetSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSet not implemented")
+}
+func (UnimplementedCacheServiceServer) GetEx(context.Context, *GetExRequest) (*GetExResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEx not implemented")
+}
+func (UnimplementedCacheServiceServer) MSet(context.Context, *MSetRequest) (*MSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MSet not implemented")
+}
+func (UnimplementedCacheServiceServer) MGet(context.Context, *MGetRequest) (*MGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MGet not implemented")
+}
+func (UnimplementedCacheServiceServer) Exists(context.Context, *ExistsRequest) (*ExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exists not implemented")
+}
+func (UnimplementedCacheServiceServer) MDel(context.Context, *MDelRequest) (*MDelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MDel not implemented")
+}
+func (UnimplementedCacheServiceServer) Exec(context.Context, *ExecRequest) (*ExecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
+}
+func (UnimplementedCacheServiceServer) Replicate(*ReplicateRequest, grpc.ServerStreamingServer[ReplicateEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Replicate not implemented")
+}
+func (UnimplementedCacheServiceServer) IncrBy(context.Context, *IncrByRequest) (*IncrByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrBy not implemented")
+}
+func (UnimplementedCacheServiceServer) DecrBy(context.Context, *DecrByRequest) (*DecrByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecrBy not implemented")
+}
+func (UnimplementedCacheServiceServer) HSet(context.Context, *HSetRequest) (*HSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HSet not implemented")
+}
+func (UnimplementedCacheServiceServer) HGet(context.Context, *HGetRequest) (*HGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGet not implemented")
+}
+func (UnimplementedCacheServiceServer) HDel(context.Context, *HDelRequest) (*HDelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HDel not implemented")
+}
+func (UnimplementedCacheServiceServer) HGetAll(context.Context, *HGetAllRequest) (*HGetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGetAll not implemented")
+}
+func (UnimplementedCacheServiceServer) HLen(context.Context, *HLenRequest) (*HLenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HLen not implemented")
+}
+func (UnimplementedCacheServiceServer) LPush(context.Context, *LPushRequest) (*LPushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LPush not implemented")
+}
+func (UnimplementedCacheServiceServer) RPush(context.Context, *RPushRequest) (*RPushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RPush not implemented")
+}
+func (UnimplementedCacheServiceServer) LPop(context.Context, *LPopRequest) (*LPopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LPop not implemented")
+}
+func (UnimplementedCacheServiceServer) RPop(context.Context, *RPopRequest) (*RPopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RPop not implemented")
+}
+func (UnimplementedCacheServiceServer) LRange(context.Context, *LRangeRequest) (*LRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LRange not implemented")
+}
+func (UnimplementedCacheServiceServer) LLen(context.Context, *LLenRequest) (*LLenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LLen not implemented")
+}
+func (UnimplementedCacheServiceServer) Keys(context.Context, *KeysRequest) (*KeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keys not implemented")
+}
+func (UnimplementedCacheServiceServer) Scan(context.Context, *ScanRequest) (*ScanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
+}
+func (UnimplementedCacheServiceServer) ScanStream(*ScanRequest, grpc.ServerStreamingServer[ScanResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ScanStream not implemented")
+}
+func (UnimplementedCacheServiceServer) WatchExpired(*WatchExpiredRequest, grpc.ServerStreamingServer[ExpiredEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchExpired not implemented")
+}
+func (UnimplementedCacheServiceServer) RandomKey(context.Context, *RandomKeyRequest) (*RandomKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RandomKey not implemented")
+}
+func (UnimplementedCacheServiceServer) Type(context.Context, *TypeRequest) (*TypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Type not implemented")
+}
+func (UnimplementedCacheServiceServer) DBSize(context.Context, *DBSizeRequest) (*DBSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DBSize not implemented")
+}
+func (UnimplementedCacheServiceServer) MemoryUsage(context.Context, *MemoryUsageRequest) (*MemoryUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemoryUsage not implemented")
+}
+func (UnimplementedCacheServiceServer) GetTTL(context.Context, *GetTTLRequest) (*GetTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTTL not implemented")
+}
+func (UnimplementedCacheServiceServer) PTTL(context.Context, *PTTLRequest) (*PTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PTTL not implemented")
+}
+func (UnimplementedCacheServiceServer) Expire(context.Context, *ExpireRequest) (*ExpireResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expire not implemented")
+}
+func (UnimplementedCacheServiceServer) Touch(context.Context, *TouchRequest) (*TouchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Touch not implemented")
+}
+func (UnimplementedCacheServiceServer) TouchKeys(context.Context, *TouchKeysRequest) (*TouchKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TouchKeys not implemented")
+}
+func (UnimplementedCacheServiceServer) Rename(context.Context, *RenameRequest) (*RenameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedCacheServiceServer) Persist(context.Context, *PersistRequest) (*PersistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
+func (UnimplementedCacheServiceServer) Pin(context.Context, *PinRequest) (*PinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pin not implemented")
+}
+func (UnimplementedCacheServiceServer) Unpin(context.Context, *UnpinRequest) (*UnpinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpin not implemented")
+}
+func (UnimplementedCacheServiceServer) ListPinned(context.Context, *ListPinnedRequest) (*ListPinnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPinned not implemented")
+}
+func (UnimplementedCacheServiceServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedCacheServiceServer) Defrag(context.Context, *DefragRequest) (*DefragResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Defrag not implemented")
+}
+func (UnimplementedCacheServiceServer) RewriteAOF(context.Context, *RewriteAOFRequest) (*RewriteAOFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewriteAOF not implemented")
+}
+func (UnimplementedCacheServiceServer) FlushAll(context.Context, *FlushAllRequest) (*FlushAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushAll not implemented")
+}
+func (UnimplementedCacheServiceServer) FlushByPrefix(context.Context, *FlushByPrefixRequest) (*FlushByPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushByPrefix not implemented")
+}
+func (UnimplementedCacheServiceServer) Save(context.Context, *SaveRequest) (*SaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Save not implemented")
+}
+func (UnimplementedCacheServiceServer) Export(*ExportRequest, grpc.ServerStreamingServer[ExportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedCacheServiceServer) Import(grpc.ClientStreamingServer[ImportRequest, ImportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedCacheServiceServer) TopKeys(context.Context, *TopKeysRequest) (*TopKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopKeys not implemented")
+}
+func (UnimplementedCacheServiceServer) Capabilities(context.Context, *CapabilitiesRequest) (*CapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Capabilities not implemented")
+}
+func (UnimplementedCacheServiceServer) mustEmbedUnimplementedCacheServiceServer() {}
+func (UnimplementedCacheServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeCacheServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CacheServiceServer will
+// result in compilation errors.
+type UnsafeCacheServiceServer interface {
+	mustEmbedUnimplementedCacheServiceServer()
+}
+
+func RegisterCacheServiceServer(s grpc.ServiceRegistrar, srv CacheServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCacheServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CacheService_ServiceDesc, srv)
+}
+
+func _CacheService_SetString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_SetString_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetString(ctx, req.(*SetStringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PSetString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PSetStringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PSetString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_PSetString_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PSetString(ctx, req.(*PSetStringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SetStringNX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStringNXRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetStringNX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_SetStringNX_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetStringNX(ctx, req.(*SetStringNXRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SetStringIfNewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStringIfNewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetStringIfNewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_SetStringIfNewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetStringIfNewer(ctx, req.(*SetStringIfNewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_GetString_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetString(ctx, req.(*GetStringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetStringWithVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStringWithVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetStringWithVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_GetStringWithVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetStringWithVersion(ctx, req.(*GetStringWithVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SetStringIfVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStringIfVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetStringIfVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_SetStringIfVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetStringIfVersion(ctx, req.(*SetStringIfVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SetBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_SetBytes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetBytes(ctx, req.(*SetBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_GetBytes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetBytes(ctx, req.(*GetBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DelString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelStringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DelString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_DelString_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DelString(ctx, req.(*DelStringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CompareAndDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CompareAndDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_CompareAndDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CompareAndDelete(ctx, req.(*CompareAndDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CompareAndSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CompareAndSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_CompareAndSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CompareAndSwap(ctx, req.(*CompareAndSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Append_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Append(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Append_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Append(ctx, req.(*AppendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Strlen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StrlenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Strlen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Strlen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Strlen(ctx, req.(*StrlenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_GetDel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetDel(ctx, req.(*GetDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_GetSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetSet(ctx, req.(*GetSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetEx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetEx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_GetEx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetEx(ctx, req.(*GetExRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_MSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).MSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_MSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).MSet(ctx, req.(*MSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_MGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).MGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_MGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).MGet(ctx, req.(*MGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Exists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Exists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Exists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Exists(ctx, req.(*ExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_MDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).MDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_MDel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).MDel(ctx, req.(*MDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Exec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Exec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Exec(ctx, req.(*ExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Replicate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplicateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).Replicate(m, &grpc.GenericServerStream[ReplicateRequest, ReplicateEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CacheService_ReplicateServer = grpc.ServerStreamingServer[ReplicateEvent]
+
+func _CacheService_IncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).IncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_IncrBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).IncrBy(ctx, req.(*IncrByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DecrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecrByRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DecrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_DecrBy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DecrBy(ctx, req.(*DecrByRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_HSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).HSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_HSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).HSet(ctx, req.(*HSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_HGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).HGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_HGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).HGet(ctx, req.(*HGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_HDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HDelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).HDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_HDel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).HDel(ctx, req.(*HDelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_HGetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HGetAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).HGetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_HGetAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).HGetAll(ctx, req.(*HGetAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_HLen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HLenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).HLen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_HLen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).HLen(ctx, req.(*HLenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_LPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).LPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_LPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).LPush(ctx, req.(*LPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_RPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_RPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RPush(ctx, req.(*RPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_LPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).LPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_LPop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).LPop(ctx, req.(*LPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_RPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_RPop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RPop(ctx, req.(*RPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_LRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).LRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_LRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).LRange(ctx, req.(*LRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_LLen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LLenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).LLen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_LLen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).LLen(ctx, req.(*LLenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Keys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Keys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Keys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Keys(ctx, req.(*KeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Scan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Scan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Scan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Scan(ctx, req.(*ScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_ScanStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).ScanStream(m, &grpc.GenericServerStream[ScanRequest, ScanResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CacheService_ScanStreamServer = grpc.ServerStreamingServer[ScanResponse]
+
+func _CacheService_WatchExpired_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchExpiredRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).WatchExpired(m, &grpc.GenericServerStream[WatchExpiredRequest, ExpiredEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CacheService_WatchExpiredServer = grpc.ServerStreamingServer[ExpiredEvent]
+
+func _CacheService_RandomKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RandomKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RandomKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_RandomKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RandomKey(ctx, req.(*RandomKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Type_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Type(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Type_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Type(ctx, req.(*TypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DBSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DBSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DBSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_DBSize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DBSize(ctx, req.(*DBSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_MemoryUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemoryUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).MemoryUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_MemoryUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).MemoryUsage(ctx, req.(*MemoryUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_GetTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetTTL(ctx, req.(*GetTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_PTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PTTL(ctx, req.(*PTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Expire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Expire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Expire_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Expire(ctx, req.(*ExpireRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Touch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Touch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Touch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Touch(ctx, req.(*TouchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TouchKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TouchKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_TouchKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TouchKeys(ctx, req.(*TouchKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Rename_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Rename(ctx, req.(*RenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Persist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Persist(ctx, req.(*PersistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Pin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Pin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Pin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Pin(ctx, req.(*PinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Unpin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Unpin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Unpin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Unpin(ctx, req.(*UnpinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *cacheServiceClient) DelString(ctx context.Context, in *DelStringRequest, opts ...grpc.CallOption) (*DelStringResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DelStringResponse)
-	err := c.cc.Invoke(ctx, CacheService_DelString_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _CacheService_ListPinned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPinnedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(CacheServiceServer).ListPinned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_ListPinned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).ListPinned(ctx, req.(*ListPinnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// CacheServiceServer is the server API for CacheService service.
-// All implementations must embed UnimplementedCacheServiceServer
-// for forward compatibility.
-type CacheServiceServer interface {
-	SetString(context.Context, *SetStringRequest) (*SetStringResponse, error)
-	GetString(context.Context, *GetStringRequest) (*GetStringResponse, error)
-	DelString(context.Context, *DelStringRequest) (*DelStringResponse, error)
-	mustEmbedUnimplementedCacheServiceServer()
+func _CacheService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Stats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedCacheServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCacheServiceServer struct{}
-
-func (UnimplementedCacheServiceServer) SetString(context.Context, *SetStringRequest) (*SetStringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetString not implemented")
-}
-func (UnimplementedCacheServiceServer) GetString(context.Context, *GetStringRequest) (*GetStringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetString not implemented")
+func _CacheService_Defrag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefragRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Defrag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_Defrag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Defrag(ctx, req.(*DefragRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCacheServiceServer) DelString(context.Context, *DelStringRequest) (*DelStringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelString not implemented")
+
+func _CacheService_RewriteAOF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewriteAOFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RewriteAOF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_RewriteAOF_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RewriteAOF(ctx, req.(*RewriteAOFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCacheServiceServer) mustEmbedUnimplementedCacheServiceServer() {}
-func (UnimplementedCacheServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeCacheServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CacheServiceServer will
-// result in compilation errors.
-type UnsafeCacheServiceServer interface {
-	mustEmbedUnimplementedCacheServiceServer()
+func _CacheService_FlushAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).FlushAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_FlushAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).FlushAll(ctx, req.(*FlushAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCacheServiceServer(s grpc.ServiceRegistrar, srv CacheServiceServer) {
-	// If the following call pancis, it indicates UnimplementedCacheServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _CacheService_FlushByPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushByPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&CacheService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(CacheServiceServer).FlushByPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_FlushByPrefix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).FlushByPrefix(ctx, req.(*FlushByPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CacheService_SetString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetStringRequest)
+func _CacheService_Save_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CacheServiceServer).SetString(ctx, in)
+		return srv.(CacheServiceServer).Save(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CacheService_SetString_FullMethodName,
+		FullMethod: CacheService_Save_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).SetString(ctx, req.(*SetStringRequest))
+		return srv.(CacheServiceServer).Save(ctx, req.(*SaveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CacheService_GetString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStringRequest)
+func _CacheService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).Export(m, &grpc.GenericServerStream[ExportRequest, ExportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CacheService_ExportServer = grpc.ServerStreamingServer[ExportResponse]
+
+func _CacheService_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CacheServiceServer).Import(&grpc.GenericServerStream[ImportRequest, ImportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CacheService_ImportServer = grpc.ClientStreamingServer[ImportRequest, ImportResponse]
+
+func _CacheService_TopKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CacheServiceServer).GetString(ctx, in)
+		return srv.(CacheServiceServer).TopKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CacheService_GetString_FullMethodName,
+		FullMethod: CacheService_TopKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).GetString(ctx, req.(*GetStringRequest))
+		return srv.(CacheServiceServer).TopKeys(ctx, req.(*TopKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CacheService_DelString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelStringRequest)
+func _CacheService_Capabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapabilitiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CacheServiceServer).DelString(ctx, in)
+		return srv.(CacheServiceServer).Capabilities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CacheService_DelString_FullMethodName,
+		FullMethod: CacheService_Capabilities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).DelString(ctx, req.(*DelStringRequest))
+		return srv.(CacheServiceServer).Capabilities(ctx, req.(*CapabilitiesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -183,15 +2257,265 @@ var CacheService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetString",
 			Handler:    _CacheService_SetString_Handler,
 		},
+		{
+			MethodName: "PSetString",
+			Handler:    _CacheService_PSetString_Handler,
+		},
+		{
+			MethodName: "SetStringNX",
+			Handler:    _CacheService_SetStringNX_Handler,
+		},
+		{
+			MethodName: "SetStringIfNewer",
+			Handler:    _CacheService_SetStringIfNewer_Handler,
+		},
 		{
 			MethodName: "GetString",
 			Handler:    _CacheService_GetString_Handler,
 		},
+		{
+			MethodName: "GetStringWithVersion",
+			Handler:    _CacheService_GetStringWithVersion_Handler,
+		},
+		{
+			MethodName: "SetStringIfVersion",
+			Handler:    _CacheService_SetStringIfVersion_Handler,
+		},
+		{
+			MethodName: "SetBytes",
+			Handler:    _CacheService_SetBytes_Handler,
+		},
+		{
+			MethodName: "GetBytes",
+			Handler:    _CacheService_GetBytes_Handler,
+		},
 		{
 			MethodName: "DelString",
 			Handler:    _CacheService_DelString_Handler,
 		},
+		{
+			MethodName: "CompareAndDelete",
+			Handler:    _CacheService_CompareAndDelete_Handler,
+		},
+		{
+			MethodName: "CompareAndSwap",
+			Handler:    _CacheService_CompareAndSwap_Handler,
+		},
+		{
+			MethodName: "Append",
+			Handler:    _CacheService_Append_Handler,
+		},
+		{
+			MethodName: "Strlen",
+			Handler:    _CacheService_Strlen_Handler,
+		},
+		{
+			MethodName: "GetDel",
+			Handler:    _CacheService_GetDel_Handler,
+		},
+		{
+			MethodName: "GetSet",
+			Handler:    _CacheService_GetSet_Handler,
+		},
+		{
+			MethodName: "GetEx",
+			Handler:    _CacheService_GetEx_Handler,
+		},
+		{
+			MethodName: "MSet",
+			Handler:    _CacheService_MSet_Handler,
+		},
+		{
+			MethodName: "MGet",
+			Handler:    _CacheService_MGet_Handler,
+		},
+		{
+			MethodName: "Exists",
+			Handler:    _CacheService_Exists_Handler,
+		},
+		{
+			MethodName: "MDel",
+			Handler:    _CacheService_MDel_Handler,
+		},
+		{
+			MethodName: "Exec",
+			Handler:    _CacheService_Exec_Handler,
+		},
+		{
+			MethodName: "IncrBy",
+			Handler:    _CacheService_IncrBy_Handler,
+		},
+		{
+			MethodName: "DecrBy",
+			Handler:    _CacheService_DecrBy_Handler,
+		},
+		{
+			MethodName: "HSet",
+			Handler:    _CacheService_HSet_Handler,
+		},
+		{
+			MethodName: "HGet",
+			Handler:    _CacheService_HGet_Handler,
+		},
+		{
+			MethodName: "HDel",
+			Handler:    _CacheService_HDel_Handler,
+		},
+		{
+			MethodName: "HGetAll",
+			Handler:    _CacheService_HGetAll_Handler,
+		},
+		{
+			MethodName: "HLen",
+			Handler:    _CacheService_HLen_Handler,
+		},
+		{
+			MethodName: "LPush",
+			Handler:    _CacheService_LPush_Handler,
+		},
+		{
+			MethodName: "RPush",
+			Handler:    _CacheService_RPush_Handler,
+		},
+		{
+			MethodName: "LPop",
+			Handler:    _CacheService_LPop_Handler,
+		},
+		{
+			MethodName: "RPop",
+			Handler:    _CacheService_RPop_Handler,
+		},
+		{
+			MethodName: "LRange",
+			Handler:    _CacheService_LRange_Handler,
+		},
+		{
+			MethodName: "LLen",
+			Handler:    _CacheService_LLen_Handler,
+		},
+		{
+			MethodName: "Keys",
+			Handler:    _CacheService_Keys_Handler,
+		},
+		{
+			MethodName: "Scan",
+			Handler:    _CacheService_Scan_Handler,
+		},
+		{
+			MethodName: "RandomKey",
+			Handler:    _CacheService_RandomKey_Handler,
+		},
+		{
+			MethodName: "Type",
+			Handler:    _CacheService_Type_Handler,
+		},
+		{
+			MethodName: "DBSize",
+			Handler:    _CacheService_DBSize_Handler,
+		},
+		{
+			MethodName: "MemoryUsage",
+			Handler:    _CacheService_MemoryUsage_Handler,
+		},
+		{
+			MethodName: "GetTTL",
+			Handler:    _CacheService_GetTTL_Handler,
+		},
+		{
+			MethodName: "PTTL",
+			Handler:    _CacheService_PTTL_Handler,
+		},
+		{
+			MethodName: "Expire",
+			Handler:    _CacheService_Expire_Handler,
+		},
+		{
+			MethodName: "Touch",
+			Handler:    _CacheService_Touch_Handler,
+		},
+		{
+			MethodName: "TouchKeys",
+			Handler:    _CacheService_TouchKeys_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _CacheService_Rename_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _CacheService_Persist_Handler,
+		},
+		{
+			MethodName: "Pin",
+			Handler:    _CacheService_Pin_Handler,
+		},
+		{
+			MethodName: "Unpin",
+			Handler:    _CacheService_Unpin_Handler,
+		},
+		{
+			MethodName: "ListPinned",
+			Handler:    _CacheService_ListPinned_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _CacheService_Stats_Handler,
+		},
+		{
+			MethodName: "Defrag",
+			Handler:    _CacheService_Defrag_Handler,
+		},
+		{
+			MethodName: "RewriteAOF",
+			Handler:    _CacheService_RewriteAOF_Handler,
+		},
+		{
+			MethodName: "FlushAll",
+			Handler:    _CacheService_FlushAll_Handler,
+		},
+		{
+			MethodName: "FlushByPrefix",
+			Handler:    _CacheService_FlushByPrefix_Handler,
+		},
+		{
+			MethodName: "Save",
+			Handler:    _CacheService_Save_Handler,
+		},
+		{
+			MethodName: "TopKeys",
+			Handler:    _CacheService_TopKeys_Handler,
+		},
+		{
+			MethodName: "Capabilities",
+			Handler:    _CacheService_Capabilities_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Replicate",
+			Handler:       _CacheService_Replicate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanStream",
+			Handler:       _CacheService_ScanStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchExpired",
+			Handler:       _CacheService_WatchExpired_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _CacheService_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _CacheService_Import_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cache/v1/cache.proto",
 }
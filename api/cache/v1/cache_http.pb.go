@@ -19,21 +19,189 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationCacheServiceAppend = "/cache.v1.CacheService/Append"
+const OperationCacheServiceCapabilities = "/cache.v1.CacheService/Capabilities"
+const OperationCacheServiceCompareAndDelete = "/cache.v1.CacheService/CompareAndDelete"
+const OperationCacheServiceCompareAndSwap = "/cache.v1.CacheService/CompareAndSwap"
+const OperationCacheServiceDBSize = "/cache.v1.CacheService/DBSize"
+const OperationCacheServiceDecrBy = "/cache.v1.CacheService/DecrBy"
+const OperationCacheServiceDefrag = "/cache.v1.CacheService/Defrag"
 const OperationCacheServiceDelString = "/cache.v1.CacheService/DelString"
+const OperationCacheServiceExec = "/cache.v1.CacheService/Exec"
+const OperationCacheServiceExists = "/cache.v1.CacheService/Exists"
+const OperationCacheServiceExpire = "/cache.v1.CacheService/Expire"
+const OperationCacheServiceFlushAll = "/cache.v1.CacheService/FlushAll"
+const OperationCacheServiceFlushByPrefix = "/cache.v1.CacheService/FlushByPrefix"
+const OperationCacheServiceGetBytes = "/cache.v1.CacheService/GetBytes"
+const OperationCacheServiceGetDel = "/cache.v1.CacheService/GetDel"
+const OperationCacheServiceGetEx = "/cache.v1.CacheService/GetEx"
+const OperationCacheServiceGetSet = "/cache.v1.CacheService/GetSet"
 const OperationCacheServiceGetString = "/cache.v1.CacheService/GetString"
+const OperationCacheServiceGetStringWithVersion = "/cache.v1.CacheService/GetStringWithVersion"
+const OperationCacheServiceGetTTL = "/cache.v1.CacheService/GetTTL"
+const OperationCacheServiceHDel = "/cache.v1.CacheService/HDel"
+const OperationCacheServiceHGet = "/cache.v1.CacheService/HGet"
+const OperationCacheServiceHGetAll = "/cache.v1.CacheService/HGetAll"
+const OperationCacheServiceHLen = "/cache.v1.CacheService/HLen"
+const OperationCacheServiceHSet = "/cache.v1.CacheService/HSet"
+const OperationCacheServiceIncrBy = "/cache.v1.CacheService/IncrBy"
+const OperationCacheServiceKeys = "/cache.v1.CacheService/Keys"
+const OperationCacheServiceLLen = "/cache.v1.CacheService/LLen"
+const OperationCacheServiceLPop = "/cache.v1.CacheService/LPop"
+const OperationCacheServiceLPush = "/cache.v1.CacheService/LPush"
+const OperationCacheServiceLRange = "/cache.v1.CacheService/LRange"
+const OperationCacheServiceListPinned = "/cache.v1.CacheService/ListPinned"
+const OperationCacheServiceMDel = "/cache.v1.CacheService/MDel"
+const OperationCacheServiceMGet = "/cache.v1.CacheService/MGet"
+const OperationCacheServiceMSet = "/cache.v1.CacheService/MSet"
+const OperationCacheServiceMemoryUsage = "/cache.v1.CacheService/MemoryUsage"
+const OperationCacheServicePSetString = "/cache.v1.CacheService/PSetString"
+const OperationCacheServicePTTL = "/cache.v1.CacheService/PTTL"
+const OperationCacheServicePersist = "/cache.v1.CacheService/Persist"
+const OperationCacheServicePin = "/cache.v1.CacheService/Pin"
+const OperationCacheServiceRPop = "/cache.v1.CacheService/RPop"
+const OperationCacheServiceRPush = "/cache.v1.CacheService/RPush"
+const OperationCacheServiceRandomKey = "/cache.v1.CacheService/RandomKey"
+const OperationCacheServiceRename = "/cache.v1.CacheService/Rename"
+const OperationCacheServiceRewriteAOF = "/cache.v1.CacheService/RewriteAOF"
+const OperationCacheServiceSave = "/cache.v1.CacheService/Save"
+const OperationCacheServiceScan = "/cache.v1.CacheService/Scan"
+const OperationCacheServiceSetBytes = "/cache.v1.CacheService/SetBytes"
 const OperationCacheServiceSetString = "/cache.v1.CacheService/SetString"
+const OperationCacheServiceSetStringIfNewer = "/cache.v1.CacheService/SetStringIfNewer"
+const OperationCacheServiceSetStringIfVersion = "/cache.v1.CacheService/SetStringIfVersion"
+const OperationCacheServiceSetStringNX = "/cache.v1.CacheService/SetStringNX"
+const OperationCacheServiceStats = "/cache.v1.CacheService/Stats"
+const OperationCacheServiceStrlen = "/cache.v1.CacheService/Strlen"
+const OperationCacheServiceTopKeys = "/cache.v1.CacheService/TopKeys"
+const OperationCacheServiceTouch = "/cache.v1.CacheService/Touch"
+const OperationCacheServiceTouchKeys = "/cache.v1.CacheService/TouchKeys"
+const OperationCacheServiceType = "/cache.v1.CacheService/Type"
+const OperationCacheServiceUnpin = "/cache.v1.CacheService/Unpin"
 
 type CacheServiceHTTPServer interface {
+	Append(context.Context, *AppendRequest) (*AppendResponse, error)
+	Capabilities(context.Context, *CapabilitiesRequest) (*CapabilitiesResponse, error)
+	CompareAndDelete(context.Context, *CompareAndDeleteRequest) (*CompareAndDeleteResponse, error)
+	CompareAndSwap(context.Context, *CompareAndSwapRequest) (*CompareAndSwapResponse, error)
+	DBSize(context.Context, *DBSizeRequest) (*DBSizeResponse, error)
+	DecrBy(context.Context, *DecrByRequest) (*DecrByResponse, error)
+	Defrag(context.Context, *DefragRequest) (*DefragResponse, error)
 	DelString(context.Context, *DelStringRequest) (*DelStringResponse, error)
+	Exec(context.Context, *ExecRequest) (*ExecResponse, error)
+	Exists(context.Context, *ExistsRequest) (*ExistsResponse, error)
+	Expire(context.Context, *ExpireRequest) (*ExpireResponse, error)
+	FlushAll(context.Context, *FlushAllRequest) (*FlushAllResponse, error)
+	FlushByPrefix(context.Context, *FlushByPrefixRequest) (*FlushByPrefixResponse, error)
+	GetBytes(context.Context, *GetBytesRequest) (*GetBytesResponse, error)
+	GetDel(context.Context, *GetDelRequest) (*GetDelResponse, error)
+	GetEx(context.Context, *GetExRequest) (*GetExResponse, error)
+	GetSet(context.Context, *GetSetRequest) (*GetSetResponse, error)
 	GetString(context.Context, *GetStringRequest) (*GetStringResponse, error)
+	GetStringWithVersion(context.Context, *GetStringWithVersionRequest) (*GetStringWithVersionResponse, error)
+	GetTTL(context.Context, *GetTTLRequest) (*GetTTLResponse, error)
+	HDel(context.Context, *HDelRequest) (*HDelResponse, error)
+	HGet(context.Context, *HGetRequest) (*HGetResponse, error)
+	HGetAll(context.Context, *HGetAllRequest) (*HGetAllResponse, error)
+	HLen(context.Context, *HLenRequest) (*HLenResponse, error)
+	HSet(context.Context, *HSetRequest) (*HSetResponse, error)
+	IncrBy(context.Context, *IncrByRequest) (*IncrByResponse, error)
+	Keys(context.Context, *KeysRequest) (*KeysResponse, error)
+	LLen(context.Context, *LLenRequest) (*LLenResponse, error)
+	LPop(context.Context, *LPopRequest) (*LPopResponse, error)
+	LPush(context.Context, *LPushRequest) (*LPushResponse, error)
+	LRange(context.Context, *LRangeRequest) (*LRangeResponse, error)
+	ListPinned(context.Context, *ListPinnedRequest) (*ListPinnedResponse, error)
+	MDel(context.Context, *MDelRequest) (*MDelResponse, error)
+	MGet(context.Context, *MGetRequest) (*MGetResponse, error)
+	MSet(context.Context, *MSetRequest) (*MSetResponse, error)
+	MemoryUsage(context.Context, *MemoryUsageRequest) (*MemoryUsageResponse, error)
+	PSetString(context.Context, *PSetStringRequest) (*PSetStringResponse, error)
+	PTTL(context.Context, *PTTLRequest) (*PTTLResponse, error)
+	Persist(context.Context, *PersistRequest) (*PersistResponse, error)
+	Pin(context.Context, *PinRequest) (*PinResponse, error)
+	RPop(context.Context, *RPopRequest) (*RPopResponse, error)
+	RPush(context.Context, *RPushRequest) (*RPushResponse, error)
+	RandomKey(context.Context, *RandomKeyRequest) (*RandomKeyResponse, error)
+	Rename(context.Context, *RenameRequest) (*RenameResponse, error)
+	RewriteAOF(context.Context, *RewriteAOFRequest) (*RewriteAOFResponse, error)
+	Save(context.Context, *SaveRequest) (*SaveResponse, error)
+	Scan(context.Context, *ScanRequest) (*ScanResponse, error)
+	SetBytes(context.Context, *SetBytesRequest) (*SetBytesResponse, error)
 	SetString(context.Context, *SetStringRequest) (*SetStringResponse, error)
+	SetStringIfNewer(context.Context, *SetStringIfNewerRequest) (*SetStringIfNewerResponse, error)
+	SetStringIfVersion(context.Context, *SetStringIfVersionRequest) (*SetStringIfVersionResponse, error)
+	SetStringNX(context.Context, *SetStringNXRequest) (*SetStringNXResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	Strlen(context.Context, *StrlenRequest) (*StrlenResponse, error)
+	TopKeys(context.Context, *TopKeysRequest) (*TopKeysResponse, error)
+	Touch(context.Context, *TouchRequest) (*TouchResponse, error)
+	TouchKeys(context.Context, *TouchKeysRequest) (*TouchKeysResponse, error)
+	Type(context.Context, *TypeRequest) (*TypeResponse, error)
+	Unpin(context.Context, *UnpinRequest) (*UnpinResponse, error)
 }
 
 func RegisterCacheServiceHTTPServer(s *http.Server, srv CacheServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/cache/string/{key}", _CacheService_SetString0_HTTP_Handler(srv))
+	r.POST("/v1/cache/string/{key}/px", _CacheService_PSetString0_HTTP_Handler(srv))
+	r.POST("/v1/cache/string/{key}/nx", _CacheService_SetStringNX0_HTTP_Handler(srv))
+	r.POST("/v1/cache/string/{key}/if-newer", _CacheService_SetStringIfNewer0_HTTP_Handler(srv))
 	r.GET("/v1/cache/string/{key}", _CacheService_GetString0_HTTP_Handler(srv))
+	r.GET("/v1/cache/string/{key}/version", _CacheService_GetStringWithVersion0_HTTP_Handler(srv))
+	r.POST("/v1/cache/string/{key}/if-version", _CacheService_SetStringIfVersion0_HTTP_Handler(srv))
+	r.POST("/v1/cache/bytes/{key}", _CacheService_SetBytes0_HTTP_Handler(srv))
+	r.GET("/v1/cache/bytes/{key}", _CacheService_GetBytes0_HTTP_Handler(srv))
 	r.DELETE("/v1/cache/string/{key}", _CacheService_DelString0_HTTP_Handler(srv))
+	r.POST("/v1/cache/string/{key}/cad", _CacheService_CompareAndDelete0_HTTP_Handler(srv))
+	r.POST("/v1/cache/string/{key}/cas", _CacheService_CompareAndSwap0_HTTP_Handler(srv))
+	r.POST("/v1/cache/string/{key}/append", _CacheService_Append0_HTTP_Handler(srv))
+	r.GET("/v1/cache/strlen/{key}", _CacheService_Strlen0_HTTP_Handler(srv))
+	r.POST("/v1/cache/string/{key}/getdel", _CacheService_GetDel0_HTTP_Handler(srv))
+	r.POST("/v1/cache/string/{key}/getset", _CacheService_GetSet0_HTTP_Handler(srv))
+	r.POST("/v1/cache/string/{key}/getex", _CacheService_GetEx0_HTTP_Handler(srv))
+	r.POST("/v1/cache/mset", _CacheService_MSet0_HTTP_Handler(srv))
+	r.GET("/v1/cache/mget", _CacheService_MGet0_HTTP_Handler(srv))
+	r.GET("/v1/cache/exists", _CacheService_Exists0_HTTP_Handler(srv))
+	r.POST("/v1/cache/mdel", _CacheService_MDel0_HTTP_Handler(srv))
+	r.POST("/v1/cache/exec", _CacheService_Exec0_HTTP_Handler(srv))
+	r.POST("/v1/cache/incr/{key}", _CacheService_IncrBy0_HTTP_Handler(srv))
+	r.POST("/v1/cache/decr/{key}", _CacheService_DecrBy0_HTTP_Handler(srv))
+	r.POST("/v1/cache/hash/{key}", _CacheService_HSet0_HTTP_Handler(srv))
+	r.GET("/v1/cache/hash/{key}/{field}", _CacheService_HGet0_HTTP_Handler(srv))
+	r.DELETE("/v1/cache/hash/{key}/{field}", _CacheService_HDel0_HTTP_Handler(srv))
+	r.GET("/v1/cache/hash/{key}", _CacheService_HGetAll0_HTTP_Handler(srv))
+	r.GET("/v1/cache/hlen/{key}", _CacheService_HLen0_HTTP_Handler(srv))
+	r.POST("/v1/cache/list/{key}/lpush", _CacheService_LPush0_HTTP_Handler(srv))
+	r.POST("/v1/cache/list/{key}/rpush", _CacheService_RPush0_HTTP_Handler(srv))
+	r.POST("/v1/cache/list/{key}/lpop", _CacheService_LPop0_HTTP_Handler(srv))
+	r.POST("/v1/cache/list/{key}/rpop", _CacheService_RPop0_HTTP_Handler(srv))
+	r.GET("/v1/cache/list/{key}", _CacheService_LRange0_HTTP_Handler(srv))
+	r.GET("/v1/cache/llen/{key}", _CacheService_LLen0_HTTP_Handler(srv))
+	r.GET("/v1/cache/keys", _CacheService_Keys0_HTTP_Handler(srv))
+	r.GET("/v1/cache/scan", _CacheService_Scan0_HTTP_Handler(srv))
+	r.GET("/v1/cache/randomkey", _CacheService_RandomKey0_HTTP_Handler(srv))
+	r.GET("/v1/cache/type/{key}", _CacheService_Type0_HTTP_Handler(srv))
+	r.GET("/v1/cache/dbsize", _CacheService_DBSize0_HTTP_Handler(srv))
+	r.GET("/v1/cache/memory/{key}", _CacheService_MemoryUsage0_HTTP_Handler(srv))
+	r.GET("/v1/cache/ttl/{key}", _CacheService_GetTTL0_HTTP_Handler(srv))
+	r.GET("/v1/cache/pttl/{key}", _CacheService_PTTL0_HTTP_Handler(srv))
+	r.POST("/v1/cache/expire/{key}", _CacheService_Expire0_HTTP_Handler(srv))
+	r.POST("/v1/cache/touch/{key}", _CacheService_Touch0_HTTP_Handler(srv))
+	r.POST("/v1/cache/touch", _CacheService_TouchKeys0_HTTP_Handler(srv))
+	r.POST("/v1/cache/rename/{key}", _CacheService_Rename0_HTTP_Handler(srv))
+	r.POST("/v1/cache/persist/{key}", _CacheService_Persist0_HTTP_Handler(srv))
+	r.POST("/v1/cache/pin/{key}", _CacheService_Pin0_HTTP_Handler(srv))
+	r.DELETE("/v1/cache/pin/{key}", _CacheService_Unpin0_HTTP_Handler(srv))
+	r.GET("/v1/cache/pinned", _CacheService_ListPinned0_HTTP_Handler(srv))
+	r.GET("/v1/cache/stats", _CacheService_Stats0_HTTP_Handler(srv))
+	r.POST("/v1/cache/admin/defrag", _CacheService_Defrag0_HTTP_Handler(srv))
+	r.POST("/v1/cache/admin/rewrite-aof", _CacheService_RewriteAOF0_HTTP_Handler(srv))
+	r.POST("/v1/cache/admin/flushall", _CacheService_FlushAll0_HTTP_Handler(srv))
+	r.POST("/v1/cache/admin/flush-prefix", _CacheService_FlushByPrefix0_HTTP_Handler(srv))
+	r.POST("/v1/cache/admin/save", _CacheService_Save0_HTTP_Handler(srv))
+	r.GET("/v1/cache/hotkeys", _CacheService_TopKeys0_HTTP_Handler(srv))
+	r.GET("/v1/cache/capabilities", _CacheService_Capabilities0_HTTP_Handler(srv))
 }
 
 func _CacheService_SetString0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
@@ -61,6 +229,81 @@ func _CacheService_SetString0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx
 	}
 }
 
+func _CacheService_PSetString0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PSetStringRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServicePSetString)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PSetString(ctx, req.(*PSetStringRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PSetStringResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_SetStringNX0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetStringNXRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceSetStringNX)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetStringNX(ctx, req.(*SetStringNXRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetStringNXResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_SetStringIfNewer0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetStringIfNewerRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceSetStringIfNewer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetStringIfNewer(ctx, req.(*SetStringIfNewerRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetStringIfNewerResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _CacheService_GetString0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetStringRequest
@@ -83,6 +326,100 @@ func _CacheService_GetString0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx
 	}
 }
 
+func _CacheService_GetStringWithVersion0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetStringWithVersionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceGetStringWithVersion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetStringWithVersion(ctx, req.(*GetStringWithVersionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetStringWithVersionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_SetStringIfVersion0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetStringIfVersionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceSetStringIfVersion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetStringIfVersion(ctx, req.(*SetStringIfVersionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetStringIfVersionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_SetBytes0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetBytesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceSetBytes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetBytes(ctx, req.(*SetBytesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetBytesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_GetBytes0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetBytesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceGetBytes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBytes(ctx, req.(*GetBytesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetBytesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _CacheService_DelString0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DelStringRequest
@@ -105,53 +442,1939 @@ func _CacheService_DelString0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx
 	}
 }
 
-type CacheServiceHTTPClient interface {
-	DelString(ctx context.Context, req *DelStringRequest, opts ...http.CallOption) (rsp *DelStringResponse, err error)
-	GetString(ctx context.Context, req *GetStringRequest, opts ...http.CallOption) (rsp *GetStringResponse, err error)
-	SetString(ctx context.Context, req *SetStringRequest, opts ...http.CallOption) (rsp *SetStringResponse, err error)
+func _CacheService_CompareAndDelete0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompareAndDeleteRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceCompareAndDelete)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CompareAndDelete(ctx, req.(*CompareAndDeleteRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompareAndDeleteResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-type CacheServiceHTTPClientImpl struct {
-	cc *http.Client
+func _CacheService_CompareAndSwap0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompareAndSwapRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceCompareAndSwap)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CompareAndSwap(ctx, req.(*CompareAndSwapRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompareAndSwapResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewCacheServiceHTTPClient(client *http.Client) CacheServiceHTTPClient {
-	return &CacheServiceHTTPClientImpl{client}
+func _CacheService_Append0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AppendRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceAppend)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Append(ctx, req.(*AppendRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AppendResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-func (c *CacheServiceHTTPClientImpl) DelString(ctx context.Context, in *DelStringRequest, opts ...http.CallOption) (*DelStringResponse, error) {
-	var out DelStringResponse
-	pattern := "/v1/cache/string/{key}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationCacheServiceDelString))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _CacheService_Strlen0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StrlenRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceStrlen)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Strlen(ctx, req.(*StrlenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*StrlenResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *CacheServiceHTTPClientImpl) GetString(ctx context.Context, in *GetStringRequest, opts ...http.CallOption) (*GetStringResponse, error) {
-	var out GetStringResponse
-	pattern := "/v1/cache/string/{key}"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationCacheServiceGetString))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _CacheService_GetDel0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetDelRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceGetDel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetDel(ctx, req.(*GetDelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetDelResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *CacheServiceHTTPClientImpl) SetString(ctx context.Context, in *SetStringRequest, opts ...http.CallOption) (*SetStringResponse, error) {
-	var out SetStringResponse
-	pattern := "/v1/cache/string/{key}"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationCacheServiceSetString))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+func _CacheService_GetSet0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceGetSet)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSet(ctx, req.(*GetSetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSetResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_GetEx0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetExRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceGetEx)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetEx(ctx, req.(*GetExRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetExResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_MSet0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MSetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceMSet)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MSet(ctx, req.(*MSetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MSetResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_MGet0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MGetRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceMGet)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MGet(ctx, req.(*MGetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MGetResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Exists0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExistsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceExists)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Exists(ctx, req.(*ExistsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExistsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_MDel0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MDelRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceMDel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MDel(ctx, req.(*MDelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MDelResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Exec0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExecRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceExec)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Exec(ctx, req.(*ExecRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExecResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_IncrBy0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in IncrByRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceIncrBy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.IncrBy(ctx, req.(*IncrByRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*IncrByResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_DecrBy0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DecrByRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceDecrBy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DecrBy(ctx, req.(*DecrByRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DecrByResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_HSet0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HSetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceHSet)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.HSet(ctx, req.(*HSetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HSetResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_HGet0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HGetRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceHGet)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.HGet(ctx, req.(*HGetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HGetResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_HDel0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HDelRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceHDel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.HDel(ctx, req.(*HDelRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HDelResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_HGetAll0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HGetAllRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceHGetAll)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.HGetAll(ctx, req.(*HGetAllRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HGetAllResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_HLen0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in HLenRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceHLen)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.HLen(ctx, req.(*HLenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*HLenResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_LPush0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LPushRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceLPush)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LPush(ctx, req.(*LPushRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LPushResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_RPush0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RPushRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceRPush)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RPush(ctx, req.(*RPushRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RPushResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_LPop0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LPopRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceLPop)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LPop(ctx, req.(*LPopRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LPopResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_RPop0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RPopRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceRPop)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RPop(ctx, req.(*RPopRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RPopResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_LRange0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LRangeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceLRange)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LRange(ctx, req.(*LRangeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LRangeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_LLen0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LLenRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceLLen)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LLen(ctx, req.(*LLenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LLenResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Keys0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in KeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Keys(ctx, req.(*KeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*KeysResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Scan0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ScanRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceScan)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Scan(ctx, req.(*ScanRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ScanResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_RandomKey0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RandomKeyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceRandomKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RandomKey(ctx, req.(*RandomKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RandomKeyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Type0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TypeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceType)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Type(ctx, req.(*TypeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TypeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_DBSize0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DBSizeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceDBSize)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DBSize(ctx, req.(*DBSizeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DBSizeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_MemoryUsage0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MemoryUsageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceMemoryUsage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MemoryUsage(ctx, req.(*MemoryUsageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MemoryUsageResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_GetTTL0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTTLRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceGetTTL)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTTL(ctx, req.(*GetTTLRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetTTLResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_PTTL0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PTTLRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServicePTTL)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PTTL(ctx, req.(*PTTLRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PTTLResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Expire0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExpireRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceExpire)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Expire(ctx, req.(*ExpireRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExpireResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Touch0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TouchRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceTouch)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Touch(ctx, req.(*TouchRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TouchResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_TouchKeys0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TouchKeysRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceTouchKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TouchKeys(ctx, req.(*TouchKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TouchKeysResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Rename0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenameRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceRename)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Rename(ctx, req.(*RenameRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RenameResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Persist0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PersistRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServicePersist)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Persist(ctx, req.(*PersistRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PersistResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Pin0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PinRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServicePin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Pin(ctx, req.(*PinRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PinResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Unpin0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnpinRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceUnpin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Unpin(ctx, req.(*UnpinRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnpinResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_ListPinned0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPinnedRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceListPinned)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPinned(ctx, req.(*ListPinnedRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPinnedResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Stats0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in StatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Stats(ctx, req.(*StatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*StatsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Defrag0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DefragRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceDefrag)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Defrag(ctx, req.(*DefragRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DefragResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_RewriteAOF0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RewriteAOFRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceRewriteAOF)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RewriteAOF(ctx, req.(*RewriteAOFRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RewriteAOFResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_FlushAll0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FlushAllRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceFlushAll)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FlushAll(ctx, req.(*FlushAllRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FlushAllResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_FlushByPrefix0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FlushByPrefixRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceFlushByPrefix)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FlushByPrefix(ctx, req.(*FlushByPrefixRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FlushByPrefixResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Save0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SaveRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceSave)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Save(ctx, req.(*SaveRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SaveResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_TopKeys0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TopKeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceTopKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TopKeys(ctx, req.(*TopKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TopKeysResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _CacheService_Capabilities0_HTTP_Handler(srv CacheServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CapabilitiesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationCacheServiceCapabilities)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Capabilities(ctx, req.(*CapabilitiesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CapabilitiesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type CacheServiceHTTPClient interface {
+	Append(ctx context.Context, req *AppendRequest, opts ...http.CallOption) (rsp *AppendResponse, err error)
+	Capabilities(ctx context.Context, req *CapabilitiesRequest, opts ...http.CallOption) (rsp *CapabilitiesResponse, err error)
+	CompareAndDelete(ctx context.Context, req *CompareAndDeleteRequest, opts ...http.CallOption) (rsp *CompareAndDeleteResponse, err error)
+	CompareAndSwap(ctx context.Context, req *CompareAndSwapRequest, opts ...http.CallOption) (rsp *CompareAndSwapResponse, err error)
+	DBSize(ctx context.Context, req *DBSizeRequest, opts ...http.CallOption) (rsp *DBSizeResponse, err error)
+	DecrBy(ctx context.Context, req *DecrByRequest, opts ...http.CallOption) (rsp *DecrByResponse, err error)
+	Defrag(ctx context.Context, req *DefragRequest, opts ...http.CallOption) (rsp *DefragResponse, err error)
+	DelString(ctx context.Context, req *DelStringRequest, opts ...http.CallOption) (rsp *DelStringResponse, err error)
+	Exec(ctx context.Context, req *ExecRequest, opts ...http.CallOption) (rsp *ExecResponse, err error)
+	Exists(ctx context.Context, req *ExistsRequest, opts ...http.CallOption) (rsp *ExistsResponse, err error)
+	Expire(ctx context.Context, req *ExpireRequest, opts ...http.CallOption) (rsp *ExpireResponse, err error)
+	FlushAll(ctx context.Context, req *FlushAllRequest, opts ...http.CallOption) (rsp *FlushAllResponse, err error)
+	FlushByPrefix(ctx context.Context, req *FlushByPrefixRequest, opts ...http.CallOption) (rsp *FlushByPrefixResponse, err error)
+	GetBytes(ctx context.Context, req *GetBytesRequest, opts ...http.CallOption) (rsp *GetBytesResponse, err error)
+	GetDel(ctx context.Context, req *GetDelRequest, opts ...http.CallOption) (rsp *GetDelResponse, err error)
+	GetEx(ctx context.Context, req *GetExRequest, opts ...http.CallOption) (rsp *GetExResponse, err error)
+	GetSet(ctx context.Context, req *GetSetRequest, opts ...http.CallOption) (rsp *GetSetResponse, err error)
+	GetString(ctx context.Context, req *GetStringRequest, opts ...http.CallOption) (rsp *GetStringResponse, err error)
+	GetStringWithVersion(ctx context.Context, req *GetStringWithVersionRequest, opts ...http.CallOption) (rsp *GetStringWithVersionResponse, err error)
+	GetTTL(ctx context.Context, req *GetTTLRequest, opts ...http.CallOption) (rsp *GetTTLResponse, err error)
+	HDel(ctx context.Context, req *HDelRequest, opts ...http.CallOption) (rsp *HDelResponse, err error)
+	HGet(ctx context.Context, req *HGetRequest, opts ...http.CallOption) (rsp *HGetResponse, err error)
+	HGetAll(ctx context.Context, req *HGetAllRequest, opts ...http.CallOption) (rsp *HGetAllResponse, err error)
+	HLen(ctx context.Context, req *HLenRequest, opts ...http.CallOption) (rsp *HLenResponse, err error)
+	HSet(ctx context.Context, req *HSetRequest, opts ...http.CallOption) (rsp *HSetResponse, err error)
+	IncrBy(ctx context.Context, req *IncrByRequest, opts ...http.CallOption) (rsp *IncrByResponse, err error)
+	Keys(ctx context.Context, req *KeysRequest, opts ...http.CallOption) (rsp *KeysResponse, err error)
+	LLen(ctx context.Context, req *LLenRequest, opts ...http.CallOption) (rsp *LLenResponse, err error)
+	LPop(ctx context.Context, req *LPopRequest, opts ...http.CallOption) (rsp *LPopResponse, err error)
+	LPush(ctx context.Context, req *LPushRequest, opts ...http.CallOption) (rsp *LPushResponse, err error)
+	LRange(ctx context.Context, req *LRangeRequest, opts ...http.CallOption) (rsp *LRangeResponse, err error)
+	ListPinned(ctx context.Context, req *ListPinnedRequest, opts ...http.CallOption) (rsp *ListPinnedResponse, err error)
+	MDel(ctx context.Context, req *MDelRequest, opts ...http.CallOption) (rsp *MDelResponse, err error)
+	MGet(ctx context.Context, req *MGetRequest, opts ...http.CallOption) (rsp *MGetResponse, err error)
+	MSet(ctx context.Context, req *MSetRequest, opts ...http.CallOption) (rsp *MSetResponse, err error)
+	MemoryUsage(ctx context.Context, req *MemoryUsageRequest, opts ...http.CallOption) (rsp *MemoryUsageResponse, err error)
+	PSetString(ctx context.Context, req *PSetStringRequest, opts ...http.CallOption) (rsp *PSetStringResponse, err error)
+	PTTL(ctx context.Context, req *PTTLRequest, opts ...http.CallOption) (rsp *PTTLResponse, err error)
+	Persist(ctx context.Context, req *PersistRequest, opts ...http.CallOption) (rsp *PersistResponse, err error)
+	Pin(ctx context.Context, req *PinRequest, opts ...http.CallOption) (rsp *PinResponse, err error)
+	RPop(ctx context.Context, req *RPopRequest, opts ...http.CallOption) (rsp *RPopResponse, err error)
+	RPush(ctx context.Context, req *RPushRequest, opts ...http.CallOption) (rsp *RPushResponse, err error)
+	RandomKey(ctx context.Context, req *RandomKeyRequest, opts ...http.CallOption) (rsp *RandomKeyResponse, err error)
+	Rename(ctx context.Context, req *RenameRequest, opts ...http.CallOption) (rsp *RenameResponse, err error)
+	RewriteAOF(ctx context.Context, req *RewriteAOFRequest, opts ...http.CallOption) (rsp *RewriteAOFResponse, err error)
+	Save(ctx context.Context, req *SaveRequest, opts ...http.CallOption) (rsp *SaveResponse, err error)
+	Scan(ctx context.Context, req *ScanRequest, opts ...http.CallOption) (rsp *ScanResponse, err error)
+	SetBytes(ctx context.Context, req *SetBytesRequest, opts ...http.CallOption) (rsp *SetBytesResponse, err error)
+	SetString(ctx context.Context, req *SetStringRequest, opts ...http.CallOption) (rsp *SetStringResponse, err error)
+	SetStringIfNewer(ctx context.Context, req *SetStringIfNewerRequest, opts ...http.CallOption) (rsp *SetStringIfNewerResponse, err error)
+	SetStringIfVersion(ctx context.Context, req *SetStringIfVersionRequest, opts ...http.CallOption) (rsp *SetStringIfVersionResponse, err error)
+	SetStringNX(ctx context.Context, req *SetStringNXRequest, opts ...http.CallOption) (rsp *SetStringNXResponse, err error)
+	Stats(ctx context.Context, req *StatsRequest, opts ...http.CallOption) (rsp *StatsResponse, err error)
+	Strlen(ctx context.Context, req *StrlenRequest, opts ...http.CallOption) (rsp *StrlenResponse, err error)
+	TopKeys(ctx context.Context, req *TopKeysRequest, opts ...http.CallOption) (rsp *TopKeysResponse, err error)
+	Touch(ctx context.Context, req *TouchRequest, opts ...http.CallOption) (rsp *TouchResponse, err error)
+	TouchKeys(ctx context.Context, req *TouchKeysRequest, opts ...http.CallOption) (rsp *TouchKeysResponse, err error)
+	Type(ctx context.Context, req *TypeRequest, opts ...http.CallOption) (rsp *TypeResponse, err error)
+	Unpin(ctx context.Context, req *UnpinRequest, opts ...http.CallOption) (rsp *UnpinResponse, err error)
+}
+
+type CacheServiceHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewCacheServiceHTTPClient(client *http.Client) CacheServiceHTTPClient {
+	return &CacheServiceHTTPClientImpl{client}
+}
+
+func (c *CacheServiceHTTPClientImpl) Append(ctx context.Context, in *AppendRequest, opts ...http.CallOption) (*AppendResponse, error) {
+	var out AppendResponse
+	pattern := "/v1/cache/string/{key}/append"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceAppend))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Capabilities(ctx context.Context, in *CapabilitiesRequest, opts ...http.CallOption) (*CapabilitiesResponse, error) {
+	var out CapabilitiesResponse
+	pattern := "/v1/cache/capabilities"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceCapabilities))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) CompareAndDelete(ctx context.Context, in *CompareAndDeleteRequest, opts ...http.CallOption) (*CompareAndDeleteResponse, error) {
+	var out CompareAndDeleteResponse
+	pattern := "/v1/cache/string/{key}/cad"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceCompareAndDelete))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) CompareAndSwap(ctx context.Context, in *CompareAndSwapRequest, opts ...http.CallOption) (*CompareAndSwapResponse, error) {
+	var out CompareAndSwapResponse
+	pattern := "/v1/cache/string/{key}/cas"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceCompareAndSwap))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) DBSize(ctx context.Context, in *DBSizeRequest, opts ...http.CallOption) (*DBSizeResponse, error) {
+	var out DBSizeResponse
+	pattern := "/v1/cache/dbsize"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceDBSize))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) DecrBy(ctx context.Context, in *DecrByRequest, opts ...http.CallOption) (*DecrByResponse, error) {
+	var out DecrByResponse
+	pattern := "/v1/cache/decr/{key}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceDecrBy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Defrag(ctx context.Context, in *DefragRequest, opts ...http.CallOption) (*DefragResponse, error) {
+	var out DefragResponse
+	pattern := "/v1/cache/admin/defrag"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceDefrag))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) DelString(ctx context.Context, in *DelStringRequest, opts ...http.CallOption) (*DelStringResponse, error) {
+	var out DelStringResponse
+	pattern := "/v1/cache/string/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceDelString))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Exec(ctx context.Context, in *ExecRequest, opts ...http.CallOption) (*ExecResponse, error) {
+	var out ExecResponse
+	pattern := "/v1/cache/exec"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceExec))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Exists(ctx context.Context, in *ExistsRequest, opts ...http.CallOption) (*ExistsResponse, error) {
+	var out ExistsResponse
+	pattern := "/v1/cache/exists"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceExists))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Expire(ctx context.Context, in *ExpireRequest, opts ...http.CallOption) (*ExpireResponse, error) {
+	var out ExpireResponse
+	pattern := "/v1/cache/expire/{key}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceExpire))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) FlushAll(ctx context.Context, in *FlushAllRequest, opts ...http.CallOption) (*FlushAllResponse, error) {
+	var out FlushAllResponse
+	pattern := "/v1/cache/admin/flushall"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceFlushAll))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) FlushByPrefix(ctx context.Context, in *FlushByPrefixRequest, opts ...http.CallOption) (*FlushByPrefixResponse, error) {
+	var out FlushByPrefixResponse
+	pattern := "/v1/cache/admin/flush-prefix"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceFlushByPrefix))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) GetBytes(ctx context.Context, in *GetBytesRequest, opts ...http.CallOption) (*GetBytesResponse, error) {
+	var out GetBytesResponse
+	pattern := "/v1/cache/bytes/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceGetBytes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) GetDel(ctx context.Context, in *GetDelRequest, opts ...http.CallOption) (*GetDelResponse, error) {
+	var out GetDelResponse
+	pattern := "/v1/cache/string/{key}/getdel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceGetDel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) GetEx(ctx context.Context, in *GetExRequest, opts ...http.CallOption) (*GetExResponse, error) {
+	var out GetExResponse
+	pattern := "/v1/cache/string/{key}/getex"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceGetEx))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) GetSet(ctx context.Context, in *GetSetRequest, opts ...http.CallOption) (*GetSetResponse, error) {
+	var out GetSetResponse
+	pattern := "/v1/cache/string/{key}/getset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceGetSet))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) GetString(ctx context.Context, in *GetStringRequest, opts ...http.CallOption) (*GetStringResponse, error) {
+	var out GetStringResponse
+	pattern := "/v1/cache/string/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceGetString))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) GetStringWithVersion(ctx context.Context, in *GetStringWithVersionRequest, opts ...http.CallOption) (*GetStringWithVersionResponse, error) {
+	var out GetStringWithVersionResponse
+	pattern := "/v1/cache/string/{key}/version"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceGetStringWithVersion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) GetTTL(ctx context.Context, in *GetTTLRequest, opts ...http.CallOption) (*GetTTLResponse, error) {
+	var out GetTTLResponse
+	pattern := "/v1/cache/ttl/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceGetTTL))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) HDel(ctx context.Context, in *HDelRequest, opts ...http.CallOption) (*HDelResponse, error) {
+	var out HDelResponse
+	pattern := "/v1/cache/hash/{key}/{field}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceHDel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) HGet(ctx context.Context, in *HGetRequest, opts ...http.CallOption) (*HGetResponse, error) {
+	var out HGetResponse
+	pattern := "/v1/cache/hash/{key}/{field}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceHGet))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) HGetAll(ctx context.Context, in *HGetAllRequest, opts ...http.CallOption) (*HGetAllResponse, error) {
+	var out HGetAllResponse
+	pattern := "/v1/cache/hash/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceHGetAll))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) HLen(ctx context.Context, in *HLenRequest, opts ...http.CallOption) (*HLenResponse, error) {
+	var out HLenResponse
+	pattern := "/v1/cache/hlen/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceHLen))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) HSet(ctx context.Context, in *HSetRequest, opts ...http.CallOption) (*HSetResponse, error) {
+	var out HSetResponse
+	pattern := "/v1/cache/hash/{key}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceHSet))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) IncrBy(ctx context.Context, in *IncrByRequest, opts ...http.CallOption) (*IncrByResponse, error) {
+	var out IncrByResponse
+	pattern := "/v1/cache/incr/{key}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceIncrBy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Keys(ctx context.Context, in *KeysRequest, opts ...http.CallOption) (*KeysResponse, error) {
+	var out KeysResponse
+	pattern := "/v1/cache/keys"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) LLen(ctx context.Context, in *LLenRequest, opts ...http.CallOption) (*LLenResponse, error) {
+	var out LLenResponse
+	pattern := "/v1/cache/llen/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceLLen))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) LPop(ctx context.Context, in *LPopRequest, opts ...http.CallOption) (*LPopResponse, error) {
+	var out LPopResponse
+	pattern := "/v1/cache/list/{key}/lpop"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceLPop))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) LPush(ctx context.Context, in *LPushRequest, opts ...http.CallOption) (*LPushResponse, error) {
+	var out LPushResponse
+	pattern := "/v1/cache/list/{key}/lpush"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceLPush))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) LRange(ctx context.Context, in *LRangeRequest, opts ...http.CallOption) (*LRangeResponse, error) {
+	var out LRangeResponse
+	pattern := "/v1/cache/list/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceLRange))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) ListPinned(ctx context.Context, in *ListPinnedRequest, opts ...http.CallOption) (*ListPinnedResponse, error) {
+	var out ListPinnedResponse
+	pattern := "/v1/cache/pinned"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceListPinned))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) MDel(ctx context.Context, in *MDelRequest, opts ...http.CallOption) (*MDelResponse, error) {
+	var out MDelResponse
+	pattern := "/v1/cache/mdel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceMDel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) MGet(ctx context.Context, in *MGetRequest, opts ...http.CallOption) (*MGetResponse, error) {
+	var out MGetResponse
+	pattern := "/v1/cache/mget"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceMGet))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) MSet(ctx context.Context, in *MSetRequest, opts ...http.CallOption) (*MSetResponse, error) {
+	var out MSetResponse
+	pattern := "/v1/cache/mset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceMSet))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) MemoryUsage(ctx context.Context, in *MemoryUsageRequest, opts ...http.CallOption) (*MemoryUsageResponse, error) {
+	var out MemoryUsageResponse
+	pattern := "/v1/cache/memory/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceMemoryUsage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) PSetString(ctx context.Context, in *PSetStringRequest, opts ...http.CallOption) (*PSetStringResponse, error) {
+	var out PSetStringResponse
+	pattern := "/v1/cache/string/{key}/px"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServicePSetString))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) PTTL(ctx context.Context, in *PTTLRequest, opts ...http.CallOption) (*PTTLResponse, error) {
+	var out PTTLResponse
+	pattern := "/v1/cache/pttl/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServicePTTL))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Persist(ctx context.Context, in *PersistRequest, opts ...http.CallOption) (*PersistResponse, error) {
+	var out PersistResponse
+	pattern := "/v1/cache/persist/{key}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServicePersist))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Pin(ctx context.Context, in *PinRequest, opts ...http.CallOption) (*PinResponse, error) {
+	var out PinResponse
+	pattern := "/v1/cache/pin/{key}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServicePin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) RPop(ctx context.Context, in *RPopRequest, opts ...http.CallOption) (*RPopResponse, error) {
+	var out RPopResponse
+	pattern := "/v1/cache/list/{key}/rpop"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceRPop))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) RPush(ctx context.Context, in *RPushRequest, opts ...http.CallOption) (*RPushResponse, error) {
+	var out RPushResponse
+	pattern := "/v1/cache/list/{key}/rpush"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceRPush))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) RandomKey(ctx context.Context, in *RandomKeyRequest, opts ...http.CallOption) (*RandomKeyResponse, error) {
+	var out RandomKeyResponse
+	pattern := "/v1/cache/randomkey"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceRandomKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Rename(ctx context.Context, in *RenameRequest, opts ...http.CallOption) (*RenameResponse, error) {
+	var out RenameResponse
+	pattern := "/v1/cache/rename/{key}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceRename))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) RewriteAOF(ctx context.Context, in *RewriteAOFRequest, opts ...http.CallOption) (*RewriteAOFResponse, error) {
+	var out RewriteAOFResponse
+	pattern := "/v1/cache/admin/rewrite-aof"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceRewriteAOF))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Save(ctx context.Context, in *SaveRequest, opts ...http.CallOption) (*SaveResponse, error) {
+	var out SaveResponse
+	pattern := "/v1/cache/admin/save"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceSave))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Scan(ctx context.Context, in *ScanRequest, opts ...http.CallOption) (*ScanResponse, error) {
+	var out ScanResponse
+	pattern := "/v1/cache/scan"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceScan))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) SetBytes(ctx context.Context, in *SetBytesRequest, opts ...http.CallOption) (*SetBytesResponse, error) {
+	var out SetBytesResponse
+	pattern := "/v1/cache/bytes/{key}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceSetBytes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) SetString(ctx context.Context, in *SetStringRequest, opts ...http.CallOption) (*SetStringResponse, error) {
+	var out SetStringResponse
+	pattern := "/v1/cache/string/{key}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceSetString))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) SetStringIfNewer(ctx context.Context, in *SetStringIfNewerRequest, opts ...http.CallOption) (*SetStringIfNewerResponse, error) {
+	var out SetStringIfNewerResponse
+	pattern := "/v1/cache/string/{key}/if-newer"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceSetStringIfNewer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) SetStringIfVersion(ctx context.Context, in *SetStringIfVersionRequest, opts ...http.CallOption) (*SetStringIfVersionResponse, error) {
+	var out SetStringIfVersionResponse
+	pattern := "/v1/cache/string/{key}/if-version"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceSetStringIfVersion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) SetStringNX(ctx context.Context, in *SetStringNXRequest, opts ...http.CallOption) (*SetStringNXResponse, error) {
+	var out SetStringNXResponse
+	pattern := "/v1/cache/string/{key}/nx"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceSetStringNX))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Stats(ctx context.Context, in *StatsRequest, opts ...http.CallOption) (*StatsResponse, error) {
+	var out StatsResponse
+	pattern := "/v1/cache/stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Strlen(ctx context.Context, in *StrlenRequest, opts ...http.CallOption) (*StrlenResponse, error) {
+	var out StrlenResponse
+	pattern := "/v1/cache/strlen/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceStrlen))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) TopKeys(ctx context.Context, in *TopKeysRequest, opts ...http.CallOption) (*TopKeysResponse, error) {
+	var out TopKeysResponse
+	pattern := "/v1/cache/hotkeys"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceTopKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Touch(ctx context.Context, in *TouchRequest, opts ...http.CallOption) (*TouchResponse, error) {
+	var out TouchResponse
+	pattern := "/v1/cache/touch/{key}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceTouch))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) TouchKeys(ctx context.Context, in *TouchKeysRequest, opts ...http.CallOption) (*TouchKeysResponse, error) {
+	var out TouchKeysResponse
+	pattern := "/v1/cache/touch"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationCacheServiceTouchKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Type(ctx context.Context, in *TypeRequest, opts ...http.CallOption) (*TypeResponse, error) {
+	var out TypeResponse
+	pattern := "/v1/cache/type/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceType))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *CacheServiceHTTPClientImpl) Unpin(ctx context.Context, in *UnpinRequest, opts ...http.CallOption) (*UnpinResponse, error) {
+	var out UnpinResponse
+	pattern := "/v1/cache/pin/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationCacheServiceUnpin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v4.22.0
+// source: cache/v1/error_reason.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ErrorReason int32
+
+const (
+	ErrorReason_CACHE_UNSPECIFIED ErrorReason = 0
+	ErrorReason_KEY_NOT_FOUND     ErrorReason = 1
+	ErrorReason_NOT_AN_INTEGER    ErrorReason = 2
+	ErrorReason_KEY_PINNED        ErrorReason = 3
+	ErrorReason_INVALID_PATTERN   ErrorReason = 4
+	ErrorReason_CACHE_FULL        ErrorReason = 5
+	ErrorReason_AOF_UNAVAILABLE   ErrorReason = 6
+	ErrorReason_NOT_UTF8          ErrorReason = 7
+	ErrorReason_WRONG_TYPE        ErrorReason = 8
+	ErrorReason_FLUSH_DISABLED    ErrorReason = 9
+	ErrorReason_UNKNOWN_COMMAND   ErrorReason = 10
+	ErrorReason_READ_ONLY_REPLICA ErrorReason = 11
+	ErrorReason_VALUE_TOO_LARGE   ErrorReason = 12
+	ErrorReason_INVALID_TTL       ErrorReason = 13
+	ErrorReason_HOT_KEYS_DISABLED ErrorReason = 14
+	ErrorReason_EXPIRES_AT_PASSED ErrorReason = 15
+	ErrorReason_DISK_FULL         ErrorReason = 16
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "CACHE_UNSPECIFIED",
+		1:  "KEY_NOT_FOUND",
+		2:  "NOT_AN_INTEGER",
+		3:  "KEY_PINNED",
+		4:  "INVALID_PATTERN",
+		5:  "CACHE_FULL",
+		6:  "AOF_UNAVAILABLE",
+		7:  "NOT_UTF8",
+		8:  "WRONG_TYPE",
+		9:  "FLUSH_DISABLED",
+		10: "UNKNOWN_COMMAND",
+		11: "READ_ONLY_REPLICA",
+		12: "VALUE_TOO_LARGE",
+		13: "INVALID_TTL",
+		14: "HOT_KEYS_DISABLED",
+		15: "EXPIRES_AT_PASSED",
+		16: "DISK_FULL",
+	}
+	ErrorReason_value = map[string]int32{
+		"CACHE_UNSPECIFIED": 0,
+		"KEY_NOT_FOUND":     1,
+		"NOT_AN_INTEGER":    2,
+		"KEY_PINNED":        3,
+		"INVALID_PATTERN":   4,
+		"CACHE_FULL":        5,
+		"AOF_UNAVAILABLE":   6,
+		"NOT_UTF8":          7,
+		"WRONG_TYPE":        8,
+		"FLUSH_DISABLED":    9,
+		"UNKNOWN_COMMAND":   10,
+		"READ_ONLY_REPLICA": 11,
+		"VALUE_TOO_LARGE":   12,
+		"INVALID_TTL":       13,
+		"HOT_KEYS_DISABLED": 14,
+		"EXPIRES_AT_PASSED": 15,
+		"DISK_FULL":         16,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_cache_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_cache_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_cache_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_cache_v1_error_reason_proto protoreflect.FileDescriptor
+
+const file_cache_v1_error_reason_proto_rawDesc = "" +
+	"\n" +
+	"\x1bcache/v1/error_reason.proto\x12\bcache.v1*\xd6\x02\n" +
+	"\vErrorReason\x12\x15\n" +
+	"\x11CACHE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rKEY_NOT_FOUND\x10\x01\x12\x12\n" +
+	"\x0eNOT_AN_INTEGER\x10\x02\x12\x0e\n" +
+	"\n" +
+	"KEY_PINNED\x10\x03\x12\x13\n" +
+	"\x0fINVALID_PATTERN\x10\x04\x12\x0e\n" +
+	"\n" +
+	"CACHE_FULL\x10\x05\x12\x13\n" +
+	"\x0fAOF_UNAVAILABLE\x10\x06\x12\f\n" +
+	"\bNOT_UTF8\x10\a\x12\x0e\n" +
+	"\n" +
+	"WRONG_TYPE\x10\b\x12\x12\n" +
+	"\x0eFLUSH_DISABLED\x10\t\x12\x13\n" +
+	"\x0fUNKNOWN_COMMAND\x10\n" +
+	"\x12\x15\n" +
+	"\x11READ_ONLY_REPLICA\x10\v\x12\x13\n" +
+	"\x0fVALUE_TOO_LARGE\x10\f\x12\x0f\n" +
+	"\vINVALID_TTL\x10\r\x12\x15\n" +
+	"\x11HOT_KEYS_DISABLED\x10\x0e\x12\x15\n" +
+	"\x11EXPIRES_AT_PASSED\x10\x0f\x12\r\n" +
+	"\tDISK_FULL\x10\x10B!Z\x1fgocache-service/api/cache/v1;v1b\x06proto3"
+
+var (
+	file_cache_v1_error_reason_proto_rawDescOnce sync.Once
+	file_cache_v1_error_reason_proto_rawDescData []byte
+)
+
+func file_cache_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_cache_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_cache_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cache_v1_error_reason_proto_rawDesc), len(file_cache_v1_error_reason_proto_rawDesc)))
+	})
+	return file_cache_v1_error_reason_proto_rawDescData
+}
+
+var file_cache_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cache_v1_error_reason_proto_goTypes = []any{
+	(ErrorReason)(0), // 0: cache.v1.ErrorReason
+}
+var file_cache_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_cache_v1_error_reason_proto_init() }
+func file_cache_v1_error_reason_proto_init() {
+	if File_cache_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cache_v1_error_reason_proto_rawDesc), len(file_cache_v1_error_reason_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cache_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_cache_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_cache_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_cache_v1_error_reason_proto = out.File
+	file_cache_v1_error_reason_proto_goTypes = nil
+	file_cache_v1_error_reason_proto_depIdxs = nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"gocache-service/pkg/conformance"
+
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+var (
+	// flagaddr is the gRPC endpoint under test.
+	flagaddr string
+	// flagdir is the directory holding the scenario files.
+	flagdir string
+)
+
+func init() {
+	flag.StringVar(&flagaddr, "addr", "127.0.0.1:9000", "gRPC endpoint, eg: -addr 127.0.0.1:9000")
+	flag.StringVar(&flagdir, "dir", "test/conformance", "scenario directory, eg: -dir test/conformance")
+}
+
+// main runs the scenarios against a live server. Its clock cannot be moved,
+// so advance steps sleep for the same time, and scenarios that restart the
+// server are skipped.
+func main() {
+	flag.Parse()
+	ctx := context.Background()
+	conn, err := grpc.DialInsecure(ctx, grpc.WithEndpoint(flagaddr))
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+
+	files, err := conformance.Files(flagdir)
+	if err != nil {
+		panic(err)
+	}
+	runner := &conformance.Runner{Conn: conn}
+	failed := 0
+	for _, file := range files {
+		err := runner.RunFile(ctx, file)
+		if errors.Is(err, conformance.ErrSkipped) {
+			fmt.Printf("skip %s\n", file)
+			continue
+		}
+		if err != nil {
+			failed++
+			fmt.Printf("FAIL %s\n", err)
+			continue
+		}
+		fmt.Printf("ok   %s\n", file)
+	}
+	if failed > 0 {
+		fmt.Printf("%d of %d scenarios failed\n", failed, len(files))
+		os.Exit(1)
+	}
+}
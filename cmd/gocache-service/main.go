@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 
 	"gocache-service/internal/conf"
+	"gocache-service/internal/server"
+	"gocache-service/internal/service"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -33,7 +36,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, rs *server.RESPServer, cache *service.CacheService) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,7 +46,14 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			rs,
 		),
+		// gRPC GracefulStop waits for streaming calls, so end the expiry watch and
+		// replication streams first
+		kratos.BeforeStop(func(context.Context) error {
+			cache.StopStreams()
+			return nil
+		}),
 	)
 }
 
@@ -31,13 +31,31 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	greeterRepo := data.NewGreeterRepo(dataData, logger)
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
-	cacheRepo := data.NewCacheRepo(dataData, logger)
-	goCacheUsecase := biz.NewGoCacheUsecase(cacheRepo, logger)
+	prometheusMetrics := server.NewPrometheusMetrics()
+	cacheRepo, err := data.NewCacheRepo(confData, dataData, prometheusMetrics, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	leaderClient, cleanup2, err := data.NewLeaderClient(confData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	goCacheUsecase, cleanup3, err := biz.NewGoCacheUsecase(confData, cacheRepo, prometheusMetrics, leaderClient, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	cacheService := service.NewCacheService(goCacheUsecase)
 	grpcServer := server.NewGRPCServer(confServer, greeterService, cacheService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, cacheService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	httpServer := server.NewHTTPServer(confServer, greeterService, cacheService, prometheusMetrics, logger)
+	respServer := server.NewRESPServer(confServer, goCacheUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, respServer, cacheService)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -0,0 +1,382 @@
+package biz
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// AOFOp AOF 与复制流中命令的操作码。数值会写入文件，新的操作码只能追加在末尾，已有的值不能修改
+type AOFOp uint8
+
+const (
+	AOFSet AOFOp = iota + 1
+	AOFSetZ
+	AOFDel
+	AOFMSet
+	AOFMDel
+	AOFIncr
+	AOFExpire
+	AOFAppend
+	AOFHSet
+	AOFHDel
+	AOFHMSet
+	AOFLPush
+	AOFRPush
+	AOFLPop
+	AOFRPop
+	AOFLRestore
+	AOFPin
+	AOFUnpin
+	AOFLoad
+	AOFEpoch
+	AOFMulti
+	// AOFFlushAll 只出现在复制流中，AOF 通过 Truncate 清空
+	AOFFlushAll
+)
+
+var aofOpNames = [...]string{
+	AOFSet:      "SET",
+	AOFSetZ:     "SETZ",
+	AOFDel:      "DEL",
+	AOFMSet:     "MSET",
+	AOFMDel:     "MDEL",
+	AOFIncr:     "INCR",
+	AOFExpire:   "EXPIRE",
+	AOFAppend:   "APPEND",
+	AOFHSet:     "HSET",
+	AOFHDel:     "HDEL",
+	AOFHMSet:    "HMSET",
+	AOFLPush:    "LPUSH",
+	AOFRPush:    "RPUSH",
+	AOFLPop:     "LPOP",
+	AOFRPop:     "RPOP",
+	AOFLRestore: "LRESTORE",
+	AOFPin:      "PIN",
+	AOFUnpin:    "UNPIN",
+	AOFLoad:     "LOAD",
+	AOFEpoch:    "EPOCH",
+	AOFMulti:    "MULTI",
+	AOFFlushAll: "FLUSHALL",
+}
+
+func (op AOFOp) String() string {
+	if int(op) < len(aofOpNames) && aofOpNames[op] != "" {
+		return aofOpNames[op]
+	}
+	return fmt.Sprintf("AOFOp(%d)", uint8(op))
+}
+
+// ParseAOFOp 按命令名查找操作码，供转换旧格式的 AOF 使用
+func ParseAOFOp(name string) (AOFOp, bool) {
+	for op, n := range aofOpNames {
+		if n != "" && n == name {
+			return AOFOp(op), true
+		}
+	}
+	return 0, false
+}
+
+// AOFCommand AOF 与复制流中的一条命令，各操作码使用的字段：
+//
+//	SET/SETZ  Key Value ExpiresAt EventTime，SETZ 的 Value 已经压缩
+//	DEL       Key
+//	MSET      ExpiresAt EventTime，Args 为键和值交替
+//	MDEL      Args 为键
+//	INCR      Key Delta
+//	EXPIRE    Key ExpiresAt
+//	APPEND    Key Value(追加的内容) ExpiresAt EventTime，ExpiresAt 是追加后的过期时间
+//	HSET      Key Args[0](字段) Value
+//	HDEL      Key Args[0](字段)
+//	HMSET     Key ExpiresAt EventTime，Args 为整个哈希的字段和值交替
+//	LPUSH/RPUSH Key Args(按调用顺序插入的元素)
+//	LPOP/RPOP Key
+//	LRESTORE  Key ExpiresAt EventTime，Args 为整个列表
+//	PIN       Key TTLOverride
+//	UNPIN     Key
+//	LOAD      Items(快照中的一个分片或全量同步的一块)
+//	EPOCH     Value(快照纪元)
+//	MULTI     Commands(整体重放的一批命令，不能嵌套)
+//
+// 修改单个键的命令(SET/SETZ、INCR、APPEND、HSET、HDEL、HMSET、LPUSH/RPUSH、LPOP/RPOP、LRESTORE)
+// 在 Version 中记录修改后的版本号，为 0 时(旧记录)重放按当前条目递增
+type AOFCommand struct {
+	Op  AOFOp
+	Key string
+	// Value 与 CacheItem.Value 一样可以是任意字节序列
+	Value     string
+	ExpiresAt int64
+	EventTime int64
+	Delta     int64
+	Version   uint64
+	// TTLOverride PIN 时是否忽略 TTL
+	TTLOverride bool
+	Args        []string
+	Items       map[string]CacheItem
+	Commands    []AOFCommand
+}
+
+// aofCommandVersion EncodeCommand 输出的第一个字节，编码方式变化时递增。
+// 版本 2 在命令和条目中加入了版本号，DecodeCommand 仍能读取版本 1
+const aofCommandVersion = 2
+
+// 条目标志位，见 appendItem
+const (
+	itemCompressed = 1 << iota
+	itemPinned
+	itemPinTTLOverride
+	itemHash
+	itemList
+)
+
+// errMalformedCommand 编码的命令不完整、版本无法识别或包含多余的字节
+var errMalformedCommand = errors.New("cache: malformed aof command")
+
+// EncodeCommand 把命令编码为版本字节加紧凑的二进制格式，AOF 记录与复制流使用相同的编码。
+// 整数为 varint，字符串和列表带长度前缀，所有字段总是写出，零值只占一个字节
+func EncodeCommand(cmd AOFCommand) []byte {
+	buf := make([]byte, 0, 32+len(cmd.Key)+len(cmd.Value))
+	buf = append(buf, aofCommandVersion)
+	return appendCommand(buf, cmd)
+}
+
+func appendCommand(buf []byte, cmd AOFCommand) []byte {
+	buf = append(buf, byte(cmd.Op))
+	buf = appendString(buf, cmd.Key)
+	buf = appendString(buf, cmd.Value)
+	buf = binary.AppendVarint(buf, cmd.ExpiresAt)
+	buf = binary.AppendVarint(buf, cmd.EventTime)
+	buf = binary.AppendVarint(buf, cmd.Delta)
+	buf = binary.AppendUvarint(buf, cmd.Version)
+	if cmd.TTLOverride {
+		buf = append(buf, 1)
+	} else {
+		buf = append(buf, 0)
+	}
+	buf = appendStrings(buf, cmd.Args)
+	buf = binary.AppendUvarint(buf, uint64(len(cmd.Items)))
+	for key, item := range cmd.Items {
+		buf = appendString(buf, key)
+		buf = appendItem(buf, item)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(cmd.Commands)))
+	for _, sub := range cmd.Commands {
+		buf = appendCommand(buf, sub)
+	}
+	return buf
+}
+
+// appendItem 编码 LOAD 中的一个条目，标志位区分 nil 与空的 Hash、List
+func appendItem(buf []byte, item CacheItem) []byte {
+	var flags byte
+	if item.Compressed {
+		flags |= itemCompressed
+	}
+	if item.Pinned {
+		flags |= itemPinned
+	}
+	if item.PinTTLOverride {
+		flags |= itemPinTTLOverride
+	}
+	if item.Hash != nil {
+		flags |= itemHash
+	}
+	if item.List != nil {
+		flags |= itemList
+	}
+	buf = append(buf, flags)
+	buf = appendString(buf, item.Value)
+	buf = binary.AppendVarint(buf, item.ExpiresAt)
+	buf = binary.AppendVarint(buf, item.EventTime)
+	buf = binary.AppendUvarint(buf, item.Version)
+	if item.Hash != nil {
+		buf = binary.AppendUvarint(buf, uint64(len(item.Hash)))
+		for field, value := range item.Hash {
+			buf = appendString(buf, field)
+			buf = appendString(buf, value)
+		}
+	}
+	if item.List != nil {
+		buf = appendStrings(buf, item.List)
+	}
+	return buf
+}
+
+func appendString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+func appendStrings(buf []byte, values []string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(values)))
+	for _, s := range values {
+		buf = appendString(buf, s)
+	}
+	return buf
+}
+
+// DecodeCommand 解码 EncodeCommand 编码的命令。输入被截断、版本无法识别、MULTI 嵌套或末尾有多余字节时返回错误；
+// 各长度字段都不能超过剩余的字节数，损坏的输入不会导致过大的内存分配
+func DecodeCommand(data []byte) (AOFCommand, error) {
+	if len(data) == 0 {
+		return AOFCommand{}, fmt.Errorf("%w: empty", errMalformedCommand)
+	}
+	if data[0] == 0 || data[0] > aofCommandVersion {
+		return AOFCommand{}, fmt.Errorf("%w: unknown version %d", errMalformedCommand, data[0])
+	}
+	d := commandDecoder{data: data[1:], version: data[0]}
+	cmd := d.command(true)
+	if d.err == nil && len(d.data) > 0 {
+		d.fail("%d trailing bytes", len(d.data))
+	}
+	if d.err != nil {
+		return AOFCommand{}, d.err
+	}
+	return cmd, nil
+}
+
+// commandDecoder 顺序读取编码后的字段，出错后后续的读取都返回零值，由调用方最后检查 err
+type commandDecoder struct {
+	data []byte
+	err  error
+	// version 编码版本，版本 1 没有版本号字段
+	version byte
+}
+
+func (d *commandDecoder) fail(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = fmt.Errorf("%w: %s", errMalformedCommand, fmt.Sprintf(format, args...))
+	}
+	d.data = nil
+}
+
+func (d *commandDecoder) readByte() byte {
+	if len(d.data) == 0 {
+		d.fail("truncated")
+		return 0
+	}
+	b := d.data[0]
+	d.data = d.data[1:]
+	return b
+}
+
+func (d *commandDecoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.fail("bad uvarint")
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *commandDecoder) varint() int64 {
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.fail("bad varint")
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+// count 读取元素个数，每个元素至少占一个字节，超过剩余字节数说明长度字段已损坏
+func (d *commandDecoder) count() int {
+	n := d.uvarint()
+	if n > uint64(len(d.data)) {
+		d.fail("count %d exceeds remaining %d bytes", n, len(d.data))
+		return 0
+	}
+	return int(n)
+}
+
+func (d *commandDecoder) readString() string {
+	n := d.uvarint()
+	if n > uint64(len(d.data)) {
+		d.fail("string length %d exceeds remaining %d bytes", n, len(d.data))
+		return ""
+	}
+	s := string(d.data[:n])
+	d.data = d.data[n:]
+	return s
+}
+
+func (d *commandDecoder) readStrings() []string {
+	n := d.count()
+	if n == 0 {
+		return nil
+	}
+	values := make([]string, n)
+	for i := range values {
+		values[i] = d.readString()
+	}
+	return values
+}
+
+// command 解码一条命令，top 为 false 时是 MULTI 中的命令，不能再包含命令
+func (d *commandDecoder) command(top bool) AOFCommand {
+	cmd := AOFCommand{Op: AOFOp(d.readByte())}
+	cmd.Key = d.readString()
+	cmd.Value = d.readString()
+	cmd.ExpiresAt = d.varint()
+	cmd.EventTime = d.varint()
+	cmd.Delta = d.varint()
+	if d.version >= 2 {
+		cmd.Version = d.uvarint()
+	}
+	switch d.readByte() {
+	case 0:
+	case 1:
+		cmd.TTLOverride = true
+	default:
+		d.fail("bad bool")
+	}
+	cmd.Args = d.readStrings()
+	if n := d.count(); n > 0 {
+		cmd.Items = make(map[string]CacheItem, n)
+		for i := 0; i < n && d.err == nil; i++ {
+			key := d.readString()
+			cmd.Items[key] = d.item()
+		}
+	}
+	if n := d.count(); n > 0 {
+		if !top {
+			d.fail("nested commands")
+			return cmd
+		}
+		cmd.Commands = make([]AOFCommand, n)
+		for i := range cmd.Commands {
+			cmd.Commands[i] = d.command(false)
+		}
+	}
+	return cmd
+}
+
+func (d *commandDecoder) item() CacheItem {
+	flags := d.readByte()
+	item := CacheItem{
+		Compressed:     flags&itemCompressed != 0,
+		Pinned:         flags&itemPinned != 0,
+		PinTTLOverride: flags&itemPinTTLOverride != 0,
+	}
+	item.Value = d.readString()
+	item.ExpiresAt = d.varint()
+	item.EventTime = d.varint()
+	if d.version >= 2 {
+		item.Version = d.uvarint()
+	}
+	if flags&itemHash != 0 {
+		n := d.count()
+		item.Hash = make(map[string]string, n)
+		for i := 0; i < n && d.err == nil; i++ {
+			field := d.readString()
+			item.Hash[field] = d.readString()
+		}
+	}
+	if flags&itemList != 0 {
+		if item.List = d.readStrings(); item.List == nil {
+			item.List = []string{}
+		}
+	}
+	return item
+}
@@ -0,0 +1,42 @@
+package biz
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func FuzzDecodeCommand(f *testing.F) {
+	for _, command := range []AOFCommand{
+		{Op: AOFSet, Key: "k", Value: "v", ExpiresAt: 1700000000000, EventTime: 1},
+		{Op: AOFSetZ, Key: "k", Value: "\x00\xff", Version: 7},
+		{Op: AOFMSet, Args: []string{"a", "1", "b", "2"}},
+		{Op: AOFPin, Key: "k", TTLOverride: true},
+		{Op: AOFLoad, Items: map[string]CacheItem{"x": {Value: "y", ExpiresAt: 5, Pinned: true}}},
+		{Op: AOFMulti, Commands: []AOFCommand{{Op: AOFIncr, Key: "n", Delta: -3}, {Op: AOFDel, Key: "k"}}},
+	} {
+		encoded := EncodeCommand(command)
+		f.Add(encoded)
+		f.Add(encoded[:len(encoded)/2])
+	}
+	f.Add([]byte{})
+	f.Add([]byte{aofCommandVersion + 1})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		command, err := DecodeCommand(data)
+		if err != nil {
+			if !errors.Is(err, errMalformedCommand) {
+				t.Fatalf("DecodeCommand err = %v, want errMalformedCommand", err)
+			}
+			return
+		}
+		// 能够解码的输入重新编码后再解码，得到同样的命令。Items 按 map 的顺序编码，不能比较字节
+		again, err := DecodeCommand(EncodeCommand(command))
+		if err != nil {
+			t.Fatalf("decoding a re-encoded command: %v", err)
+		}
+		if !reflect.DeepEqual(again, command) {
+			t.Fatalf("round trip changed %+v into %+v", command, again)
+		}
+	})
+}
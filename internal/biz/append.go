@@ -0,0 +1,96 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// Append 把 suffix 追加到字符串键的末尾并返回追加后的长度，整个过程持有分片写锁。
+// 键不存在或已过期时以 suffix 创建，ttl > 0 时为新键设置过期时间；已存在的键保留原有的过期时间，
+// 忽略 ttl。追加后超过 max_value_size 时返回 ErrValueTooLarge，哈希和列表键返回 ErrWrongType。
+// AOF 中记录 APPEND 以及追加后的过期时间和事件时间，重放时同样追加，而不是写入整个值
+func (c *GoCacheUsecase) Append(ctx context.Context, key, suffix string, ttl time.Duration) (int, error) {
+	c.log.WithContext(ctx).Infof("append key:%s,len:%d,ttl:%v", key, len(suffix), ttl)
+	if err := c.writable(); err != nil {
+		return 0, err
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return 0, err
+	}
+	defer shard.mu.Unlock()
+	now := c.clock.Now().UnixMilli()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(now) {
+		entry = c.newCacheItem("", ttl, now)
+	} else if !entry.isString() {
+		return 0, ErrWrongType
+	}
+	value, err := entry.value()
+	if err != nil {
+		return 0, err
+	}
+	if c.maxValueSize > 0 && int64(len(value)+len(suffix)) > c.maxValueSize {
+		return 0, ErrValueTooLarge
+	}
+	entry.Value, entry.Compressed = value+suffix, false
+	entry.EventTime = now
+	entry, err = c.storeLocked(shard.active, key, entry, c.ttlOf(entry))
+	if err != nil {
+		return 0, err
+	}
+	c.counters.sets.Add(1)
+	return len(value) + len(suffix), c.repo.Write(ctx, AOFCommand{Op: AOFAppend, Key: key, Value: suffix, ExpiresAt: entry.ExpiresAt, EventTime: entry.EventTime, Version: entry.Version})
+}
+
+// Strlen 返回字符串键的值的长度，键不存在或已过期时返回 0，哈希和列表键返回 ErrWrongType
+func (c *GoCacheUsecase) Strlen(ctx context.Context, key string) (int, error) {
+	shard := c.getShard(key)
+	shard.mu.RLock()
+	entry, exists := shard.active.Data[key]
+	shard.mu.RUnlock()
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) {
+		return 0, nil
+	}
+	if !entry.isString() {
+		return 0, ErrWrongType
+	}
+	value, err := entry.value()
+	if err != nil {
+		return 0, err
+	}
+	return len(value), nil
+}
+
+// replayAppend 重放 APPEND 记录。expiresAt 是追加后的过期时间，重放时已过期说明追加之后键已经过期，
+// 与 SET 相同直接删除，不会以 suffix 创建一个没有过期时间的键。不是字符串键时跳过
+func (c *GoCacheUsecase) replayAppend(key, suffix string, expiresAt, eventTime int64, version uint64) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	now := c.clock.Now().UnixMilli()
+	if expiresAt > 0 && expiresAt <= now {
+		c.removeLocked(shard.active, key)
+		return
+	}
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(now) {
+		entry = CacheItem{}
+	} else if !entry.isString() {
+		return
+	}
+	value, err := entry.value()
+	if err != nil {
+		return
+	}
+	entry.Value, entry.Compressed = value+suffix, false
+	entry.ExpiresAt, entry.EventTime = expiresAt, eventTime
+	entry.Version = shard.active.replayedVersion(key, version)
+	shard.active.set(key, c.compress(entry))
+	if expiresAt > 0 {
+		c.timeWheel.Add(key, c.untilMilli(expiresAt))
+	}
+}
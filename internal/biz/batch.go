@@ -0,0 +1,255 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// groupByShard 按分片下标对键分组，保证每个分片只加一次锁
+func (c *GoCacheUsecase) groupByShard(keys []string) map[uint32][]string {
+	groups := make(map[uint32][]string)
+	for _, key := range keys {
+		index := c.shardIndex(key)
+		groups[index] = append(groups[index], key)
+	}
+	return groups
+}
+
+// lockKeys 按分片序号从小到大经 lockShard 锁住 keys 涉及的所有分片，每个分片只锁一次，返回按相反顺序解锁的函数。
+// 某个分片等锁失败时释放已经拿到的锁并返回错误
+func (c *GoCacheUsecase) lockKeys(ctx context.Context, keys []string) (func(), error) {
+	seen := make(map[uint32]bool)
+	indexes := make([]uint32, 0, len(keys))
+	for _, key := range keys {
+		if index := c.shardIndex(key); !seen[index] {
+			seen[index] = true
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	unlock := func(locked int) {
+		for i := locked - 1; i >= 0; i-- {
+			c.shards[indexes[i]].mu.Unlock()
+		}
+	}
+	for n, index := range indexes {
+		if err := c.lockShard(ctx, &c.shards[index]); err != nil {
+			unlock(n)
+			return nil, err
+		}
+	}
+	return func() { unlock(len(indexes)) }, nil
+}
+
+// MSet 批量写入，所有键使用相同的 ttl。eventTimes 给出部分键的事件时间(Unix 毫秒)，这些键与 SetIfNewer 相同，
+// 只在键不存在或已存储的事件时间早于它时写入，其余键以到达时间为事件时间并总是写入。返回因事件时间不够新
+// 而跳过的键及胜出的事件时间。没有 eventTimes 时整批只追加一条 MSET 记录，否则各键的事件时间不同，
+// 写入的键合并为一条 MULTI 记录。涉及的分片全部加锁直到记录写入，并发写入同一个键的记录不会排在这条记录之前。
+// 某个分片已满且无法淘汰时停止写入并返回 ErrCacheFull，已写入的键仍会记录到 AOF
+func (c *GoCacheUsecase) MSet(ctx context.Context, items map[string]string, ttl time.Duration, eventTimes map[string]int64) (map[string]int64, error) {
+	c.log.WithContext(ctx).Infof("mset keys:%d,ttl:%v,eventTimes:%d", len(items), ttl, len(eventTimes))
+	if err := c.writable(); err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	now := c.clock.Now().UnixMilli()
+	// 整批共用一条 MSET 记录和同一个过期时间，jitter 只在批与批之间错开
+	ttl = c.jitterTTL(ttl)
+	base := c.newCacheItem("", ttl, now)
+	command := AOFCommand{Op: AOFMSet, ExpiresAt: base.ExpiresAt, EventTime: now, Args: make([]string, 0, 2*len(items))}
+	multi := AOFCommand{Op: AOFMulti}
+	skipped := make(map[string]int64)
+	unlock, err := c.lockKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	for _, key := range keys {
+		buf := c.getShard(key).active
+		entry := base
+		entry.Value = items[key]
+		if eventTime, ok := eventTimes[key]; ok {
+			if old, exists := buf.Data[key]; exists && !old.expired(now) && old.EventTime >= eventTime {
+				skipped[key] = old.EventTime
+				continue
+			}
+			entry.EventTime = eventTime
+		}
+		if entry, err = c.storeLocked(buf, key, entry, ttl); err != nil {
+			break
+		}
+		command.Args = append(command.Args, key, entry.Value)
+		multi.Commands = append(multi.Commands, EntryRecord(key, entry))
+		c.counters.sets.Add(1)
+	}
+	if len(command.Args) > 0 {
+		if len(eventTimes) > 0 {
+			command = multi
+		}
+		if writeErr := c.repo.Write(ctx, command); err == nil {
+			err = writeErr
+		}
+	}
+	return skipped, err
+}
+
+// MGet 批量读取，缺失或已过期的键不会出现在结果中
+func (c *GoCacheUsecase) MGet(ctx context.Context, keys []string) (map[string]string, error) {
+	c.log.WithContext(ctx).Infof("mget keys:%d", len(keys))
+	result := make(map[string]string, len(keys))
+	now := c.clock.Now().UnixMilli()
+	var compressed []string
+	for index, group := range c.groupByShard(keys) {
+		shard := &c.shards[index]
+		if err := c.rlockShard(ctx, shard); err != nil {
+			return nil, err
+		}
+		for _, key := range group {
+			// 与 Redis 的 MGET 相同，哈希和列表键按不存在处理
+			if entry, exists := shard.active.Data[key]; exists && !entry.expired(now) && entry.isString() {
+				entry.touch(nowNano())
+				result[key] = entry.Value
+				if entry.Compressed {
+					compressed = append(compressed, key)
+				}
+				c.counters.hits.Add(1)
+			} else {
+				c.counters.misses.Add(1)
+			}
+		}
+		shard.mu.RUnlock()
+	}
+	// 压缩的值在释放分片锁之后解压
+	for _, key := range compressed {
+		value, err := CacheItem{Value: result[key], Compressed: true}.value()
+		if err != nil {
+			return nil, err
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+// Exists 返回 keys 中存在且未过期的键数，任意类型的键都计入，重复的键按出现次数计算。
+// 按分片分组，每个分片只加一次读锁；不更新访问时间，也不计入命中率。
+// 与 Get 一样，发现的已过期键在读锁释放后删除
+func (c *GoCacheUsecase) Exists(ctx context.Context, keys ...string) (int64, error) {
+	c.log.WithContext(ctx).Infof("exists keys:%d", len(keys))
+	return c.scanLive(ctx, keys, func(CacheItem, int64) {})
+}
+
+// TouchKeys 更新 keys 中存在且未过期的键的访问时间，返回这些键的数量，重复的键按出现次数计算。
+// 只影响淘汰顺序，不改变值、TTL 和版本号，也不追加 AOF 记录；未开启淘汰时只返回数量
+func (c *GoCacheUsecase) TouchKeys(ctx context.Context, keys ...string) (int64, error) {
+	c.log.WithContext(ctx).Infof("touch keys:%d", len(keys))
+	return c.scanLive(ctx, keys, func(entry CacheItem, now int64) {
+		entry.touch(now)
+	})
+}
+
+// scanLive 按分片分组，在读锁下对 keys 中存在且未过期的键调用 fn 并计数。
+// 访问时间是原子值，读锁下可以更新；已过期的键在读锁释放后批量删除
+func (c *GoCacheUsecase) scanLive(ctx context.Context, keys []string, fn func(entry CacheItem, now int64)) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	var count int64
+	var expired []string
+	now := c.clock.Now().UnixMilli()
+	accessed := nowNano()
+	for index, group := range c.groupByShard(keys) {
+		shard := &c.shards[index]
+		if err := c.rlockShard(ctx, shard); err != nil {
+			return 0, err
+		}
+		for _, key := range group {
+			entry, exists := shard.active.Data[key]
+			switch {
+			case !exists:
+			case entry.expired(now):
+				expired = append(expired, key)
+			default:
+				fn(entry, accessed)
+				count++
+			}
+		}
+		shard.mu.RUnlock()
+	}
+	if len(expired) > 0 {
+		c.deleteExpired(expired)
+	}
+	return count, nil
+}
+
+// MDel 批量删除，返回删除前仍然存活的键的数量，整批只追加一条 MDEL 记录，与 MSet 一样在持有全部相关分片锁时写入
+func (c *GoCacheUsecase) MDel(ctx context.Context, keys []string) (int, error) {
+	c.log.WithContext(ctx).Infof("mdel keys:%d", len(keys))
+	if err := c.writable(); err != nil {
+		return 0, err
+	}
+	deleted := 0
+	now := c.clock.Now().UnixMilli()
+	command := AOFCommand{Op: AOFMDel}
+	unlock, err := c.lockKeys(ctx, keys)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+	for _, key := range keys {
+		buf := c.getShard(key).active
+		entry, exists := buf.Data[key]
+		if !exists {
+			continue
+		}
+		if !entry.expired(now) {
+			deleted++
+			c.counters.deletes.Add(1)
+		}
+		c.removeLocked(buf, key)
+		command.Args = append(command.Args, key)
+	}
+	if len(command.Args) > 0 {
+		return deleted, c.repo.Write(ctx, command)
+	}
+	return deleted, nil
+}
+
+// replayMSet 重放 MSET 记录，pairs 为键和值交替
+func (c *GoCacheUsecase) replayMSet(expiresAt, eventTime int64, pairs []string) {
+	expired := expiresAt != 0 && c.clock.Now().UnixMilli() >= expiresAt
+	for i := 0; i+1 < len(pairs); i += 2 {
+		key := pairs[i]
+		if expired {
+			// 已过期的批量写入同样覆盖之前的值
+			shard := c.getShard(key)
+			shard.mu.Lock()
+			c.removeLocked(shard.active, key)
+			shard.mu.Unlock()
+			continue
+		}
+		entry := c.compress(CacheItem{
+			Value:     pairs[i+1],
+			ExpiresAt: expiresAt,
+			EventTime: eventTime,
+		})
+		shard := c.getShard(key)
+		shard.mu.Lock()
+		// MSET 记录不带版本号，各键的版本号在重放时逐个递增
+		entry.Version = shard.active.nextVersion(key)
+		if old, exists := shard.active.Data[key]; exists {
+			entry = inheritPin(old, entry)
+		}
+		shard.active.set(key, entry)
+		if expiresAt > 0 {
+			c.timeWheel.Add(key, c.untilMilli(expiresAt))
+		}
+		shard.mu.Unlock()
+	}
+}
@@ -0,0 +1,40 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// BenchmarkSetVsMSet 每次迭代写入 10k 个键：逐个 Set 或一次 MSet
+func BenchmarkSetVsMSet(b *testing.B) {
+	const keys = 10000
+	ctx := context.Background()
+	items := make(map[string]string, keys)
+	names := make([]string, 0, keys)
+	for i := 0; i < keys; i++ {
+		key := fmt.Sprintf("key:%d", i)
+		items[key] = "value"
+		names = append(names, key)
+	}
+	b.Run("Set", func(b *testing.B) {
+		c := newTestCache(b, nil, &discardRepo{})
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, key := range names {
+				if err := c.Set(ctx, key, "value", 0); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+	b.Run("MSet", func(b *testing.B) {
+		c := newTestCache(b, nil, &discardRepo{})
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := c.MSet(ctx, items, 0, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package biz
+
+const (
+	// AOFFormatGobV1 gob 编码的 []interface{} 命令流，启动时会被转换为 AOFFormatBinaryV1
+	AOFFormatGobV1 = "gob-v1"
+	// AOFFormatGobFramedV1 带文件头、每条 []interface{} 命令独立用 gob 编码并带长度前缀的格式，
+	// 启动时同样会被转换为 AOFFormatBinaryV1，这一格式的快照仍可直接加载
+	AOFFormatGobFramedV1 = "gob-framed-v1"
+	// AOFFormatBinaryV1 带文件头和长度前缀，每条 AOFCommand 以 EncodeCommand 编码，当前写入的格式
+	AOFFormatBinaryV1 = "binary-v1"
+
+	RoleStandalone = "standalone"
+	// RoleLeader 至少有一个从节点正在同步的主节点
+	RoleLeader = "leader"
+	// RoleReplica 配置了 replica_of 的从节点
+	RoleReplica = "replica"
+)
+
+// 可选特性名称，客户端据此判断服务端是否支持某项功能
+const (
+	FeatureNamespaces   = "namespaces"
+	FeatureHash         = "hash"
+	FeatureList         = "list"
+	FeatureWatch        = "watch"
+	FeatureTransactions = "transactions"
+	FeatureRESP         = "resp"
+	FeatureReplication  = "replication"
+	FeatureSnapshot     = "snapshot"
+	FeatureHotKeys      = "hot_keys"
+)
+
+// Limits 当前生效的限制，0 表示不限制
+type Limits struct {
+	// MaxValueSize Append 之后的值的最大长度(字节)
+	MaxValueSize int64
+	MaxKeys      int
+	MaxBytes     int64
+}
+
+// Capabilities 描述当前实例支持的可选特性与生效的限制
+type Capabilities struct {
+	Features   map[string]bool
+	AOFFormats []string
+	Role       string
+	Limits     Limits
+}
+
+// Capabilities 返回当前实例的能力描述
+func (c *GoCacheUsecase) Capabilities() Capabilities {
+	return Capabilities{
+		Features: map[string]bool{
+			FeatureNamespaces:   false,
+			FeatureHash:         true,
+			FeatureList:         true,
+			FeatureWatch:        true,
+			FeatureTransactions: true,
+			FeatureRESP:         c.respEnabled.Load(),
+			FeatureReplication:  true,
+			FeatureSnapshot:     true,
+			FeatureHotKeys:      c.hotKeys.enabled.Load(),
+		},
+		AOFFormats: []string{AOFFormatBinaryV1, AOFFormatGobFramedV1, AOFFormatGobV1},
+		Role:       c.ReplicationStats().Role,
+		Limits: Limits{
+			MaxValueSize: c.maxValueSize,
+			MaxKeys:      c.maxKeys,
+			MaxBytes:     c.maxBytes,
+		},
+	}
+}
+
+// SetRESPEnabled 记录是否对外提供 RESP 协议，供 Capabilities 上报
+func (c *GoCacheUsecase) SetRESPEnabled(enabled bool) {
+	c.respEnabled.Store(enabled)
+}
@@ -0,0 +1,42 @@
+package biz
+
+import (
+	"testing"
+
+	"gocache-service/internal/conf"
+)
+
+func TestCapabilitiesFollowConfig(t *testing.T) {
+	c := newTestCache(t, nil, nil)
+	caps := c.Capabilities()
+	if caps.Features[FeatureHotKeys] {
+		t.Fatal("hot_keys reported without hot_keys in config")
+	}
+	if caps.Limits != (Limits{}) {
+		t.Fatalf("Limits = %+v, want none", caps.Limits)
+	}
+	if caps.Role != RoleStandalone {
+		t.Fatalf("Role = %q, want %q", caps.Role, RoleStandalone)
+	}
+
+	c = newTestCache(t, &conf.Data_Cache{HotKeys: true, MaxValueSize: 1 << 20, MaxKeys: 1000, MaxBytes: 1 << 30}, nil)
+	caps = c.Capabilities()
+	if !caps.Features[FeatureHotKeys] {
+		t.Fatal("hot_keys not reported with hot_keys in config")
+	}
+	if want := (Limits{MaxValueSize: 1 << 20, MaxKeys: 1000, MaxBytes: 1 << 30}); caps.Limits != want {
+		t.Fatalf("Limits = %+v, want %+v", caps.Limits, want)
+	}
+}
+
+func TestCapabilitiesReportLeaderWhileServingFollowers(t *testing.T) {
+	c := newTestCache(t, nil, nil)
+	c.replication.followers.Add(1)
+	if role := c.Capabilities().Role; role != RoleLeader {
+		t.Fatalf("Role = %q with a follower, want %q", role, RoleLeader)
+	}
+	c.replication.followers.Add(-1)
+	if role := c.Capabilities().Role; role != RoleStandalone {
+		t.Fatalf("Role = %q after the follower left, want %q", role, RoleStandalone)
+	}
+}
@@ -0,0 +1,164 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCompareAndSwapOneWinnerPerTransition(t *testing.T) {
+	const goroutines, transitions = 32, 2000
+	ctx := context.Background()
+	repo := &memRepo{}
+	c := newTestCache(t, nil, repo)
+	if err := c.Set(ctx, "counter", "0", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// winners[i] 把值从 i 改为 i+1 的 CAS 成功次数
+	var winners [transitions]atomic.Int32
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				current, err := c.Get(ctx, "counter")
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				n, _ := strconv.Atoi(current)
+				if n >= transitions {
+					return
+				}
+				swapped, err := c.CompareAndSwap(ctx, "counter", current, strconv.Itoa(n+1), 0)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if swapped {
+					winners[n].Add(1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := range winners {
+		if n := winners[i].Load(); n != 1 {
+			t.Fatalf("%d CAS calls won %d -> %d, want exactly 1", n, i, i+1)
+		}
+	}
+	if v, _ := c.Get(ctx, "counter"); v != strconv.Itoa(transitions) {
+		t.Fatalf("counter = %s, want %d", v, transitions)
+	}
+	// 只有成功的 CAS 写入 AOF，重放的顺序与成功的顺序一致
+	sets := 0
+	for _, command := range repo.records() {
+		if command.Key != "counter" {
+			continue
+		}
+		if want := strconv.Itoa(sets); command.Value != want {
+			t.Fatalf("AOF record %d for counter = %q, want %q", sets, command.Value, want)
+		}
+		sets++
+	}
+	if sets != transitions+1 {
+		t.Fatalf("%d AOF records for counter, want %d", sets, transitions+1)
+	}
+}
+
+func TestCompareAndSwapMissingAsEmptyCreatesOnce(t *testing.T) {
+	const goroutines = 50
+	ctx := context.Background()
+	c := newTestCache(t, nil, nil)
+	if swapped, err := c.CompareAndSwap(ctx, "k", "", "v", 0); err != nil || swapped {
+		t.Fatalf("CompareAndSwap on a missing key = %v, %v, want false", swapped, err)
+	}
+
+	var wins atomic.Int32
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			swapped, err := c.CompareAndSwapMissingAsEmpty(ctx, "k", "", fmt.Sprintf("g%d", g), 0)
+			if err != nil {
+				t.Error(err)
+			}
+			if swapped {
+				wins.Add(1)
+			}
+		}(g)
+	}
+	wg.Wait()
+	if n := wins.Load(); n != 1 {
+		t.Fatalf("%d goroutines created the key, want 1", n)
+	}
+}
+
+// SetNX 和 CompareAndDelete 只记录结果的 SET/DEL，重放后得到相同的状态，失败的操作不写 AOF
+func TestSetNXAndCompareAndDeleteReplay(t *testing.T) {
+	ctx := context.Background()
+	clock := newMilliClock()
+	repo := &memRepo{}
+	c := newTestCache(t, nil, repo, WithClock(clock))
+	step := func(name string, got bool, err error, want bool) {
+		t.Helper()
+		if err != nil || got != want {
+			t.Fatalf("%s = %v, %v, want %v", name, got, err, want)
+		}
+	}
+
+	ok, err := c.SetNX(ctx, "lock", "a", time.Hour)
+	step("SetNX(lock, a)", ok, err, true)
+	ok, err = c.SetNX(ctx, "lock", "b", time.Hour)
+	step("SetNX(lock, b)", ok, err, false)
+	ok, err = c.CompareAndDelete(ctx, "lock", "b")
+	step("CompareAndDelete(lock, b)", ok, err, false)
+	ok, err = c.CompareAndDelete(ctx, "lock", "a")
+	step("CompareAndDelete(lock, a)", ok, err, true)
+	ok, err = c.SetNX(ctx, "lock", "c", time.Hour)
+	step("SetNX(lock, c)", ok, err, true)
+	ok, err = c.SetNX(ctx, "held", "x", 0)
+	step("SetNX(held, x)", ok, err, true)
+	ok, err = c.CompareAndDelete(ctx, "missing", "")
+	step("CompareAndDelete(missing)", ok, err, false)
+	// 已过期但还没被删除的键按不存在处理
+	ok, err = c.SetNX(ctx, "lease", "old", time.Second)
+	step("SetNX(lease, old)", ok, err, true)
+	clock.Advance(2 * time.Second)
+	ok, err = c.SetNX(ctx, "lease", "new", time.Minute)
+	step("SetNX(lease, new) after expiry", ok, err, true)
+	records := len(repo.records())
+	ok, err = c.SetNX(ctx, "held", "y", 0)
+	step("SetNX(held, y)", ok, err, false)
+	ok, err = c.CompareAndDelete(ctx, "held", "y")
+	step("CompareAndDelete(held, y)", ok, err, false)
+	if n := len(repo.records()); n != records {
+		t.Fatalf("failed SetNX and CompareAndDelete wrote %d AOF records", n-records)
+	}
+
+	restarted := newTestCache(t, nil, repo, WithClock(clock))
+	for key, want := range map[string]string{"lock": "c", "held": "x", "lease": "new"} {
+		if v, err := restarted.Get(ctx, key); err != nil || v != want {
+			t.Fatalf("Get(%s) after restart = %q, %v, want %q", key, v, err, want)
+		}
+	}
+	if ttl, err := restarted.TTL(ctx, "lease"); err != nil || ttl != time.Minute {
+		t.Fatalf("TTL(lease) after restart = %v, %v, want 1m", ttl, err)
+	}
+	if n, _ := restarted.DBSize(ctx); n != 3 {
+		t.Fatalf("DBSize after restart = %d, want 3", n)
+	}
+	// 重放后的状态上条件操作照常生效
+	ok, err = restarted.SetNX(ctx, "lock", "d", time.Hour)
+	step("SetNX(lock, d) after restart", ok, err, false)
+	ok, err = restarted.CompareAndDelete(ctx, "lock", "c")
+	step("CompareAndDelete(lock, c) after restart", ok, err, true)
+}
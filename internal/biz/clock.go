@@ -0,0 +1,64 @@
+package biz
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock 提供当前时间。过期判断、过期时间与事件时间的计算、AOF 重放、时间轮和 AOF 增长速度的估算都通过它读取时间，
+// 测试中可以换成手动推进的 FakeClock。耗时统计、分片锁等待、抽样的时间预算、访问时间和运行时长
+// 与真实的计时器或 LRU 顺序有关，仍使用真实时间
+type Clock interface {
+	Now() time.Time
+}
+
+// realClock 默认的时钟，直接返回 time.Now()
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// FakeClock 只在调用 Advance 或 Set 时前进的时钟，供测试使用，可以并发访问。
+// 时间轮仍按真实的 tick 转动，但到期判断使用 FakeClock 的时间
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock 创建停在 now 的时钟
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (f *FakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Advance 把时钟向后推进 d
+func (f *FakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// Set 把时钟调到 now
+func (f *FakeClock) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = now
+}
+
+// Option 定制 NewGoCacheUsecase 创建的缓存，在读取配置和重放 AOF 之前生效
+type Option func(*GoCacheUsecase)
+
+// WithClock 使用 clock 代替真实时间，clock 为 nil 时不生效
+func WithClock(clock Clock) Option {
+	return func(c *GoCacheUsecase) {
+		if clock != nil {
+			c.clock = clock
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+// newMilliClock 返回停在整毫秒上的 FakeClock，ExpiresAt 按毫秒保存，TTL 才能精确相等
+func newMilliClock() *FakeClock {
+	return NewFakeClock(time.UnixMilli(time.Now().UnixMilli()))
+}
+
+func TestTTLFollowsFakeClock(t *testing.T) {
+	ctx := context.Background()
+	clock := newMilliClock()
+	c := newTestCache(t, nil, nil, WithClock(clock))
+	if err := c.Set(ctx, "k", "v", 10*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Set(ctx, "forever", "v", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	clock.Advance(4 * time.Second)
+	if ttl, err := c.TTL(ctx, "k"); err != nil || ttl != 6*time.Second {
+		t.Fatalf("TTL after 4s = %v, %v, want 6s", ttl, err)
+	}
+	if ttl, err := c.TTL(ctx, "forever"); err != nil || ttl != NoExpiration {
+		t.Fatalf("TTL(forever) = %v, %v, want NoExpiration", ttl, err)
+	}
+	clock.Advance(6*time.Second - time.Millisecond)
+	if v, err := c.Get(ctx, "k"); err != nil || v != "v" {
+		t.Fatalf("Get 1ms before expiry = %q, %v, want v", v, err)
+	}
+	clock.Advance(time.Millisecond)
+	if _, err := c.Get(ctx, "k"); err != ErrKeyNotFound {
+		t.Fatalf("Get at expiry err = %v, want ErrKeyNotFound", err)
+	}
+	if _, err := c.TTL(ctx, "k"); err != ErrKeyNotFound {
+		t.Fatalf("TTL at expiry err = %v, want ErrKeyNotFound", err)
+	}
+}
+
+func TestExpireFollowsFakeClock(t *testing.T) {
+	ctx := context.Background()
+	clock := newMilliClock()
+	c := newTestCache(t, nil, nil, WithClock(clock))
+	if err := c.Set(ctx, "k", "v", time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := c.Expire(ctx, "k", time.Minute); err != nil || !ok {
+		t.Fatalf("Expire = %v, %v, want true", ok, err)
+	}
+	clock.Advance(59 * time.Second)
+	if _, err := c.Get(ctx, "k"); err != nil {
+		t.Fatalf("Get before the extended expiry: %v", err)
+	}
+	clock.Advance(time.Second)
+	if ok, err := c.Expire(ctx, "k", time.Minute); err != nil || ok {
+		t.Fatalf("Expire on an expired key = %v, %v, want false", ok, err)
+	}
+}
+
+func TestCollectExpiredKeysFollowsFakeClock(t *testing.T) {
+	ctx := context.Background()
+	clock := newMilliClock()
+	c := newTestCache(t, nil, nil, WithClock(clock))
+	for key, ttl := range map[string]time.Duration{"short": time.Second, "long": time.Hour, "forever": 0} {
+		if err := c.Set(ctx, key, "v", ttl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if expired := c.collectExpiredKeys(ctx); len(expired) != 0 {
+		t.Fatalf("expired before the clock moved: %v", expired)
+	}
+	clock.Advance(time.Minute)
+	if expired := c.collectExpiredKeys(ctx); !slices.Equal(expired, []string{"short"}) {
+		t.Fatalf("expired after 1m = %v, want [short]", expired)
+	}
+}
+
+func TestReplaySkipsKeysExpiredWhileStopped(t *testing.T) {
+	ctx := context.Background()
+	clock := newMilliClock()
+	repo := &memRepo{}
+	c := newTestCache(t, nil, repo, WithClock(clock))
+	if err := c.Set(ctx, "short", "v", time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Set(ctx, "long", "v", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 停机期间 short 过期，重放时按时钟跳过；long 的剩余 TTL 按重放时的时间计算
+	clock.Advance(time.Minute)
+	restarted := newTestCache(t, nil, repo, WithClock(clock))
+	if _, err := restarted.Get(ctx, "short"); err != ErrKeyNotFound {
+		t.Fatalf("Get(short) after restart err = %v, want ErrKeyNotFound", err)
+	}
+	if ttl, err := restarted.TTL(ctx, "long"); err != nil || ttl != time.Hour-time.Minute {
+		t.Fatalf("TTL(long) after restart = %v, %v, want 59m", ttl, err)
+	}
+	if n, _ := restarted.DBSize(ctx); n != 1 {
+		t.Fatalf("DBSize after restart = %d, want 1", n)
+	}
+}
@@ -0,0 +1,49 @@
+package biz
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// 配置了 compress_threshold 时，不小于该长度的字符串值以 gzip 压缩后的字节保存在 CacheItem.Value 中，
+// 并设置 CacheItem.Compressed。压缩发生在写入分片之前，内存占用估算、淘汰和 AOF 记录都按压缩后的大小计算；
+// Get、MGet 和迭代器在释放分片锁之后解压。压缩后没有变小的值按原样保存。
+
+// compress 按阈值压缩字符串条目，已压缩、哈希、列表和短值原样返回
+func (c *GoCacheUsecase) compress(entry CacheItem) CacheItem {
+	if c.compressThreshold <= 0 || entry.Compressed || !entry.isString() || int64(len(entry.Value)) < c.compressThreshold {
+		return entry
+	}
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestSpeed)
+	if err != nil {
+		return entry
+	}
+	if _, err := io.WriteString(zw, entry.Value); err != nil {
+		return entry
+	}
+	if err := zw.Close(); err != nil || buf.Len() >= len(entry.Value) {
+		return entry
+	}
+	entry.Value = buf.String()
+	entry.Compressed = true
+	return entry
+}
+
+// value 返回条目的原始值，压缩过的值先解压
+func (item CacheItem) value() (string, error) {
+	if !item.Compressed {
+		return item.Value, nil
+	}
+	zr, err := gzip.NewReader(bytes.NewReader([]byte(item.Value)))
+	if err != nil {
+		return "", fmt.Errorf("cache: decompress value: %w", err)
+	}
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, zr); err != nil {
+		return "", fmt.Errorf("cache: decompress value: %w", err)
+	}
+	return buf.String(), nil
+}
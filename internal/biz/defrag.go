@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultDefragInterval 后台检查分片是否需要整理的间隔
+	defaultDefragInterval = time.Minute
+	// defragPause 每重建一个分片后暂停的时间，避免连续占用写锁
+	defragPause = 10 * time.Millisecond
+	// defragRatio 存活键数与峰值键数之比低于该值时自动重建分片
+	defragRatio = 0.25
+	// defragMinPeak 峰值键数低于该值的分片占用很小，自动整理时跳过
+	defragMinPeak = 1024
+)
+
+// defragStats 整理任务的累计计数
+type defragStats struct {
+	passes           atomic.Uint64
+	shardsRebuilt    atomic.Uint64
+	reclaimedBuckets atomic.Uint64
+}
+
+// DefragStats 整理任务累计计数的快照
+type DefragStats struct {
+	Passes        uint64
+	ShardsRebuilt uint64
+	// ReclaimedBuckets 按 Go map 的装载因子估算的已回收桶数
+	ReclaimedBuckets uint64
+}
+
+// DefragResult 一次整理的结果
+type DefragResult struct {
+	ShardsRebuilt    int
+	ReclaimedBuckets uint64
+}
+
+// estimateBuckets 估算存放 n 个键的 map 的桶数：每个桶 8 个槽位，平均装载因子 6.5
+func estimateBuckets(n int) uint64 {
+	if n == 0 {
+		return 0
+	}
+	buckets := uint64(1)
+	for float64(buckets)*6.5 < float64(n) {
+		buckets <<= 1
+	}
+	return buckets
+}
+
+// needsDefrag 判断分片是否值得重建，force 时只要能回收桶就重建
+func needsDefrag(live, peak int, force bool) bool {
+	if estimateBuckets(peak) <= estimateBuckets(live) {
+		return false
+	}
+	if force {
+		return true
+	}
+	return peak >= defragMinPeak && float64(live) < float64(peak)*defragRatio
+}
+
+// defragShard 在写锁下把分片的 map 重建为刚好容纳现有键的大小，返回估算回收的桶数
+func (c *GoCacheUsecase) defragShard(index int, force bool) (uint64, bool) {
+	shard := &c.shards[index]
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	live, peak := len(shard.active.Data), shard.active.peak
+	if !needsDefrag(live, peak, force) {
+		return 0, false
+	}
+	data := make(map[string]CacheItem, live)
+	for key, entry := range shard.active.Data {
+		data[key] = entry
+	}
+	// 条目原样搬到新 map，键数和占用不变，因此不经过 set
+	rebuilt := c.newBuffer(&shard.keys, data)
+	rebuilt.peak = live
+	shard.active = rebuilt
+	return estimateBuckets(peak) - estimateBuckets(live), true
+}
+
+// Defrag 逐个分片重建键数远低于峰值的 map，每重建一个分片暂停 defragPause，
+// force 为 true 时忽略比例阈值，只要能回收就重建
+func (c *GoCacheUsecase) Defrag(ctx context.Context, force bool) (DefragResult, error) {
+	var result DefragResult
+	for i := range c.shards {
+		reclaimed, rebuilt := c.defragShard(i, force)
+		if !rebuilt {
+			continue
+		}
+		result.ShardsRebuilt++
+		result.ReclaimedBuckets += reclaimed
+		c.defrag.shardsRebuilt.Add(1)
+		c.defrag.reclaimedBuckets.Add(reclaimed)
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-c.stop:
+			return result, nil
+		case <-time.After(defragPause):
+		}
+	}
+	c.defrag.passes.Add(1)
+	if result.ShardsRebuilt > 0 {
+		c.log.WithContext(ctx).Infof("defrag rebuilt %d shards, reclaimed ~%d buckets", result.ShardsRebuilt, result.ReclaimedBuckets)
+	}
+	return result, nil
+}
+
+// startDefragmenter 定期在后台整理分片
+func (c *GoCacheUsecase) startDefragmenter() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(defaultDefragInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			_, _ = c.Defrag(context.Background(), false)
+		case <-c.stop:
+			return
+		}
+	}
+}
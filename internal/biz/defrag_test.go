@@ -0,0 +1,70 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"testing"
+)
+
+// heapInUse 在 GC 之后返回堆上存活对象占用的字节数
+func heapInUse() uint64 {
+	var m runtime.MemStats
+	runtime.GC()
+	runtime.GC()
+	runtime.ReadMemStats(&m)
+	return m.HeapAlloc
+}
+
+func TestDefragReleasesMapMemory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("measures the heap with 200k keys")
+	}
+	const keys = 200000
+	ctx := context.Background()
+	c := newTestCache(t, nil, &discardRepo{})
+	base := heapInUse()
+	for i := 0; i < keys; i++ {
+		prefix := "drop:"
+		if i%20 == 0 {
+			prefix = "keep:"
+		}
+		if err := c.Set(ctx, fmt.Sprintf("%s%d", prefix, i), "v", 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	loaded := heapInUse() - base
+	if _, err := c.FlushByPrefix(ctx, "drop:", false); err != nil {
+		t.Fatal(err)
+	}
+	// map 不会缩小，删除 95% 的键之后桶仍然保留
+	deleted := heapInUse() - base
+	result, err := c.Defrag(ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defragged := heapInUse() - base
+	t.Logf("heap: %d KB loaded, %d KB after deleting 95%% of keys, %d KB after defrag (%d shards, ~%d buckets)",
+		loaded>>10, deleted>>10, defragged>>10, result.ShardsRebuilt, result.ReclaimedBuckets)
+
+	if result.ShardsRebuilt != len(c.shards) {
+		t.Fatalf("rebuilt %d shards, want all %d", result.ShardsRebuilt, len(c.shards))
+	}
+	if deleted < loaded/2 {
+		t.Skipf("the runtime already released map memory on delete (%d of %d KB left)", deleted>>10, loaded>>10)
+	}
+	// 剩下的 5% 的键以及各分片的其他结构仍然占用一部分，至少要释放一半
+	if defragged > deleted/2 {
+		t.Fatalf("heap after defrag = %d KB, want at most half of the %d KB before it", defragged>>10, deleted>>10)
+	}
+	if n, _ := c.DBSize(ctx); n != keys/20 {
+		t.Fatalf("DBSize = %d after defrag, want %d", n, keys/20)
+	}
+	stats, err := c.Stats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Defrag.ReclaimedBuckets != result.ReclaimedBuckets || stats.Defrag.ShardsRebuilt != uint64(result.ShardsRebuilt) {
+		t.Fatalf("Stats.Defrag = %+v, want %+v", stats.Defrag, result)
+	}
+}
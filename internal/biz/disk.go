@@ -0,0 +1,251 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultDiskCheckInterval 未配置 disk_check_interval 时检查剩余空间的间隔
+	defaultDiskCheckInterval = 10 * time.Second
+	// diskGrowthWindow 按这段时间内的 AOF 增长估算增长速度
+	diskGrowthWindow = 5 * time.Minute
+)
+
+// DiskState 数据目录所在文件系统的剩余空间状态
+type DiskState string
+
+const (
+	// DiskOK 剩余空间充足，或没有配置阈值
+	DiskOK DiskState = "ok"
+	// DiskWarning 剩余空间低于 disk_warn_free_bytes，重写能回到阈值之上时提前重写 AOF
+	DiskWarning DiskState = "warning"
+	// DiskCritical 剩余空间低于 disk_min_free_bytes，写入按 disk_full_policy 处理
+	DiskCritical DiskState = "critical"
+)
+
+// DiskFullPolicy 剩余空间低于 disk_min_free_bytes 时的写入策略
+type DiskFullPolicy string
+
+const (
+	// DiskFullReject 拒绝客户端写入，返回 ErrDiskFull
+	DiskFullReject DiskFullPolicy = "reject"
+	// DiskFullDegrade 写入只修改内存，不追加 AOF；空间恢复后用内存中的数据重写 AOF
+	DiskFullDegrade DiskFullPolicy = "degrade"
+)
+
+// ParseDiskFullPolicy 解析配置中的磁盘空间不足策略，空字符串为 reject，无法识别时返回 reject 和错误
+func ParseDiskFullPolicy(s string) (DiskFullPolicy, error) {
+	switch policy := DiskFullPolicy(s); policy {
+	case "":
+		return DiskFullReject, nil
+	case DiskFullReject, DiskFullDegrade:
+		return policy, nil
+	default:
+		return DiskFullReject, fmt.Errorf("cache: unknown disk full policy %q", s)
+	}
+}
+
+// diskSample 某一时刻的 AOF 文件大小
+type diskSample struct {
+	at   time.Time
+	size int64
+}
+
+// diskMonitor 剩余空间的阈值、最近一次检查的结果与累计计数
+type diskMonitor struct {
+	interval time.Duration
+	// warnFree、minFree 警告阈值与下限(字节)，0 表示不检查
+	warnFree int64
+	minFree  int64
+	policy   DiskFullPolicy
+	// critical 剩余空间低于 minFree，写入路径只读这个标志
+	critical atomic.Bool
+	// unpersisted degrade 期间丢弃过 AOF 命令，AOF 与内存不一致，需要重写
+	unpersisted atomic.Bool
+
+	compactions atomic.Uint64
+	rejected    atomic.Uint64
+	dropped     atomic.Uint64
+
+	mu    sync.Mutex
+	state DiskState
+	free  int64
+	total int64
+	// samples diskGrowthWindow 内的 AOF 大小，文件变小(重写、清空)后重新开始
+	samples []diskSample
+	growth  float64
+}
+
+// DiskStats 剩余空间与磁盘空间不足时处理的快照
+type DiskStats struct {
+	State      DiskState
+	FreeBytes  int64
+	TotalBytes int64
+	// GrowthBytesPerSecond 最近 diskGrowthWindow 内 AOF 的增长速度
+	GrowthBytesPerSecond float64
+	// TimeToFull 按增长速度写满剩余空间的时间，AOF 没有增长时为 0
+	TimeToFull time.Duration
+	// Compactions 因剩余空间不足提前重写 AOF 的次数
+	Compactions uint64
+	// RejectedWrites reject 策略拒绝的写入数，DroppedCommands degrade 策略没有写入 AOF 的命令数
+	RejectedWrites  uint64
+	DroppedCommands uint64
+}
+
+// diskStateFor 按阈值判断剩余空间的状态，阈值为 0 时不检查
+func diskStateFor(free, warnFree, minFree int64) DiskState {
+	switch {
+	case minFree > 0 && free < minFree:
+		return DiskCritical
+	case warnFree > 0 && free < warnFree:
+		return DiskWarning
+	default:
+		return DiskOK
+	}
+}
+
+// observe 记录一次检查的结果并更新增长速度，返回之前和现在的状态
+func (d *diskMonitor) observe(now time.Time, stats AOFStats) (DiskState, DiskState) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if n := len(d.samples); n > 0 && stats.FileSize < d.samples[n-1].size {
+		d.samples = d.samples[:0]
+	}
+	d.samples = append(d.samples, diskSample{at: now, size: stats.FileSize})
+	drop := 0
+	for drop < len(d.samples)-1 && now.Sub(d.samples[drop].at) > diskGrowthWindow {
+		drop++
+	}
+	d.samples = d.samples[drop:]
+	first, last := d.samples[0], d.samples[len(d.samples)-1]
+	d.growth = 0
+	if elapsed := last.at.Sub(first.at).Seconds(); elapsed > 0 && last.size > first.size {
+		d.growth = float64(last.size-first.size) / elapsed
+	}
+	d.free, d.total = stats.DiskFree, stats.DiskTotal
+	prev := d.state
+	d.state = diskStateFor(stats.DiskFree, d.warnFree, d.minFree)
+	d.critical.Store(d.state == DiskCritical)
+	return prev, d.state
+}
+
+func (d *diskMonitor) stats() DiskStats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	stats := DiskStats{
+		State:                d.state,
+		FreeBytes:            d.free,
+		TotalBytes:           d.total,
+		GrowthBytesPerSecond: d.growth,
+		Compactions:          d.compactions.Load(),
+		RejectedWrites:       d.rejected.Load(),
+		DroppedCommands:      d.dropped.Load(),
+	}
+	if d.growth > 0 {
+		stats.TimeToFull = time.Duration(float64(d.free) / d.growth * float64(time.Second))
+	}
+	return stats
+}
+
+// DiskStats 返回最近一次检查的剩余空间、AOF 增长速度与累计计数
+func (c *GoCacheUsecase) DiskStats() DiskStats {
+	return c.disk.stats()
+}
+
+// diskWritable reject 策略下剩余空间低于下限时拒绝客户端写入
+func (c *GoCacheUsecase) diskWritable() error {
+	if c.disk.policy == DiskFullReject && c.disk.critical.Load() {
+		c.disk.rejected.Add(1)
+		return ErrDiskFull
+	}
+	return nil
+}
+
+// sampleDisk 读取剩余空间并记录状态的变化，文件系统不支持 statfs 时返回 false
+func (c *GoCacheUsecase) sampleDisk(ctx context.Context) (AOFStats, bool) {
+	stats, err := c.repo.AOFStats(ctx)
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("disk stats err: %v", err)
+		return stats, false
+	}
+	if stats.DiskTotal == 0 {
+		return stats, false
+	}
+	prev, state := c.disk.observe(c.clock.Now(), stats)
+	if prev != state {
+		if state == DiskOK {
+			c.log.WithContext(ctx).Infof("disk free space back to %d bytes", stats.DiskFree)
+		} else {
+			c.log.WithContext(ctx).Warnf("disk free space %d bytes, state %s", stats.DiskFree, state)
+		}
+	}
+	return stats, true
+}
+
+// shouldCompact 剩余空间低于警告阈值，且按估算重写后能回到阈值之上时提前重写 AOF。
+// 重写后的文件按内存估算占用计算，偏大；新文件写完之前旧文件仍在，因此还要求剩余空间放得下新文件
+func (c *GoCacheUsecase) shouldCompact(stats AOFStats) bool {
+	if c.disk.warnFree <= 0 || stats.DiskFree >= c.disk.warnFree {
+		return false
+	}
+	used := c.usedBytes.Load()
+	reclaim := stats.FileSize - used
+	return reclaim > 0 && used <= stats.DiskFree && stats.DiskFree+reclaim >= c.disk.warnFree
+}
+
+// checkDisk 检查一次剩余空间，需要时提前重写 AOF；degrade 期间丢弃过命令的，空间恢复后重写 AOF 补上
+func (c *GoCacheUsecase) checkDisk(ctx context.Context) {
+	stats, ok := c.sampleDisk(ctx)
+	if !ok {
+		return
+	}
+	if c.shouldCompact(stats) {
+		if _, err := c.RewriteAOF(ctx); err == nil {
+			c.disk.compactions.Add(1)
+			if _, ok := c.sampleDisk(ctx); !ok {
+				return
+			}
+		}
+	}
+	// 先清除标志再重写：重写期间的写入已经追加到新文件，失败时下次检查重试
+	if !c.disk.critical.Load() && c.disk.unpersisted.Swap(false) {
+		if _, err := c.RewriteAOF(ctx); err != nil {
+			c.disk.unpersisted.Store(true)
+		}
+	}
+}
+
+// startDiskMonitor 定期检查 AOF 所在文件系统的剩余空间
+func (c *GoCacheUsecase) startDiskMonitor() {
+	defer c.wg.Done()
+	ctx := context.Background()
+	ticker := time.NewTicker(c.disk.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.checkDisk(ctx)
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// diskGuardRepo degrade 策略下剩余空间低于下限时丢弃 AOF 命令，内存中的修改照常生效
+type diskGuardRepo struct {
+	CacheRepo
+	disk *diskMonitor
+}
+
+func (r *diskGuardRepo) Write(ctx context.Context, command AOFCommand) error {
+	if r.disk.policy == DiskFullDegrade && r.disk.critical.Load() {
+		r.disk.dropped.Add(1)
+		r.disk.unpersisted.Store(true)
+		return nil
+	}
+	return r.CacheRepo.Write(ctx, command)
+}
@@ -0,0 +1,245 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"gocache-service/internal/conf"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// diskRecordSize diskRepo 中每条命令占用的字节数
+const diskRecordSize = 10
+
+// diskRepo 模拟 AOF 所在文件系统的 memRepo：每条命令使文件增长 diskRecordSize 字节，
+// 重写后文件变为 rewrittenSize，剩余空间随之增减
+type diskRepo struct {
+	memRepo
+	fs            sync.Mutex
+	free, total   int64
+	size          int64
+	rewrittenSize int64
+	rewrites      int
+}
+
+func (r *diskRepo) Write(ctx context.Context, command AOFCommand) error {
+	r.fs.Lock()
+	r.size += diskRecordSize
+	r.free -= diskRecordSize
+	r.fs.Unlock()
+	return r.memRepo.Write(ctx, command)
+}
+
+func (r *diskRepo) AOFStats(context.Context) (AOFStats, error) {
+	r.fs.Lock()
+	defer r.fs.Unlock()
+	return AOFStats{FileSize: r.size, DiskFree: r.free, DiskTotal: r.total}, nil
+}
+
+func (r *diskRepo) RewriteAOF(ctx context.Context, snapshot func(emit func(items map[string]CacheItem)) error) (int64, error) {
+	if _, err := r.memRepo.RewriteAOF(ctx, snapshot); err != nil {
+		return 0, err
+	}
+	r.fs.Lock()
+	defer r.fs.Unlock()
+	r.rewrites++
+	r.free += r.size - r.rewrittenSize
+	r.size = r.rewrittenSize
+	return r.size, nil
+}
+
+func (r *diskRepo) setFree(free int64) {
+	r.fs.Lock()
+	defer r.fs.Unlock()
+	r.free = free
+}
+
+func (r *diskRepo) rewriteCount() int {
+	r.fs.Lock()
+	defer r.fs.Unlock()
+	return r.rewrites
+}
+
+// diskConfig 检查间隔很长，只有测试调用 checkDisk 时才检查
+func diskConfig(policy DiskFullPolicy) *conf.Data_Cache {
+	return &conf.Data_Cache{
+		DiskWarnFreeBytes: 1000,
+		DiskMinFreeBytes:  500,
+		DiskFullPolicy:    string(policy),
+		DiskCheckInterval: durationpb.New(time.Hour),
+	}
+}
+
+func TestDiskStateTransitions(t *testing.T) {
+	ctx := context.Background()
+	repo := &diskRepo{free: 2000, total: 10000}
+	c := newTestCache(t, diskConfig(DiskFullReject), repo)
+	expect := func(state DiskState, free int64) {
+		t.Helper()
+		stats, err := c.Stats(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats.Disk.State != state || stats.Disk.FreeBytes != free || stats.Disk.TotalBytes != 10000 {
+			t.Fatalf("disk = %+v, want state %s with %d of 10000 bytes free", stats.Disk, state, free)
+		}
+	}
+	// 启动时已经检查过一次
+	expect(DiskOK, 2000)
+	if err := c.Set(ctx, "k", "v1", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	repo.setFree(800)
+	c.checkDisk(ctx)
+	expect(DiskWarning, 800)
+	if err := c.Set(ctx, "k", "v2", 0); err != nil {
+		t.Fatalf("Set in warning state: %v", err)
+	}
+
+	repo.setFree(400)
+	c.checkDisk(ctx)
+	expect(DiskCritical, 400)
+	if err := c.Set(ctx, "k", "v3", 0); err != ErrDiskFull {
+		t.Fatalf("Set below disk_min_free_bytes err = %v, want ErrDiskFull", err)
+	}
+	if err := c.Delete(ctx, "k"); err != ErrDiskFull {
+		t.Fatalf("Delete below disk_min_free_bytes err = %v, want ErrDiskFull", err)
+	}
+	if v, err := c.Get(ctx, "k"); err != nil || v != "v2" {
+		t.Fatalf("Get(k) = %q, %v, want v2 untouched by the rejected writes", v, err)
+	}
+	if stats, _ := c.Stats(ctx); stats.Disk.RejectedWrites != 2 {
+		t.Fatalf("rejected %d writes, want 2", stats.Disk.RejectedWrites)
+	}
+
+	repo.setFree(2000)
+	c.checkDisk(ctx)
+	expect(DiskOK, 2000)
+	if err := c.Set(ctx, "k", "v4", 0); err != nil {
+		t.Fatalf("Set after space is back: %v", err)
+	}
+	if n := repo.rewriteCount(); n != 0 {
+		t.Fatalf("%d rewrites with nothing to reclaim, want none", n)
+	}
+
+	// 启动时空间已经不足的，第一个写入就被拒绝
+	full := newTestCache(t, diskConfig(DiskFullReject), &diskRepo{free: 100, total: 10000})
+	if err := full.Set(ctx, "k", "v", 0); err != ErrDiskFull {
+		t.Fatalf("Set on a cache started below disk_min_free_bytes err = %v, want ErrDiskFull", err)
+	}
+}
+
+func TestDiskDegradeRewritesOnRecovery(t *testing.T) {
+	ctx := context.Background()
+	repo := &diskRepo{free: 2000, total: 10000}
+	c := newTestCache(t, diskConfig(DiskFullDegrade), repo)
+	if err := c.Set(ctx, "before", "v", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	repo.setFree(400)
+	c.checkDisk(ctx)
+	records := len(repo.records())
+	if err := c.Set(ctx, "during", "v", 0); err != nil {
+		t.Fatalf("Set in degrade mode: %v", err)
+	}
+	if err := c.Delete(ctx, "before"); err != nil {
+		t.Fatalf("Delete in degrade mode: %v", err)
+	}
+	if v, err := c.Get(ctx, "during"); err != nil || v != "v" {
+		t.Fatalf("Get(during) = %q, %v, want the value served from memory", v, err)
+	}
+	if n := len(repo.records()); n != records {
+		t.Fatalf("%d AOF records written below the floor, want none", n-records)
+	}
+	stats, _ := c.Stats(ctx)
+	if stats.Disk.State != DiskCritical || stats.Disk.DroppedCommands != 2 || stats.Disk.RejectedWrites != 0 {
+		t.Fatalf("disk = %+v, want critical with 2 dropped commands", stats.Disk)
+	}
+
+	// 空间恢复后用内存中的数据重写 AOF，重启后得到降级期间的修改
+	repo.setFree(2000)
+	c.checkDisk(ctx)
+	if n := repo.rewriteCount(); n != 1 {
+		t.Fatalf("%d rewrites after space is back, want 1", n)
+	}
+	c.checkDisk(ctx)
+	if n := repo.rewriteCount(); n != 1 {
+		t.Fatalf("%d rewrites after a second check, want still 1", n)
+	}
+	restarted := newTestCache(t, diskConfig(DiskFullDegrade), repo)
+	if v, err := restarted.Get(ctx, "during"); err != nil || v != "v" {
+		t.Fatalf("Get(during) after restart = %q, %v, want v", v, err)
+	}
+	if _, err := restarted.Get(ctx, "before"); err != ErrKeyNotFound {
+		t.Fatalf("Get(before) after restart err = %v, want ErrKeyNotFound", err)
+	}
+}
+
+func TestDiskCompactsWhenRewriteReclaimsEnough(t *testing.T) {
+	ctx := context.Background()
+	// 重写只能腾出 400 字节，回不到 1000 字节的警告阈值之上，不重写
+	repo := &diskRepo{free: 100, total: 10000, size: 500}
+	c := newTestCache(t, diskConfig(DiskFullReject), repo)
+	c.checkDisk(ctx)
+	if stats, _ := c.Stats(ctx); stats.Disk.State != DiskCritical || stats.Disk.Compactions != 0 || repo.rewriteCount() != 0 {
+		t.Fatalf("disk = %+v after %d rewrites, want critical and no compaction", stats.Disk, repo.rewriteCount())
+	}
+
+	// 文件中大部分是过时的记录，重写后剩余空间回到阈值之上
+	repo = &diskRepo{free: 800, total: 1 << 30, size: 1 << 20, rewrittenSize: 100}
+	c = newTestCache(t, diskConfig(DiskFullReject), repo)
+	stats, err := c.Stats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Disk.State != DiskOK || stats.Disk.Compactions != 1 || repo.rewriteCount() != 1 {
+		t.Fatalf("disk = %+v after %d rewrites, want ok after one compaction", stats.Disk, repo.rewriteCount())
+	}
+	if want := int64(800 + 1<<20 - 100); stats.Disk.FreeBytes != want {
+		t.Fatalf("%d bytes free after compaction, want %d", stats.Disk.FreeBytes, want)
+	}
+	c.checkDisk(ctx)
+	if n := repo.rewriteCount(); n != 1 {
+		t.Fatalf("%d rewrites once back above the threshold, want 1", n)
+	}
+}
+
+func TestDiskTimeToFull(t *testing.T) {
+	ctx := context.Background()
+	clock := newMilliClock()
+	repo := &diskRepo{free: 1000200, total: 1 << 30}
+	c := newTestCache(t, &conf.Data_Cache{DiskCheckInterval: durationpb.New(time.Hour)}, repo, WithClock(clock))
+	if stats := c.DiskStats(); stats.GrowthBytesPerSecond != 0 || stats.TimeToFull != 0 {
+		t.Fatalf("disk = %+v before any writes, want no growth", stats)
+	}
+
+	for i := 0; i < 20; i++ {
+		if err := c.Set(ctx, "k", "v", 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clock.Advance(10 * time.Second)
+	c.checkDisk(ctx)
+	stats := c.DiskStats()
+	if stats.GrowthBytesPerSecond != 20*diskRecordSize/10 {
+		t.Fatalf("growth = %v bytes/s, want %d", stats.GrowthBytesPerSecond, 20*diskRecordSize/10)
+	}
+	if free := int64(1000000); stats.FreeBytes != free || stats.TimeToFull != 50000*time.Second {
+		t.Fatalf("disk = %+v, want %d bytes free filling up at %d bytes/s", stats, free, 20*diskRecordSize/10)
+	}
+	if stats.State != DiskOK {
+		t.Fatalf("state = %s without thresholds, want ok", stats.State)
+	}
+
+	// 窗口内没有增长后不再预测写满时间
+	clock.Advance(diskGrowthWindow + time.Minute)
+	c.checkDisk(ctx)
+	if stats := c.DiskStats(); stats.GrowthBytesPerSecond != 0 || stats.TimeToFull != 0 {
+		t.Fatalf("disk = %+v after an idle window, want no growth", stats)
+	}
+}
@@ -0,0 +1,93 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// eventTimeWinners 记录每个键写入过的最大事件时间
+type eventTimeWinners struct {
+	mu  sync.Mutex
+	max map[string]int64
+}
+
+func (w *eventTimeWinners) observe(key string, eventTime int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.max[key] = max(w.max[key], eventTime)
+}
+
+func TestNewestEventTimeWinsAcrossRestart(t *testing.T) {
+	const keys, backfillers, writes = 60, 8, 2000
+	ctx := context.Background()
+	clock := newMilliClock()
+	now := clock.Now().UnixMilli()
+	repo := &memRepo{}
+	c := newTestCache(t, nil, repo, WithClock(clock))
+
+	// 回填的事件时间都早于线上写入的到达时间，值就是事件时间，事件时间相同的写入互相替换不影响结果
+	winners := &eventTimeWinners{max: make(map[string]int64)}
+	var wg sync.WaitGroup
+	for g := 0; g < backfillers; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			rng := rand.New(rand.NewSource(int64(g)))
+			for i := 0; i < writes; i++ {
+				key := fmt.Sprintf("k%d", rng.Intn(keys))
+				eventTime := now - 1 - rng.Int63n(100000)
+				if g%2 == 0 {
+					if _, _, err := c.SetIfNewer(ctx, key, strconv.FormatInt(eventTime, 10), 0, eventTime); err != nil {
+						t.Error(err)
+						return
+					}
+				} else if _, err := c.MSet(ctx, map[string]string{key: strconv.FormatInt(eventTime, 10)}, 0, map[string]int64{key: eventTime}); err != nil {
+					t.Error(err)
+					return
+				}
+				winners.observe(key, eventTime)
+			}
+		}(g)
+	}
+	// 线上写入与回填交错，以到达时间为事件时间，总是比回填的数据新
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < keys; i += 3 {
+			if err := c.Set(ctx, fmt.Sprintf("k%d", i), "live", 0); err != nil {
+				t.Error(err)
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+	wg.Wait()
+
+	check := func(c *GoCacheUsecase, when string) {
+		t.Helper()
+		for i := 0; i < keys; i++ {
+			key := fmt.Sprintf("k%d", i)
+			want, wantTime := strconv.FormatInt(winners.max[key], 10), winners.max[key]
+			if i%3 == 0 {
+				want, wantTime = "live", now
+			}
+			if v, err := c.Get(ctx, key); err != nil || v != want {
+				t.Fatalf("%s: Get(%s) = %q, %v, want %q", when, key, v, err, want)
+			}
+			applied, winner, err := c.SetIfNewer(ctx, key, "stale", 0, now-200000)
+			if err != nil || applied || winner != wantTime {
+				t.Fatalf("%s: stale SetIfNewer(%s) = %v, %d, %v, want not applied with winner %d", when, key, applied, winner, err, wantTime)
+			}
+		}
+	}
+	check(c, "before restart")
+	if err := c.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	check(newTestCache(t, nil, repo, WithClock(clock)), "after restart")
+}
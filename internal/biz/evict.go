@@ -0,0 +1,257 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// evictionSamples 每次淘汰时从分片中抽样比较的键数，与 Redis 的 maxmemory-samples 类似
+const evictionSamples = 5
+
+// EvictionPolicy 键数达到上限时的处理方式，名称与 Redis 的 maxmemory-policy 一致
+type EvictionPolicy string
+
+const (
+	// EvictionNoEviction 不淘汰，写入新键或超出内存上限时返回 ErrCacheFull
+	EvictionNoEviction EvictionPolicy = "noeviction"
+	// EvictionAllKeysLRU 在所有未固定的键中淘汰近似最久未访问的键
+	EvictionAllKeysLRU EvictionPolicy = "allkeys-lru"
+	// EvictionVolatileTTL 在设置了过期时间的键中淘汰最快过期的键，没有这样的键时同 noeviction
+	EvictionVolatileTTL EvictionPolicy = "volatile-ttl"
+)
+
+// ParseEvictionPolicy 解析配置中的淘汰策略，空字符串为 allkeys-lru，无法识别时返回 allkeys-lru 和错误
+func ParseEvictionPolicy(s string) (EvictionPolicy, error) {
+	switch policy := EvictionPolicy(s); policy {
+	case "":
+		return EvictionAllKeysLRU, nil
+	case EvictionNoEviction, EvictionAllKeysLRU, EvictionVolatileTTL:
+		return policy, nil
+	default:
+		return EvictionAllKeysLRU, fmt.Errorf("cache: unknown eviction policy %q", s)
+	}
+}
+
+// evictionStats 淘汰的累计计数
+type evictionStats struct {
+	evicted  atomic.Uint64
+	rejected atomic.Uint64
+	// next 从其他分片淘汰时的起始分片，轮流选择避免总是淘汰同一个分片
+	next atomic.Uint32
+}
+
+// EvictionStats 淘汰计数的快照
+type EvictionStats struct {
+	Policy  EvictionPolicy
+	MaxKeys int
+	// MaxBytes 估算内存占用的上限，UsedBytes 当前的估算占用，见 entrySize
+	MaxBytes  int64
+	UsedBytes int64
+	// Evicted 因键数达到上限被淘汰的键数
+	Evicted uint64
+	// Rejected 因无法腾出空间被拒绝的写入数
+	Rejected uint64
+}
+
+// touch 记录一次访问，读路径只持有读锁，因此用原子操作更新
+func (item CacheItem) touch(now int64) {
+	if item.access != nil {
+		item.access.Store(now)
+	}
+}
+
+// lastAccess 返回最近一次访问的时间(Unix 纳秒)，从未记录过的条目视为最旧
+func (item CacheItem) lastAccess() int64 {
+	if item.access == nil {
+		return 0
+	}
+	return item.access.Load()
+}
+
+// newAccessClock 为新写入的条目分配访问时间
+func newAccessClock(now int64) *atomic.Int64 {
+	clock := new(atomic.Int64)
+	clock.Store(now)
+	return clock
+}
+
+// shardLimit 每个分片最多容纳的键数，maxKeys 为 0 时不限制
+func (c *GoCacheUsecase) shardLimit() int {
+	if c.maxKeys <= 0 {
+		return 0
+	}
+	return (c.maxKeys + len(c.shards) - 1) / len(c.shards)
+}
+
+// itemOverhead 每个条目除键和值以外的估算开销：CacheItem 本身、map 槽位、访问时间和时间轮定时项
+const itemOverhead = 96
+
+const (
+	// hashFieldOverhead 哈希每个字段除字段名和值以外的估算开销
+	hashFieldOverhead = 32
+	// listElemOverhead 列表每个元素除值以外的开销，即切片中的字符串头
+	listElemOverhead = 16
+)
+
+// entrySize 条目的估算内存占用
+func entrySize(key string, entry CacheItem) int64 {
+	size := int64(len(key)+len(entry.Value)) + itemOverhead
+	for field, value := range entry.Hash {
+		size += int64(len(field)+len(value)) + hashFieldOverhead
+	}
+	for _, value := range entry.List {
+		size += int64(len(value)) + listElemOverhead
+	}
+	return size
+}
+
+// evictLocked 在写入前检查键数与内存上限，超出时按淘汰策略删除键并追加 DEL 记录，size 是将要写入的条目的估算占用。
+// 固定的键不参与淘汰，无法腾出空间时返回 ErrCacheFull。调用方需持有分片写锁。
+func (c *GoCacheUsecase) evictLocked(buf *CacheBuffer, key string, size int64) error {
+	if err := c.evictKeysLocked(buf, key); err != nil {
+		return err
+	}
+	return c.evictBytesLocked(buf, key, size)
+}
+
+// evictKeysLocked 分片键数达到上限时在分片内淘汰，覆盖已有的键不受限制
+func (c *GoCacheUsecase) evictKeysLocked(buf *CacheBuffer, key string) error {
+	limit := c.shardLimit()
+	if limit == 0 {
+		return nil
+	}
+	if _, exists := buf.Data[key]; exists {
+		return nil
+	}
+	for len(buf.Data) >= limit {
+		victim, ok := c.sampleVictim(buf)
+		if !ok {
+			c.eviction.rejected.Add(1)
+			return ErrCacheFull
+		}
+		c.evictKeyLocked(buf, victim)
+	}
+	return nil
+}
+
+// evictBytesLocked 写入后估算占用会超过 maxBytes 时先在当前分片内淘汰，当前分片腾不出空间再从其他分片淘汰。
+// 不同分片的写入只各自检查，并发写入时总占用可能短暂超出上限，超出量不超过这些写入的大小之和
+func (c *GoCacheUsecase) evictBytesLocked(buf *CacheBuffer, key string, size int64) error {
+	if c.maxBytes <= 0 {
+		return nil
+	}
+	if size > c.maxBytes {
+		c.eviction.rejected.Add(1)
+		return ErrCacheFull
+	}
+	for {
+		excess := c.usedBytes.Load() + size - c.maxBytes
+		if old, exists := buf.Data[key]; exists {
+			excess -= entrySize(key, old)
+		}
+		if excess <= 0 {
+			return nil
+		}
+		if victim, ok := c.sampleVictim(buf); ok {
+			c.evictKeyLocked(buf, victim)
+			continue
+		}
+		if !c.evictFromOtherShard(key) {
+			c.eviction.rejected.Add(1)
+			return ErrCacheFull
+		}
+	}
+}
+
+// evictFromOtherShard 从 key 所在分片以外的分片淘汰一个键，返回是否淘汰成功。
+// 调用方已持有一个分片的写锁，这里只用 TryLock，拿不到锁的分片直接跳过，避免两个写入互相等待对方的分片锁
+func (c *GoCacheUsecase) evictFromOtherShard(key string) bool {
+	current := c.getShard(key)
+	start := int(c.eviction.next.Add(1))
+	for n := range c.shards {
+		shard := &c.shards[(start+n)%len(c.shards)]
+		if shard == current || !shard.mu.TryLock() {
+			continue
+		}
+		victim, ok := c.sampleVictim(shard.active)
+		if ok {
+			c.evictKeyLocked(shard.active, victim)
+		}
+		shard.mu.Unlock()
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// sampleVictim 按淘汰策略从分片中选出一个键，noeviction 或没有可淘汰的键时返回 false
+func (c *GoCacheUsecase) sampleVictim(buf *CacheBuffer) (string, bool) {
+	switch c.policy {
+	case EvictionAllKeysLRU:
+		return sampleLRU(buf)
+	case EvictionVolatileTTL:
+		return sampleTTL(buf)
+	}
+	return "", false
+}
+
+// evictKeyLocked 淘汰一个键并追加 DEL 记录，调用方需持有该键所在分片的写锁
+func (c *GoCacheUsecase) evictKeyLocked(buf *CacheBuffer, victim string) {
+	c.removeLocked(buf, victim)
+	c.eviction.evicted.Add(1)
+	if err := c.repo.Write(context.Background(), AOFCommand{Op: AOFDel, Key: victim}); err != nil {
+		c.log.Errorf("write evicted key %s to AOF err: %v", victim, err)
+	}
+}
+
+// sampleLRU 利用 map 遍历顺序的随机性抽取若干未固定的键，返回其中最久未访问的一个
+func sampleLRU(buf *CacheBuffer) (string, bool) {
+	var (
+		victim  string
+		oldest  int64
+		sampled int
+	)
+	for key, entry := range buf.Data {
+		if entry.Pinned {
+			continue
+		}
+		if access := entry.lastAccess(); sampled == 0 || access < oldest {
+			victim, oldest = key, access
+		}
+		sampled++
+		if sampled >= evictionSamples {
+			break
+		}
+	}
+	return victim, sampled > 0
+}
+
+// sampleTTL 抽取若干设置了过期时间且未固定的键，返回其中最快过期的一个
+func sampleTTL(buf *CacheBuffer) (string, bool) {
+	var (
+		victim   string
+		earliest int64
+		sampled  int
+	)
+	for key, entry := range buf.Data {
+		if entry.Pinned || entry.ExpiresAt == 0 {
+			continue
+		}
+		if sampled == 0 || entry.ExpiresAt < earliest {
+			victim, earliest = key, entry.ExpiresAt
+		}
+		sampled++
+		if sampled >= evictionSamples {
+			break
+		}
+	}
+	return victim, sampled > 0
+}
+
+// nowNano 访问时间使用的时钟
+func nowNano() int64 {
+	return time.Now().UnixNano()
+}
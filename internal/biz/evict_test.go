@@ -0,0 +1,148 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"gocache-service/internal/conf"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// 单个分片只有 evictionSamples 个键时抽样覆盖全部键，淘汰顺序就是精确的 LRU
+func TestLRUEvictsLeastRecentlyRead(t *testing.T) {
+	size := entrySize("k0", CacheItem{Value: "v"})
+	for _, tc := range []struct {
+		name string
+		cfg  *conf.Data_Cache
+	}{
+		{"max_keys", &conf.Data_Cache{Shards: 1, MaxKeys: evictionSamples}},
+		{"max_bytes", &conf.Data_Cache{Shards: 1, MaxBytes: evictionSamples * size}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &memRepo{}
+			c := newTestCache(t, tc.cfg, repo)
+			// 访问时间是纳秒级的真实时间，每次访问之间稍等片刻，保证先后顺序分明
+			access := func(op func() error) {
+				t.Helper()
+				time.Sleep(time.Microsecond)
+				if err := op(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for i := 0; i < evictionSamples; i++ {
+				key := fmt.Sprintf("k%d", i)
+				access(func() error { return c.Set(ctx, key, "v", 0) })
+			}
+			// 读过的 k0、k2、k4 比 k1、k3 新，k1 写入得更早
+			for _, key := range []string{"k0", "k2", "k4"} {
+				access(func() error { _, err := c.Get(ctx, key); return err })
+			}
+			access(func() error { return c.Set(ctx, "n1", "v", 0) })
+			access(func() error { return c.Set(ctx, "n2", "v", 0) })
+
+			keys, err := c.Keys(ctx, "*")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := []string{"k0", "k2", "k4", "n1", "n2"}; !reflect.DeepEqual(keys, want) {
+				t.Fatalf("keys = %v, want %v", keys, want)
+			}
+			var evicted []string
+			for _, command := range repo.records() {
+				if command.Op == AOFDel {
+					evicted = append(evicted, command.Key)
+				}
+			}
+			if want := []string{"k1", "k3"}; !reflect.DeepEqual(evicted, want) {
+				t.Fatalf("DEL records = %v, want %v", evicted, want)
+			}
+			if stats, _ := c.Stats(ctx); stats.Eviction.Evicted != 2 {
+				t.Fatalf("evicted %d keys, want 2", stats.Eviction.Evicted)
+			}
+		})
+	}
+}
+
+// trackedBytes 返回分片中全部条目的估算占用之和，与 usedBytes 对照
+func trackedBytes(c *GoCacheUsecase) int64 {
+	var total int64
+	for i := range c.shards {
+		c.shards[i].mu.RLock()
+		for key, entry := range c.shards[i].active.Data {
+			total += entrySize(key, entry)
+		}
+		c.shards[i].mu.RUnlock()
+	}
+	return total
+}
+
+func TestMaxBytesBoundsTrackedBytes(t *testing.T) {
+	const maxBytes = 64 << 10
+	ctx := context.Background()
+	clock := newMilliClock()
+	c := newTestCache(t, &conf.Data_Cache{MaxBytes: maxBytes, TimeWheelTick: durationpb.New(time.Hour)}, nil, WithClock(clock))
+	r := rand.New(rand.NewSource(1))
+	check := func(op string) {
+		t.Helper()
+		stats, err := c.Stats(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if used := stats.Eviction.UsedBytes; used > maxBytes {
+			t.Fatalf("after %s: %d bytes tracked, over max_bytes %d", op, used, maxBytes)
+		}
+		if used, actual := stats.Eviction.UsedBytes, trackedBytes(c); used != actual {
+			t.Fatalf("after %s: %d bytes tracked, entries take %d", op, used, actual)
+		}
+	}
+	for i := 0; i < 2000; i++ {
+		key := fmt.Sprintf("k%d", r.Intn(200))
+		switch n := r.Intn(10); {
+		case n < 6:
+			// 新键和覆盖，值从 10 字节到 4KB 不等，部分带 TTL
+			ttl := time.Duration(0)
+			if n == 0 {
+				ttl = time.Second
+			}
+			if err := c.Set(ctx, key, strings.Repeat("x", 10+r.Intn(4<<10)), ttl); err != nil {
+				t.Fatalf("Set(%s): %v", key, err)
+			}
+			check("set " + key)
+		case n < 8:
+			if err := c.Delete(ctx, key); err != nil && err != ErrKeyNotFound {
+				t.Fatalf("Delete(%s): %v", key, err)
+			}
+			check("delete " + key)
+		case n == 8:
+			clock.Advance(500 * time.Millisecond)
+			c.Get(ctx, key)
+			check("get " + key)
+		default:
+			c.sweepExpired()
+			check("sweep")
+		}
+	}
+	if stats, _ := c.Stats(ctx); stats.Eviction.Evicted == 0 {
+		t.Fatal("nothing evicted, the test never reached max_bytes")
+	}
+
+	// noeviction 拒绝超出上限的写入，占用保持不变
+	full := newTestCache(t, &conf.Data_Cache{MaxBytes: maxBytes, EvictionPolicy: string(EvictionNoEviction)}, nil)
+	var err error
+	for i := 0; err == nil; i++ {
+		err = full.Set(ctx, fmt.Sprintf("k%d", i), strings.Repeat("x", 1<<10), 0)
+	}
+	if err != ErrCacheFull {
+		t.Fatalf("Set past max_bytes with noeviction err = %v, want ErrCacheFull", err)
+	}
+	if stats, _ := full.Stats(ctx); stats.Eviction.UsedBytes > maxBytes || stats.Eviction.UsedBytes != trackedBytes(full) {
+		t.Fatalf("noeviction: %d bytes tracked, entries take %d, max_bytes %d", stats.Eviction.UsedBytes, trackedBytes(full), maxBytes)
+	}
+}
@@ -0,0 +1,90 @@
+package biz
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultExpireSampleInterval 未配置时抽样检查过期键的间隔，与 Redis 默认的 hz 10 相同
+	defaultExpireSampleInterval = 100 * time.Millisecond
+	// defaultExpireSampleKeys 未配置时每个分片每轮抽查的键数
+	defaultExpireSampleKeys = 20
+	// defaultExpireSampleBudget 未配置时一次抽样检查的最长耗时
+	defaultExpireSampleBudget = 5 * time.Millisecond
+	// expireRepeatRatio 一轮抽查中已过期的键超过该比例时，说明分片内还有较多过期键，继续抽查同一分片
+	expireRepeatRatio = 0.25
+)
+
+// expireSampler 后台抽样删除过期键的配置、进度与累计计数。时间轮负责按时删除，抽样只是兜底，
+// 处理时间轮之外的过期键，例如从节点上等待主节点 DEL 的键。每轮在分片写锁下只看 keys 个键，
+// 不会像全量扫描那样长时间持有分片锁
+type expireSampler struct {
+	interval time.Duration
+	keys     int
+	budget   time.Duration
+	// next 下一次从哪个分片开始，时间用完时从停下的分片继续，只由后台协程访问
+	next int
+
+	examined    atomic.Uint64
+	expired     atomic.Uint64
+	timeLimited atomic.Uint64
+}
+
+// ExpireSampleStats 抽样检查累计计数的快照
+type ExpireSampleStats struct {
+	// Examined 抽查过的键数，Expired 其中因已过期被删除的键数
+	Examined uint64
+	Expired  uint64
+	// TimeLimited 因用完 expire_sample_budget 而提前结束的次数，持续增长说明过期键积压
+	TimeLimited uint64
+}
+
+// sampleExpired 从上次停下的分片开始依次抽查各分片，某个分片一轮中过期的键超过 expireRepeatRatio 时
+// 继续抽查该分片，总耗时超过 budget 时停止并记住位置
+func (c *GoCacheUsecase) sampleExpired() {
+	for i := range c.shards {
+		c.metrics.SetKeyCount(i, int(c.shards[i].keys.Load()))
+	}
+	c.metrics.SetLockWaits(c.LockWaits())
+	deadline := time.Now().Add(c.sampler.budget)
+	for i := 0; i < len(c.shards); i++ {
+		index := (c.sampler.next + i) % len(c.shards)
+		for {
+			examined, expired := c.sampleShard(index)
+			if time.Now().After(deadline) {
+				c.sampler.next = index
+				c.sampler.timeLimited.Add(1)
+				return
+			}
+			if examined == 0 || float64(expired) <= float64(examined)*expireRepeatRatio {
+				break
+			}
+		}
+	}
+}
+
+// sampleShard 在分片写锁下利用 map 遍历顺序的随机性抽查最多 keys 个键，删除其中已过期的键。
+// 主节点把本轮删除的键合并为一条 MDEL 记录；从节点只删除内存中的条目，
+// 与主节点复制的 DEL 无关，AOF 中的记录在重放时按过期时间跳过
+func (c *GoCacheUsecase) sampleShard(index int) (examined, expired int) {
+	shard := &c.shards[index]
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	now := c.clock.Now().UnixMilli()
+	var removed []string
+	for key, entry := range shard.active.Data {
+		if entry.expired(now) {
+			c.dropExpiredLocked(shard.active, key)
+			removed = append(removed, key)
+			expired++
+		}
+		if examined++; examined >= c.sampler.keys {
+			break
+		}
+	}
+	c.logExpiredLocked(removed)
+	c.sampler.examined.Add(uint64(examined))
+	c.sampler.expired.Add(uint64(expired))
+	return examined, expired
+}
@@ -0,0 +1,341 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"gocache-service/internal/conf"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// 用 go test -race 运行时检查读时惰性删除、时间轮和抽样清理之间没有数据竞争
+func TestGetOnExpiringKeysFromManyGoroutines(t *testing.T) {
+	const goroutines, keys = 50, 500
+	ctx := context.Background()
+	repo := &memRepo{}
+	c := newTestCache(t, &conf.Data_Cache{
+		TimeWheelTick:        durationpb.New(5 * time.Millisecond),
+		ExpireSampleInterval: durationpb.New(5 * time.Millisecond),
+	}, repo)
+	for i := 0; i < keys; i++ {
+		ttl := time.Duration(1+i%40) * time.Millisecond
+		if err := c.Set(ctx, fmt.Sprintf("k%d", i), "v", ttl); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := g; ; i = (i + 7) % keys {
+				if _, err := c.Get(ctx, fmt.Sprintf("k%d", i)); err != nil && err != ErrKeyNotFound {
+					t.Error(err)
+					return
+				}
+				if n, _ := c.DBSize(ctx); n == 0 || time.Now().After(deadline) {
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	if n, _ := c.DBSize(ctx); n != 0 {
+		t.Fatalf("%d keys left after 5s", n)
+	}
+
+	// 每个键只被删除一次：过期计数和 AOF 中的 DEL 都恰好每键一条
+	stats, err := c.Stats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Expired != keys {
+		t.Fatalf("Expired = %d, want %d", stats.Expired, keys)
+	}
+	deleted := make(map[string]int)
+	for _, command := range repo.records() {
+		switch command.Op {
+		case AOFDel:
+			deleted[command.Key]++
+		case AOFMDel:
+			for _, key := range command.Args {
+				deleted[key]++
+			}
+		}
+	}
+	for i := 0; i < keys; i++ {
+		if key := fmt.Sprintf("k%d", i); deleted[key] != 1 {
+			t.Fatalf("%s deleted %d times in the AOF, want 1", key, deleted[key])
+		}
+	}
+}
+
+// raceCleanupRepo 在清理 AOF 之前调用 beforeCleanup，模拟在清理内存和截取 AOF 之间到达的写入
+type raceCleanupRepo struct {
+	memRepo
+	beforeCleanup func()
+}
+
+func (r *raceCleanupRepo) CleanupAOF(ctx context.Context, expiredKeys func() []string) error {
+	r.beforeCleanup()
+	return r.memRepo.CleanupAOF(ctx, expiredKeys)
+}
+
+func TestSweepKeepsKeysRewrittenBeforeAOFCleanup(t *testing.T) {
+	ctx := context.Background()
+	clock := newMilliClock()
+	repo := &raceCleanupRepo{}
+	c := newTestCache(t, nil, repo, WithClock(clock))
+	manualWheel(t, c, clock, time.Second)
+	for _, key := range []string{"k", "gone"} {
+		if err := c.Set(ctx, key, "old", time.Second); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clock.Advance(2 * time.Second)
+	// k 在全量扫描删除之后、AOF 清理之前被重新写入，这条 SET 位于截取点之前
+	repo.beforeCleanup = func() {
+		if err := c.Set(ctx, "k", "new", 0); err != nil {
+			t.Error(err)
+		}
+	}
+	c.sweepExpired()
+
+	for _, command := range repo.records() {
+		if command.Key == "gone" {
+			t.Fatalf("record %+v for the expired key survived the cleanup", command)
+		}
+	}
+	restarted := newTestCache(t, nil, &repo.memRepo, WithClock(clock))
+	if v, err := restarted.Get(ctx, "k"); err != nil || v != "new" {
+		t.Fatalf("Get(k) after restart = %q, %v, want new", v, err)
+	}
+	if _, err := restarted.Get(ctx, "gone"); err != ErrKeyNotFound {
+		t.Fatalf("Get(gone) after restart err = %v, want ErrKeyNotFound", err)
+	}
+}
+
+func TestTTLJitterSpreadsExpirations(t *testing.T) {
+	const keys, ttl, jitter, tick = 100000, 10 * time.Second, 0.1, 100 * time.Millisecond
+	ctx := context.Background()
+	clock := newMilliClock()
+	repo := &memRepo{}
+	// 只让时间轮删除过期键，抽样清理和定期扫描不在测试期间运行
+	c := newTestCache(t, &conf.Data_Cache{
+		TtlJitter:            jitter,
+		ExpireSampleInterval: durationpb.New(time.Hour),
+		CleanupInterval:      durationpb.New(time.Hour),
+	}, repo, WithClock(clock))
+	step := manualWheel(t, c, clock, tick)
+	for i := 0; i < keys; i++ {
+		if err := c.Set(ctx, fmt.Sprintf("k%d", i), "v", ttl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	loaded := len(repo.records())
+
+	// 每格到期的键数，键应当分散在 [ttl-10%, ttl+10%] 的 20 格中
+	perTick := make(map[time.Duration]int)
+	for elapsed := tick; elapsed <= ttl+ttl/5; elapsed += tick {
+		if n := len(step(tick)); n > 0 {
+			perTick[elapsed] = n
+		}
+	}
+	window := time.Duration(float64(ttl) * jitter)
+	fired, busiest := 0, 0
+	for elapsed, n := range perTick {
+		if elapsed < ttl-window || elapsed > ttl+window+tick {
+			t.Fatalf("%d keys fired at %v, outside %v±%v", n, elapsed, ttl, window)
+		}
+		fired += n
+		busiest = max(busiest, n)
+	}
+	if fired != keys {
+		t.Fatalf("%d keys fired, want %d", fired, keys)
+	}
+	if ticks := int(2 * window / tick); len(perTick) < ticks*3/4 || busiest > 2*keys/ticks {
+		t.Fatalf("expirations not spread: %d ticks used, busiest tick %d keys", len(perTick), busiest)
+	}
+
+	// 每格每个分片最多一条 MDEL，AOF 写入次数远少于键数
+	deletes := repo.records()[loaded:]
+	if limit := len(perTick) * len(c.shards); len(deletes) > limit {
+		t.Fatalf("%d AOF writes for %d expired keys, want at most %d", len(deletes), keys, limit)
+	}
+	deleted := 0
+	for _, command := range deletes {
+		switch command.Op {
+		case AOFMDel:
+			deleted += len(command.Args)
+		case AOFDel:
+			deleted++
+		default:
+			t.Fatalf("unexpected AOF record %v during expiry", command.Op)
+		}
+	}
+	if deleted != keys {
+		t.Fatalf("AOF deletes %d keys, want %d", deleted, keys)
+	}
+}
+
+// BenchmarkSetDuringSweep 1M 个键时 Set 的耗时：没有后台清理、抽样清理或全量扫描反复运行(每轮之间暂停
+// 与默认抽样间隔相同的 100ms)，报告单次 Set 的 p99、p99.9 和最大值。全量扫描只占部分时间，
+// 被它阻塞的 Set 主要体现在 p99.9 和最大值中。键都不过期，只比较扫描本身对写入的影响
+func BenchmarkSetDuringSweep(b *testing.B) {
+	const keys, chunk, sweepPause = 1000000, 10000, defaultExpireSampleInterval
+	if testing.Short() {
+		b.Skip("loads 1M keys")
+	}
+	ctx := context.Background()
+	c := newTestCache(b, &conf.Data_Cache{
+		ExpireSampleInterval: durationpb.New(time.Hour),
+		CleanupInterval:      durationpb.New(time.Hour),
+	}, &discardRepo{})
+	names := make([]string, keys)
+	for i := 0; i < keys; i += chunk {
+		items := make(map[string]string, chunk)
+		for j := i; j < i+chunk; j++ {
+			names[j] = fmt.Sprintf("key:%d", j)
+			items[names[j]] = "value"
+		}
+		if _, err := c.MSet(ctx, items, time.Hour, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	for _, bench := range []struct {
+		name  string
+		sweep func()
+	}{
+		{"idle", nil},
+		{"sampled", c.sampleExpired},
+		{"full-scan", func() { c.collectExpiredKeys(ctx) }},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			stop := make(chan struct{})
+			var wg sync.WaitGroup
+			if bench.sweep != nil {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for {
+						bench.sweep()
+						select {
+						case <-stop:
+							return
+						case <-time.After(sweepPause):
+						}
+					}
+				}()
+			}
+			latencies := make([]time.Duration, b.N)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				start := time.Now()
+				if err := c.Set(ctx, names[i%keys], "value", time.Hour); err != nil {
+					b.Fatal(err)
+				}
+				latencies[i] = time.Since(start)
+			}
+			b.StopTimer()
+			close(stop)
+			wg.Wait()
+			slices.Sort(latencies)
+			b.ReportMetric(float64(latencies[b.N*99/100]), "p99-ns")
+			b.ReportMetric(float64(latencies[b.N*999/1000]), "p99.9-ns")
+			b.ReportMetric(float64(latencies[b.N-1]), "max-ns")
+		})
+	}
+}
+
+// BenchmarkExpireSweep 一次后台清理的耗时：全量扫描(collectExpiredKeys)随键数线性增长，
+// 抽样清理(sampleExpired)每个分片只抽查固定数量的键，与键数无关。键都带 TTL 但未过期
+func BenchmarkExpireSweep(b *testing.B) {
+	const chunk = 10000
+	ctx := context.Background()
+	for _, keys := range []int{10000, 100000, 1000000} {
+		b.Run(fmt.Sprintf("keys=%d", keys), func(b *testing.B) {
+			if keys > 100000 && testing.Short() {
+				b.Skip("loads 1M keys")
+			}
+			c := newTestCache(b, &conf.Data_Cache{
+				ExpireSampleInterval: durationpb.New(time.Hour),
+				CleanupInterval:      durationpb.New(time.Hour),
+			}, &discardRepo{})
+			for i := 0; i < keys; i += chunk {
+				items := make(map[string]string, chunk)
+				for j := i; j < i+chunk; j++ {
+					items[fmt.Sprintf("key:%d", j)] = "value"
+				}
+				if _, err := c.MSet(ctx, items, time.Hour, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.Run("full-scan", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					c.collectExpiredKeys(ctx)
+				}
+			})
+			b.Run("sampled", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					c.sampleExpired()
+				}
+			})
+		})
+	}
+}
+
+// Get 惰性删除过期键时写入 DEL 并移除时间轮中的定时项。重启时即使时钟回到过期之前，
+// 重放到 DEL 后键也不会重新出现
+func TestLazyExpireOnGetSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	start := newMilliClock()
+	clock := NewFakeClock(start.Now())
+	repo := &memRepo{}
+	// 时间轮在测试期间不会转动，键只能由 Get 删除
+	cfg := &conf.Data_Cache{TimeWheelTick: durationpb.New(time.Hour)}
+	c := newTestCache(t, cfg, repo, WithClock(clock))
+	if err := c.Set(ctx, "short", "v", time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Set(ctx, "long", "v", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(2 * time.Second)
+	if _, err := c.Get(ctx, "short"); err != ErrKeyNotFound {
+		t.Fatalf("Get(short) after expiry err = %v, want ErrKeyNotFound", err)
+	}
+	if n, _ := c.DBSize(ctx); n != 1 {
+		t.Fatalf("DBSize after lazy expiry = %d, want 1", n)
+	}
+	records := repo.records()
+	if last := records[len(records)-1]; last.Op != AOFDel || last.Key != "short" {
+		t.Fatalf("last AOF record = %v, want DEL short", last)
+	}
+	c.timeWheel.mutex.Lock()
+	_, scheduled := c.timeWheel.positions["short"]
+	c.timeWheel.mutex.Unlock()
+	if scheduled {
+		t.Fatal("lazily expired key still scheduled on the time wheel")
+	}
+
+	for name, restartClock := range map[string]*FakeClock{
+		"after expiry":     clock,
+		"clock moved back": NewFakeClock(start.Now()),
+	} {
+		restarted := newTestCache(t, cfg, repo, WithClock(restartClock))
+		if _, err := restarted.Get(ctx, "short"); err != ErrKeyNotFound {
+			t.Fatalf("Get(short) after restart with the clock %s err = %v, want ErrKeyNotFound", name, err)
+		}
+		if _, err := restarted.Get(ctx, "long"); err != nil {
+			t.Fatalf("Get(long) after restart with the clock %s: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package biz
+
+import (
+	"context"
+)
+
+// defaultExportBatchSize 未指定时 Export 每批输出的键数
+const defaultExportBatchSize = 512
+
+// ExportEntry Export 输出、Import 读入的一个键，与 AOF 和快照的文件格式无关。
+// Hash 非 nil 时是哈希键，List 非空时是列表键，否则是字符串键；固定状态和版本号不导出
+type ExportEntry struct {
+	Key   string
+	Value string
+	Hash  map[string]string
+	List  []string
+	// ExpiresAt 过期时间(Unix 毫秒)，0 表示永不过期
+	ExpiresAt int64
+	// EventTime 事件时间(Unix 毫秒)，0 表示未知，导入时使用导入的时间
+	EventTime int64
+}
+
+// ImportResult Import 的计数
+type ImportResult struct {
+	// Imported 写入的键数，包括覆盖已有键的写入
+	Imported int
+	// Skipped 因已过期、(合并模式下)键已存在或(覆盖模式下)已有键的事件时间更新而跳过的键数
+	Skipped int
+	// Overwritten 覆盖已有键的写入数，同时计入 Imported
+	Overwritten int
+}
+
+// Export 逐个分片导出所有未过期的键，每批不超过 batchSize 个键(<= 0 时使用默认值)交给 emit。
+// 每个分片只在复制条目时持有读锁，emit 在锁外调用，导出期间不会冻结整个缓存，
+// 但不同分片的复制时刻不同，结果不是某一时刻的一致快照。emit 或 ctx 返回错误时停止
+func (c *GoCacheUsecase) Export(ctx context.Context, batchSize int, emit func(entries []ExportEntry) error) error {
+	c.log.WithContext(ctx).Infof("export batchSize:%d", batchSize)
+	if batchSize <= 0 {
+		batchSize = defaultExportBatchSize
+	}
+	for i := range c.shards {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		items := c.copyShard(i)
+		batch := make([]ExportEntry, 0, min(batchSize, len(items)))
+		for key, item := range items {
+			value, err := item.value()
+			if err != nil {
+				return err
+			}
+			batch = append(batch, ExportEntry{Key: key, Value: value, Hash: item.Hash, List: item.List, ExpiresAt: item.ExpiresAt, EventTime: item.EventTime})
+			if len(batch) == batchSize {
+				if err := emit(batch); err != nil {
+					return err
+				}
+				batch = make([]ExportEntry, 0, batchSize)
+			}
+		}
+		if len(batch) > 0 {
+			if err := emit(batch); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// copyShard 在读锁下复制分片中未过期的条目。哈希和列表写入后不再原地修改，可以在锁外读取
+func (c *GoCacheUsecase) copyShard(index int) map[string]CacheItem {
+	shard := &c.shards[index]
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	now := c.clock.Now().UnixMilli()
+	items := make(map[string]CacheItem, len(shard.active.Data))
+	for key, item := range shard.active.Data {
+		if !item.expired(now) {
+			items[key] = item
+		}
+	}
+	return items
+}
+
+// Import 写入 Export 导出的键并记录到 AOF，重启后仍然存在。replace 为 true 时覆盖已存在的键，
+// 否则保留已有的键并跳过；覆盖时与 SetIfNewer 相同，已有键的事件时间不早于导入键的事件时间时也跳过，
+// 导入时已过期的键同样跳过。剩余 TTL 按 ExpiresAt 计算并登记时间轮。
+// 分片已满且无法淘汰时停止并返回 ErrCacheFull，之前导入的键保留，计数包括它们
+func (c *GoCacheUsecase) Import(ctx context.Context, entries []ExportEntry, replace bool) (ImportResult, error) {
+	c.log.WithContext(ctx).Infof("import keys:%d,replace:%v", len(entries), replace)
+	var result ImportResult
+	if err := c.writable(); err != nil {
+		return result, err
+	}
+	for _, e := range entries {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		overwritten, imported, err := c.importEntry(ctx, e, replace)
+		if err != nil {
+			return result, err
+		}
+		switch {
+		case !imported:
+			result.Skipped++
+		case overwritten:
+			result.Imported++
+			result.Overwritten++
+		default:
+			result.Imported++
+		}
+	}
+	return result, nil
+}
+
+// importEntry 写入一个导入的键，返回是否覆盖了已有的键以及是否写入
+func (c *GoCacheUsecase) importEntry(ctx context.Context, e ExportEntry, replace bool) (overwritten, imported bool, err error) {
+	now := c.clock.Now()
+	if e.ExpiresAt > 0 && e.ExpiresAt <= now.UnixMilli() {
+		return false, false, nil
+	}
+	entry := CacheItem{ExpiresAt: e.ExpiresAt, EventTime: e.EventTime}
+	if entry.EventTime <= 0 {
+		entry.EventTime = now.UnixMilli()
+	}
+	switch {
+	case e.Hash != nil:
+		entry.Hash = e.Hash
+	case len(e.List) > 0:
+		entry.List = e.List
+	default:
+		entry.Value = e.Value
+	}
+	shard := c.getShard(e.Key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return false, false, err
+	}
+	defer shard.mu.Unlock()
+	if old, exists := shard.active.Data[e.Key]; exists && !old.expired(now.UnixMilli()) {
+		if !replace || (e.EventTime > 0 && old.EventTime >= e.EventTime) {
+			return false, false, nil
+		}
+		overwritten = true
+	}
+	if err := c.putLocked(ctx, shard.active, e.Key, entry, c.ttlOf(entry)); err != nil {
+		return false, false, err
+	}
+	c.counters.sets.Add(1)
+	return overwritten, true, nil
+}
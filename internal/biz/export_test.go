@@ -0,0 +1,134 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// exportAll 导出 c 的全部键，检查每批不超过 batchSize
+func exportAll(t *testing.T, c *GoCacheUsecase, batchSize int) map[string]ExportEntry {
+	t.Helper()
+	keyspace := make(map[string]ExportEntry)
+	err := c.Export(context.Background(), batchSize, func(entries []ExportEntry) error {
+		if len(entries) == 0 || len(entries) > batchSize {
+			t.Fatalf("batch of %d entries, want 1..%d", len(entries), batchSize)
+		}
+		for _, e := range entries {
+			if _, dup := keyspace[e.Key]; dup {
+				t.Fatalf("%s exported twice", e.Key)
+			}
+			keyspace[e.Key] = e
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	return keyspace
+}
+
+// importAll 把 keyspace 分批导入 c
+func importAll(t *testing.T, c *GoCacheUsecase, keyspace map[string]ExportEntry, replace bool) ImportResult {
+	t.Helper()
+	var entries []ExportEntry
+	for _, e := range keyspace {
+		entries = append(entries, e)
+	}
+	var total ImportResult
+	for len(entries) > 0 {
+		n := min(len(entries), 7)
+		result, err := c.Import(context.Background(), entries[:n], replace)
+		if err != nil {
+			t.Fatalf("Import: %v", err)
+		}
+		total.Imported += result.Imported
+		total.Skipped += result.Skipped
+		total.Overwritten += result.Overwritten
+		entries = entries[n:]
+	}
+	return total
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	clock := NewFakeClock(time.Now())
+	src := newTestCache(t, nil, nil, WithClock(clock))
+	if err := src.Set(ctx, "gone", "v", time.Second); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(2 * time.Second)
+	for i := 0; i < 40; i++ {
+		ttl := time.Duration(0)
+		if i%2 == 0 {
+			ttl = time.Hour
+		}
+		if err := src.Set(ctx, fmt.Sprintf("s%d", i), fmt.Sprintf("v%d", i), ttl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := src.HSet(ctx, "h", "f", "x"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.RPush(ctx, "l", "a", "b", "c"); err != nil {
+		t.Fatal(err)
+	}
+
+	exported := exportAll(t, src, 5)
+	if len(exported) != 42 {
+		t.Fatalf("exported %d keys, want 42", len(exported))
+	}
+	if _, ok := exported["gone"]; ok {
+		t.Fatal("exported an expired key")
+	}
+
+	repo := &memRepo{}
+	dst := newTestCache(t, nil, repo, WithClock(clock))
+	if result := importAll(t, dst, exported, false); result != (ImportResult{Imported: 42}) {
+		t.Fatalf("Import = %+v, want 42 imported", result)
+	}
+	if got := exportAll(t, dst, 5); !reflect.DeepEqual(got, exported) {
+		t.Fatalf("keyspace after import differs from the export:\n%v\n%v", got, exported)
+	}
+	// 导入写入了 AOF，重启之后键空间不变
+	restarted := newTestCache(t, nil, repo, WithClock(clock))
+	if got := exportAll(t, restarted, 5); !reflect.DeepEqual(got, exported) {
+		t.Fatalf("keyspace after restart differs from the export:\n%v\n%v", got, exported)
+	}
+}
+
+func TestImportMergeAndReplace(t *testing.T) {
+	ctx := context.Background()
+	clock := NewFakeClock(time.Now())
+	merge := newTestCache(t, nil, nil, WithClock(clock))
+	replace := newTestCache(t, nil, nil, WithClock(clock))
+	for _, c := range []*GoCacheUsecase{merge, replace} {
+		if err := c.Set(ctx, "a", "local", 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 导出的键比已有的键更新，覆盖模式下替换它
+	clock.Advance(time.Millisecond)
+	src := newTestCache(t, nil, nil, WithClock(clock))
+	for _, key := range []string{"a", "b"} {
+		if err := src.Set(ctx, key, "exported", 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exported := exportAll(t, src, 10)
+
+	if result := importAll(t, merge, exported, false); result != (ImportResult{Imported: 1, Skipped: 1}) {
+		t.Fatalf("merge Import = %+v, want 1 imported and 1 skipped", result)
+	}
+	if v, _ := merge.Get(ctx, "a"); v != "local" {
+		t.Fatalf("merge overwrote a with %q", v)
+	}
+	if result := importAll(t, replace, exported, true); result != (ImportResult{Imported: 2, Overwritten: 1}) {
+		t.Fatalf("replace Import = %+v, want 2 imported and 1 overwritten", result)
+	}
+	if v, _ := replace.Get(ctx, "a"); v != "exported" {
+		t.Fatalf("replace left a as %q", v)
+	}
+}
@@ -0,0 +1,128 @@
+package biz
+
+import (
+	"context"
+	"strings"
+)
+
+// FlushAll 删除所有键并清空 AOF，返回删除的键数。includePinned 为 false 时保留被固定的键，
+// 它们在 AOF 清空后按分片以 LOAD 记录重新写入，重启和从节点上同样保留。
+// 按顺序持有全部分片的写锁直到 AOF 写完，并发的读要么看到旧值，要么看到清空后的结果
+func (c *GoCacheUsecase) FlushAll(ctx context.Context, includePinned bool) (int, error) {
+	c.log.WithContext(ctx).Infof("flush all includePinned:%v", includePinned)
+	if err := c.writable(); err != nil {
+		return 0, err
+	}
+	if c.flushDisabled {
+		return 0, ErrFlushDisabled
+	}
+	return c.flushAll(ctx, includePinned)
+}
+
+// flushAll 清空键和 AOF，不检查 disable_flush。从节点全量同步时也使用，此时 includePinned 为 true，
+// 主节点保留的固定键随后以 LOAD 记录同步过来
+func (c *GoCacheUsecase) flushAll(ctx context.Context, includePinned bool) (int, error) {
+	// 与重写、快照互斥：它们会在持有仓库锁时逐个获取分片锁
+	c.rewrite.mu.Lock()
+	defer c.rewrite.mu.Unlock()
+	for i := range c.shards {
+		c.shards[i].mu.Lock()
+	}
+	defer func() {
+		for i := range c.shards {
+			c.shards[i].mu.Unlock()
+		}
+	}()
+	if err := c.repo.Truncate(ctx); err != nil {
+		return 0, err
+	}
+	now := c.clock.Now().UnixMilli()
+	kept := make([]map[string]CacheItem, len(c.shards))
+	flushed := 0
+	for i := range c.shards {
+		for key, entry := range c.shards[i].active.Data {
+			if !includePinned && entry.Pinned && !entry.expired(now) {
+				if kept[i] == nil {
+					kept[i] = make(map[string]CacheItem)
+				}
+				kept[i][key] = entry
+				continue
+			}
+			flushed++
+		}
+		c.shards[i].keys.Store(0)
+		c.shards[i].active = c.newBuffer(&c.shards[i].keys, make(map[string]CacheItem))
+	}
+	c.usedBytes.Store(0)
+	c.pinned.keys.Store(0)
+	c.pinned.bytes.Store(0)
+	c.timeWheel.Reset()
+	c.rewrite.baseSize.Store(0)
+	for i, items := range kept {
+		if len(items) == 0 {
+			continue
+		}
+		for key, entry := range items {
+			c.shards[i].active.set(key, entry)
+			if entry.ExpiresAt > 0 {
+				c.timeWheel.Add(key, c.untilMilli(entry.ExpiresAt))
+			}
+		}
+		if err := c.repo.Write(ctx, AOFCommand{Op: AOFLoad, Items: items}); err != nil {
+			return flushed, err
+		}
+	}
+	return flushed, nil
+}
+
+// FlushByPrefix 删除所有以 prefix 开头的键，返回删除的未过期键数。includePinned 为 false 时跳过被固定的键。
+// 逐个分片持有写锁，每个分片删除的键在释放锁之前以一条 MDEL 记录写入 AOF，
+// 因此不是原子的：执行期间写入的匹配键可能保留。prefix 不能为空，清空全部键应使用 FlushAll
+func (c *GoCacheUsecase) FlushByPrefix(ctx context.Context, prefix string, includePinned bool) (int, error) {
+	c.log.WithContext(ctx).Infof("flush by prefix:%s,includePinned:%v", prefix, includePinned)
+	if err := c.writable(); err != nil {
+		return 0, err
+	}
+	if c.flushDisabled {
+		return 0, ErrFlushDisabled
+	}
+	if prefix == "" {
+		return 0, ErrInvalidPattern
+	}
+	flushed := 0
+	for i := range c.shards {
+		if err := ctx.Err(); err != nil {
+			return flushed, err
+		}
+		n, err := c.flushShardByPrefix(ctx, &c.shards[i], prefix, includePinned)
+		flushed += n
+		if err != nil {
+			return flushed, err
+		}
+	}
+	return flushed, nil
+}
+
+func (c *GoCacheUsecase) flushShardByPrefix(ctx context.Context, shard *cacheShard, prefix string, includePinned bool) (int, error) {
+	if err := c.lockShard(ctx, shard); err != nil {
+		return 0, err
+	}
+	defer shard.mu.Unlock()
+	now := c.clock.Now().UnixMilli()
+	flushed := 0
+	command := AOFCommand{Op: AOFMDel}
+	for key, entry := range shard.active.Data {
+		if !strings.HasPrefix(key, prefix) || (entry.Pinned && !includePinned && !entry.expired(now)) {
+			continue
+		}
+		if !entry.expired(now) {
+			flushed++
+		}
+		c.removeLocked(shard.active, key)
+		command.Args = append(command.Args, key)
+	}
+	if len(command.Args) == 0 {
+		return 0, nil
+	}
+	return flushed, c.repo.Write(ctx, command)
+}
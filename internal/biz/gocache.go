@@ -2,102 +2,716 @@ package biz
 
 import (
 	"context"
-	"encoding/gob"
-	"errors"
+	"fmt"
 	"hash/fnv"
-	"io"
+	"math"
+	"math/rand"
+	"net/http"
 	"os"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	v1 "gocache-service/api/cache/v1"
+	"gocache-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 var (
-	ErrKeyNotFound = errors.New("cache: key not found")
+	// ErrKeyNotFound 键不存在或已过期。空字符串是合法的值，不会返回该错误。
+	ErrKeyNotFound = errors.NotFound(v1.ErrorReason_KEY_NOT_FOUND.String(), "cache: key not found")
+	// ErrKeyPinned 键以忽略 TTL 的方式被固定，不能修改其过期时间
+	ErrKeyPinned = errors.Conflict(v1.ErrorReason_KEY_PINNED.String(), "cache: key is pinned with ttl override")
+	// ErrNotAnInteger 值不是合法的 int64 或自增后溢出
+	ErrNotAnInteger = errors.BadRequest(v1.ErrorReason_NOT_AN_INTEGER.String(), "cache: value is not an integer or out of range")
+	// ErrInvalidPattern glob 模式中的方括号未闭合或以转义符结尾
+	ErrInvalidPattern = errors.BadRequest(v1.ErrorReason_INVALID_PATTERN.String(), "cache: invalid key pattern")
+	// ErrCacheFull 键数或估算内存占用已达上限且淘汰策略无法腾出空间
+	ErrCacheFull = errors.New(http.StatusTooManyRequests, v1.ErrorReason_CACHE_FULL.String(), "cache: max keys or max bytes reached")
+	// ErrAOFUnavailable 命令未能进入 AOF 写入队列或最近一次落盘失败，内存中的修改已生效但可能没有持久化
+	ErrAOFUnavailable = errors.ServiceUnavailable(v1.ErrorReason_AOF_UNAVAILABLE.String(), "cache: aof write failed")
+	// ErrNotUTF8 值不是合法的 UTF-8，不能通过字符串接口返回，应使用 GetBytes
+	ErrNotUTF8 = errors.BadRequest(v1.ErrorReason_NOT_UTF8.String(), "cache: value is not valid utf-8, use GetBytes")
+	// ErrWrongType 对键执行了与其类型(字符串、哈希、列表)不符的操作
+	ErrWrongType = errors.BadRequest(v1.ErrorReason_WRONG_TYPE.String(), "cache: operation against a key holding the wrong kind of value")
+	// ErrFlushDisabled 配置了 disable_flush，FlushAll 和 FlushByPrefix 被拒绝
+	ErrFlushDisabled = errors.Forbidden(v1.ErrorReason_FLUSH_DISABLED.String(), "cache: flush is disabled by config")
+	// ErrReadOnlyReplica 配置了 replica_of 的从节点只接受主节点复制的写入
+	ErrReadOnlyReplica = errors.Forbidden(v1.ErrorReason_READ_ONLY_REPLICA.String(), "cache: instance is a read-only replica")
+	// ErrUnknownCommand Pipeline 中的命令不是 SET、DEL 或 EXPIRE
+	ErrUnknownCommand = errors.BadRequest(v1.ErrorReason_UNKNOWN_COMMAND.String(), "cache: unknown pipeline command")
+	// ErrValueTooLarge Append 之后的值会超过 max_value_size
+	ErrValueTooLarge = errors.BadRequest(v1.ErrorReason_VALUE_TOO_LARGE.String(), "cache: value would exceed max_value_size")
+	// ErrInvalidTTL Touch 与 GetAndTouch 的 ttl 必须大于 0
+	ErrInvalidTTL = errors.BadRequest(v1.ErrorReason_INVALID_TTL.String(), "cache: ttl must be positive")
+	// ErrHotKeysDisabled 没有开启 hot_keys，TopKeys 没有数据
+	ErrHotKeysDisabled = errors.Forbidden(v1.ErrorReason_HOT_KEYS_DISABLED.String(), "cache: hot key tracking is disabled by config")
+	// ErrExpiresAtPassed SetWithExpiresAt 的过期时间已经过去，写入的键会立即过期
+	ErrExpiresAtPassed = errors.BadRequest(v1.ErrorReason_EXPIRES_AT_PASSED.String(), "cache: expires_at is in the past")
+	// ErrDiskFull AOF 所在文件系统的剩余空间低于 disk_min_free_bytes，disk_full_policy 为 reject
+	ErrDiskFull = errors.ServiceUnavailable(v1.ErrorReason_DISK_FULL.String(), "cache: free disk space below disk_min_free_bytes")
 )
 
 const (
+	// defaultSaveInterval 未配置 cleanup_interval 时全量扫描过期键的间隔
 	defaultSaveInterval = 30 * time.Second
-	numShards           = 32
+	// defaultWheelSlots、defaultWheelTick 未配置时时间轮的槽数和每格时长
+	defaultWheelSlots = 60
+	defaultWheelTick  = time.Second
+	// defaultShards 未配置时的分片数
+	defaultShards = 32
+	// maxShards 分片数上限，每个分片都有自己的 map、锁和统计，再多只会浪费内存
+	maxShards = 1 << 16
+
+	// NoExpiration TTL 查询时表示键永不过期
+	NoExpiration time.Duration = -1
 )
 
 type CacheItem struct {
-	Value     string `json:"value" gob:"value"`
-	ExpiresAt int64  `json:"expires_at" gob:"expires_at"`
+	// Value 可以是任意字节序列，包括 NUL 和非法 UTF-8，AOF 中按原样编码
+	Value string `json:"value" gob:"value"`
+	// Hash 非 nil 时这是一个哈希键，Value 为空。写入后不再原地修改，见 hash.go
+	Hash map[string]string `json:"hash,omitempty" gob:"hash"`
+	// List 非 nil 时这是一个列表键，Value 为空，列表不会为空。同样写入后不再原地修改，见 list.go
+	List []string `json:"list,omitempty" gob:"list"`
+	// Compressed 为 true 时 Value 是 gzip 压缩后的字节，读取时需经 value() 解压，见 compress.go
+	Compressed bool `json:"compressed,omitempty" gob:"compressed"`
+	// ExpiresAt 过期时间(Unix 毫秒)，0 表示永不过期
+	ExpiresAt int64 `json:"expires_at" gob:"expires_at"`
+	// EventTime 写入方提供的事件时间(Unix 毫秒)，普通 Set 使用到达时间
+	EventTime int64 `json:"event_time" gob:"event_time"`
+	// Pinned 被固定的键不会被淘汰，FlushAll 和 FlushByPrefix 只在指定 includePinned 时删除它们
+	Pinned bool `json:"pinned" gob:"pinned"`
+	// PinTTLOverride 固定时忽略 TTL，键永不过期
+	PinTTLOverride bool `json:"pin_ttl_override" gob:"pin_ttl_override"`
+	// Version 键的版本号，新键从 1 开始，每次修改值或过期时间加一，固定、取消固定与 Touch 续期不改变版本号。
+	// 键被删除(包括过期后被清理)后重新从 1 开始，见 SetIfVersion
+	Version uint64 `json:"version" gob:"version"`
+
+	// access 最近一次访问的时间，供 LRU 淘汰使用，条目的各个副本共享同一个时钟
+	access *atomic.Int64
 }
 
 type CacheBuffer struct {
 	Data map[string]CacheItem `json:"data" gob:"data"`
+	// peak 自上次重建以来 Data 的最大键数，Go 的 map 不会缩容，桶数量由它决定
+	peak int
+	// keys 所在分片的键数，分片重建后新的 CacheBuffer 继续使用同一个计数
+	keys *atomic.Int64
+	// used 所有分片共享的估算内存占用(字节)，见 entrySize
+	used *atomic.Int64
+	// pinned 所有分片共享的固定键计数
+	pinned *pinnedCounters
 }
 
-type CacheRepo interface {
-	Write(ctx context.Context, command []interface{}) error
-	GetFile(ctx context.Context) (*os.File, error)
-	CleanupAOF(ctx context.Context, expiredKeys []string) error
+// set 写入条目、记录访问时间并更新峰值键数、分片键数、内存占用与固定键计数，调用方需持有分片写锁
+func (b *CacheBuffer) set(key string, entry CacheItem) {
+	now := nowNano()
+	if entry.access == nil {
+		entry.access = newAccessClock(now)
+	} else {
+		entry.touch(now)
+	}
+	if old, exists := b.Data[key]; exists {
+		b.used.Add(-entrySize(key, old))
+		b.pinned.add(key, old, -1)
+	} else {
+		b.keys.Add(1)
+	}
+	b.used.Add(entrySize(key, entry))
+	b.pinned.add(key, entry, 1)
+	b.Data[key] = entry
+	if n := len(b.Data); n > b.peak {
+		b.peak = n
+	}
+}
+
+// nextVersion 返回键下一次修改后的版本号，已过期但尚未删除的条目同样递增，调用方需持有分片锁
+func (b *CacheBuffer) nextVersion(key string) uint64 {
+	return b.Data[key].Version + 1
+}
+
+// replayedVersion 返回重放记录携带的版本号，不带版本号的记录(旧格式或 MSET)按当前条目递增
+func (b *CacheBuffer) replayedVersion(key string, version uint64) uint64 {
+	if version > 0 {
+		return version
+	}
+	return b.nextVersion(key)
+}
+
+// newBuffer 创建计入 usedBytes、固定键计数和分片键数 keys 的分片缓冲区，data 中已有的条目不会被计入
+func (c *GoCacheUsecase) newBuffer(keys *atomic.Int64, data map[string]CacheItem) *CacheBuffer {
+	return &CacheBuffer{Data: data, keys: keys, used: &c.usedBytes, pinned: &c.pinned}
 }
 
-func (c *GoCacheUsecase) init() {
-	gob.Register(map[string]interface{}{})
-	gob.Register([]interface{}{})
-	gob.Register("")
-	gob.Register(time.Duration(0))
+// remove 删除条目并更新分片键数、内存占用与固定键计数，调用方需持有分片写锁
+func (b *CacheBuffer) remove(key string) {
+	old, exists := b.Data[key]
+	if !exists {
+		return
+	}
+	b.used.Add(-entrySize(key, old))
+	b.pinned.add(key, old, -1)
+	b.keys.Add(-1)
+	delete(b.Data, key)
+}
+
+type CacheRepo interface {
+	// Write 追加一条命令，无法保证持久化时返回 ErrAOFUnavailable
+	Write(ctx context.Context, command AOFCommand) error
+	// Replay 按写入顺序把 AOF 中的命令逐条交给 apply，返回读取的命令数。
+	// 末尾不完整或损坏的记录会被截断丢弃，旧格式中无法转换的记录会被跳过，只有文件无法读取时才返回错误
+	Replay(ctx context.Context, apply func(command AOFCommand)) (int, error)
+	// CleanupAOF 从 AOF 中清除过期键的记录。expiredKeys 在截取旧文件之后调用，返回此时仍可清除记录的键，
+	// 截取之前被重新写入的键不在其中，它们的新记录不会被一并删除
+	CleanupAOF(ctx context.Context, expiredKeys func() []string) error
+	// Close 刷出尚未落盘的命令并关闭 AOF 文件
+	Close(ctx context.Context) error
+	// AOFStats 返回 AOF 文件大小与写入队列中等待的命令数
+	AOFStats(ctx context.Context) (AOFStats, error)
+	// RewriteAOF 用各分片的快照重建 AOF 并返回新文件的大小。snapshot 对每个分片调用一次 emit，
+	// 且必须在持有该分片锁时调用，重写期间的写入会追加在对应分片的快照之后
+	RewriteAOF(ctx context.Context, snapshot func(emit func(items map[string]CacheItem)) error) (int64, error)
+	// SaveSnapshot 把各分片的快照写入快照文件并返回其大小，之后 AOF 只记录快照之后的命令。
+	// snapshot 的约定与 RewriteAOF 相同，重放时快照中的每个分片以一条 LOAD 命令交给 apply
+	SaveSnapshot(ctx context.Context, snapshot func(emit func(items map[string]CacheItem)) error) (int64, error)
+	// Truncate 清空 AOF 与快照，在此之前写入的命令都不会再被重放
+	Truncate(ctx context.Context) error
 }
 
 type GoCacheUsecase struct {
 	repo   CacheRepo
 	log    *log.Helper
-	shards [numShards]struct {
-		active *CacheBuffer
-		mu     sync.RWMutex
-	}
+	shards []cacheShard
+	// shardMask 分片数减一，分片数是 2 的幂，hash & shardMask 即分片序号
+	shardMask uint32
 
-	wg     sync.WaitGroup
-	ticker *time.Ticker
-	stop   chan struct{}
+	wg        sync.WaitGroup
+	ticker    *time.Ticker
+	stop      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
 
 	timeWheel *TimeWheel
+	defrag    defragStats
+	// maxKeys 键数上限，按分片平均分配，0 表示不限制
+	maxKeys int
+	// maxBytes 估算内存占用的上限，所有分片共用，0 表示不限制
+	maxBytes int64
+	// usedBytes 当前的估算内存占用，由各分片的 CacheBuffer 更新
+	usedBytes atomic.Int64
+	// pinned 被固定的键数与字节数，由各分片的 CacheBuffer 更新
+	pinned   pinnedCounters
+	policy   EvictionPolicy
+	eviction evictionStats
+
+	counters  cacheCounters
+	startedAt time.Time
+
+	rewrite aofRewriter
+	// disk 剩余空间的检查，见 disk.go
+	disk diskMonitor
+	// snapshotInterval 定期保存快照的间隔，0 表示只在调用 SaveSnapshot 时保存
+	snapshotInterval time.Duration
+	// flushDisabled 为 true 时拒绝 FlushAll 和 FlushByPrefix
+	flushDisabled bool
+	// compressThreshold 不小于该长度的字符串值压缩保存，0 表示不压缩
+	compressThreshold int64
+	// reloadJitter 重启后时间轮删除重载键的最大延后比例，见 scheduleReloaded
+	reloadJitter float64
+	// ttlJitter 写入时 TTL 随机放大或缩小的最大比例，见 jitterTTL
+	ttlJitter float64
+	// maxValueSize Append 之后的值的最大长度(字节)，0 表示不限制
+	maxValueSize int64
+	// lockTimeout 读写请求等待分片锁的最长时间，0 表示只受请求的 ctx 限制
+	lockTimeout time.Duration
+	slowLog     slowLog
+	// cleanupInterval 全量扫描过期键的间隔，也是一次扫描的最长耗时；fullSweep 为 false 时不做全量扫描
+	cleanupInterval time.Duration
+	fullSweep       bool
+	sampler         expireSampler
+	// skipExpiredAOF 删除过期键时不追加 DEL/MDEL 记录，见 logExpiredLocked
+	skipExpiredAOF bool
+
+	metrics Metrics
+
+	watchers watchHub
+	// replay 启动时重放 AOF 的结果
+	replay replayStats
+	// replication 复制积压缓冲区，replica 只在从节点上非空
+	replication *replicationLog
+	replica     *replicaState
+	// respEnabled 是否启用了 RESP 协议监听，由 server 层设置，只影响 Capabilities
+	respEnabled atomic.Bool
+	// hotKeys 热点键统计，见 hotkey.go
+	hotKeys hotKeyTracker
+	// clock 过期相关的当前时间，默认为真实时间，见 clock.go
+	clock Clock
 }
 
-func NewGoCacheUsecase(repo CacheRepo, logger log.Logger) *GoCacheUsecase {
+// NewGoCacheUsecase 创建缓存并从磁盘恢复数据，metrics 为 nil 时不上报指标。
+// leader 非空时作为从节点运行，启动后持续复制主节点的数据并拒绝本地写入
+func NewGoCacheUsecase(cfg *conf.Data, repo CacheRepo, metrics Metrics, leader LeaderClient, logger log.Logger) (*GoCacheUsecase, func(), error) {
+	return NewGoCacheUsecaseWithOptions(cfg, repo, metrics, leader, logger)
+}
+
+// NewGoCacheUsecaseWithOptions 与 NewGoCacheUsecase 相同，opts 可以替换时钟等依赖，供测试使用。
+// wire 不支持可变参数的 provider，因此单独提供
+func NewGoCacheUsecaseWithOptions(cfg *conf.Data, repo CacheRepo, metrics Metrics, leader LeaderClient, logger log.Logger, opts ...Option) (*GoCacheUsecase, func(), error) {
+	if metrics == nil {
+		metrics = NopMetrics{}
+	}
+	cleanupInterval := cfg.GetCache().GetCleanupInterval().AsDuration()
+	if cleanupInterval <= 0 {
+		cleanupInterval = defaultSaveInterval
+	}
+	replication := newReplicationLog(int(cfg.GetCache().GetReplicationBacklog()))
 	c := &GoCacheUsecase{
-		ticker: time.NewTicker(defaultSaveInterval),
-		stop:   make(chan struct{}),
-		repo:   repo,
-		log:    log.NewHelper(logger),
+		ticker:    time.NewTicker(cleanupInterval),
+		stop:      make(chan struct{}),
+		log:       log.NewHelper(logger),
+		maxKeys:   int(cfg.GetCache().GetMaxKeys()),
+		maxBytes:  cfg.GetCache().GetMaxBytes(),
+		startedAt: time.Now(),
+
+		flushDisabled:     cfg.GetCache().GetDisableFlush(),
+		compressThreshold: cfg.GetCache().GetCompressThreshold(),
+		maxValueSize:      cfg.GetCache().GetMaxValueSize(),
+		lockTimeout:       cfg.GetCache().GetLockTimeout().AsDuration(),
+		cleanupInterval:   cleanupInterval,
+		metrics:           metrics,
+		replication:       replication,
+		clock:             realClock{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if leader != nil {
+		c.replica = &replicaState{leader: leader}
+	}
+	policy, err := ParseEvictionPolicy(cfg.GetCache().GetEvictionPolicy())
+	if err != nil {
+		c.log.Warnf("%v, falling back to %s", err, policy)
+	}
+	c.policy = policy
+	shards, err := ParseShardCount(cfg.GetCache().GetShards())
+	if err != nil {
+		c.log.Warnf("%v, falling back to %d", err, shards)
+	}
+	c.shards = make([]cacheShard, shards)
+	diskPolicy, err := ParseDiskFullPolicy(cfg.GetCache().GetDiskFullPolicy())
+	if err != nil {
+		c.log.Warnf("%v, falling back to %s", err, diskPolicy)
+	}
+	c.disk.policy = diskPolicy
+	c.disk.state = DiskOK
+	c.disk.warnFree = cfg.GetCache().GetDiskWarnFreeBytes()
+	c.disk.minFree = cfg.GetCache().GetDiskMinFreeBytes()
+	c.disk.interval = cfg.GetCache().GetDiskCheckInterval().AsDuration()
+	if c.disk.interval <= 0 {
+		c.disk.interval = defaultDiskCheckInterval
+	}
+	c.repo = &replicatedRepo{CacheRepo: &diskGuardRepo{CacheRepo: repo, disk: &c.disk}, log: replication}
+	c.hotKeys.init(cfg.GetCache(), shards)
+	c.shardMask = uint32(shards - 1)
+	jitter, err := ParseReloadJitter(cfg.GetCache().GetReloadTtlJitter())
+	if err != nil {
+		c.log.Warnf("%v, falling back to %v", err, jitter)
+	}
+	c.reloadJitter = jitter
+	ttlJitter, err := ParseTTLJitter(cfg.GetCache().GetTtlJitter())
+	if err != nil {
+		c.log.Warnf("%v, falling back to %v", err, ttlJitter)
+	}
+	c.ttlJitter = ttlJitter
+	c.fullSweep = cfg.GetCache().GetExpireFullSweep()
+	c.skipExpiredAOF = cfg.GetCache().GetAofSkipExpired()
+	c.sampler.interval = cfg.GetCache().GetExpireSampleInterval().AsDuration()
+	if c.sampler.interval <= 0 {
+		c.sampler.interval = defaultExpireSampleInterval
+	}
+	c.sampler.keys = int(cfg.GetCache().GetExpireSampleKeys())
+	if c.sampler.keys <= 0 {
+		c.sampler.keys = defaultExpireSampleKeys
+	}
+	c.sampler.budget = cfg.GetCache().GetExpireSampleBudget().AsDuration()
+	if c.sampler.budget <= 0 {
+		c.sampler.budget = defaultExpireSampleBudget
+	}
+	c.rewrite.percentage = int64(cfg.GetCache().GetAofRewritePercentage())
+	c.snapshotInterval = cfg.GetCache().GetSnapshotInterval().AsDuration()
+	c.rewrite.minSize = cfg.GetCache().GetAofRewriteMinSize()
+	if c.rewrite.minSize <= 0 {
+		c.rewrite.minSize = defaultRewriteMinSize
 	}
 
 	for i := range c.shards {
-		c.shards[i].active = &CacheBuffer{
-			Data: make(map[string]CacheItem),
-		}
+		c.shards[i].active = c.newBuffer(&c.shards[i].keys, make(map[string]CacheItem))
 	}
 
-	c.timeWheel = NewTimeWheel(60, time.Second, c)
-	c.init()
+	slots, tick := int(cfg.GetCache().GetTimeWheelSlots()), cfg.GetCache().GetTimeWheelTick().AsDuration()
+	if slots <= 0 {
+		slots = defaultWheelSlots
+	}
+	if tick <= 0 {
+		tick = defaultWheelTick
+	}
+	c.timeWheel = NewTimeWheel(slots, tick, c)
+	c.setLockWaitThreshold(cfg.GetCache().GetLockWaitThreshold().AsDuration())
+	c.slowLog.threshold = cfg.GetCache().GetSlowLogThreshold().AsDuration()
 
+	// AOF 无法读取时拒绝启动，否则之后的重写会用不完整的数据覆盖原文件
+	replayed, skipped, err := c.loadFromDisk()
+	if err == nil && cfg.GetCache().GetAofReplayFailFast() && int64(skipped) > cfg.GetCache().GetAofReplayMaxSkipped() {
+		err = fmt.Errorf("cache: skipped %d malformed AOF records, more than aof_replay_max_skipped %d", skipped, cfg.GetCache().GetAofReplayMaxSkipped())
+	}
+	if err != nil {
+		c.timeWheel.Close()
+		c.ticker.Stop()
+		_ = repo.Close(context.Background())
+		return nil, nil, err
+	}
+	c.replay = replayStats{replayed: replayed, skipped: skipped}
+	// 启动时先检查一次剩余空间，空间不足时从第一个请求起就按 disk_full_policy 处理
+	c.checkDisk(context.Background())
 	// 启动后台任务
-	c.loadFromDisk()
+	c.wg.Add(4)
 	go c.startExpirationChecker()
-	return c
+	go c.startDefragmenter()
+	go c.startAOFRewriter()
+	go c.startDiskMonitor()
+	if c.snapshotInterval > 0 {
+		c.wg.Add(1)
+		go c.startSnapshotter()
+	}
+	if c.replica != nil {
+		c.wg.Add(1)
+		go c.startReplica()
+	}
+	if c.hotKeys.enabled.Load() {
+		c.wg.Add(1)
+		go c.startHotKeyWindow()
+	}
+	cleanup := func() {
+		if err := c.Close(context.Background()); err != nil {
+			c.log.Errorf("close cache err: %v", err)
+		}
+	}
+	return c, cleanup, nil
+}
+
+// Close 停止后台过期检查与时间轮，并在返回前把已确认的写入全部刷到 AOF。
+// 可以重复调用，之后的调用直接返回第一次关闭的结果
+func (c *GoCacheUsecase) Close(ctx context.Context) error {
+	c.closeOnce.Do(func() {
+		c.log.WithContext(ctx).Info("closing cache")
+		close(c.stop)
+		c.wg.Wait()
+		c.CloseWatchers()
+		c.CloseReplication()
+		c.closeErr = c.repo.Close(ctx)
+	})
+	return c.closeErr
 }
 
 func (c *GoCacheUsecase) Set(ctx context.Context, key, value string, ttl time.Duration) error {
 	c.log.WithContext(ctx).Infof("set key:%s,value:%s,ttl:%v", key, value, ttl)
+	return c.set(ctx, key, value, ttl)
+}
+
+// SetBytes 写入二进制值，日志中只记录长度
+func (c *GoCacheUsecase) SetBytes(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.log.WithContext(ctx).Infof("setbytes key:%s,len:%d,ttl:%v", key, len(value), ttl)
+	return c.set(ctx, key, string(value), ttl)
+}
+
+// SetWithExpiresAt 写入字符串值，在 expiresAt 这一绝对时间过期，精度为毫秒，不受 ttl_jitter 影响。
+// expiresAt 为零值时永不过期；已经过去(包括落在当前这一毫秒内)时返回 ErrExpiresAtPassed，不写入
+func (c *GoCacheUsecase) SetWithExpiresAt(ctx context.Context, key, value string, expiresAt time.Time) error {
+	c.log.WithContext(ctx).Infof("set key:%s,value:%s,expiresAt:%v", key, value, expiresAt)
+	entry := CacheItem{Value: value, EventTime: c.clock.Now().UnixMilli()}
+	if !expiresAt.IsZero() {
+		entry.ExpiresAt = expiresAt.UnixMilli()
+		if entry.ExpiresAt <= entry.EventTime {
+			return ErrExpiresAtPassed
+		}
+	}
+	return c.setItem(ctx, key, entry, c.ttlOf(entry))
+}
+
+// set 写入字符串值，ctx 已取消或超时时直接返回，不修改内存也不写 AOF；等待分片锁或 AOF 队列期间
+// ctx 结束时返回 ctx.Err()，前者不修改内存，后者内存已写入但 AOF 没有记录，与 ErrAOFUnavailable 相同
+func (c *GoCacheUsecase) set(ctx context.Context, key, value string, ttl time.Duration) error {
+	ttl = c.jitterTTL(ttl)
+	return c.setItem(ctx, key, c.newCacheItem(value, ttl, c.clock.Now().UnixMilli()), ttl)
+}
+
+// setItem 写入 set 或 SetWithExpiresAt 构造的条目，ttl 用于登记时间轮
+func (c *GoCacheUsecase) setItem(ctx context.Context, key string, entry CacheItem, ttl time.Duration) error {
+	if err := c.writable(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	start := time.Now()
+	var wait time.Duration
+	defer func() {
+		d := time.Since(start)
+		c.metrics.ObserveSet(d)
+		c.observeSlow(ctx, "set", key, d, wait)
+	}()
+	// 大值在加锁之前压缩，storeLocked 不会再压缩一次
+	entry = c.compress(entry)
 	shard := c.getShard(key)
-	shard.mu.Lock()
+	var err error
+	if wait, err = c.lockShardWait(ctx, shard); err != nil {
+		return err
+	}
+	defer shard.mu.Unlock()
+	if err := c.putLocked(ctx, shard.active, key, entry, ttl); err != nil {
+		return err
+	}
+	c.counters.sets.Add(1)
+	c.recordAccess(key, hotKeyWrite)
+	return nil
+}
+
+// SetNX 仅当键不存在(或已过期)时写入，返回是否写入成功
+func (c *GoCacheUsecase) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	c.log.WithContext(ctx).Infof("setnx key:%s,value:%s,ttl:%v", key, value, ttl)
+	if err := c.writable(); err != nil {
+		return false, err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return false, err
+	}
 	defer shard.mu.Unlock()
+	if old, exists := shard.active.Data[key]; exists && !old.expired(c.clock.Now().UnixMilli()) {
+		return false, nil
+	}
+	ttl = c.jitterTTL(ttl)
+	if err := c.putLocked(ctx, shard.active, key, c.newCacheItem(value, ttl, c.clock.Now().UnixMilli()), ttl); err != nil {
+		return false, err
+	}
+	c.counters.sets.Add(1)
+	return true, nil
+}
+
+// Incr 把键的值按 int64 加上 delta 并返回新值，键不存在时从 0 开始，保留原有的过期时间
+func (c *GoCacheUsecase) Incr(ctx context.Context, key string, delta int64) (int64, error) {
+	c.log.WithContext(ctx).Infof("incr key:%s,delta:%d", key, delta)
+	if err := c.writable(); err != nil {
+		return 0, err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return 0, err
+	}
+	defer shard.mu.Unlock()
+	entry, err := incrItem(shard.active.Data, key, delta, c.clock.Now().UnixMilli())
+	if err != nil {
+		return 0, err
+	}
+	entry.EventTime = c.clock.Now().UnixMilli()
+	entry.Version = shard.active.nextVersion(key)
+	if err := c.evictLocked(shard.active, key, entrySize(key, entry)); err != nil {
+		return 0, err
+	}
+	shard.active.set(key, entry)
+	c.counters.sets.Add(1)
+	n, err := strconv.ParseInt(entry.Value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return n, c.repo.Write(ctx, AOFCommand{Op: AOFIncr, Key: key, Delta: delta, Version: entry.Version})
+}
+
+// Decr 把键的值减去 delta，语义同 Incr
+func (c *GoCacheUsecase) Decr(ctx context.Context, key string, delta int64) (int64, error) {
+	if delta == math.MinInt64 {
+		return 0, ErrNotAnInteger
+	}
+	return c.Incr(ctx, key, -delta)
+}
+
+// incrItem 计算自增后的条目，不存在或已过期的键从 0 开始，调用方需持有分片写锁
+func incrItem(data map[string]CacheItem, key string, delta int64, now int64) (CacheItem, error) {
+	var current int64
+	entry, exists := data[key]
+	if !exists || entry.expired(now) {
+		entry = CacheItem{}
+	} else if !entry.isString() {
+		return CacheItem{}, ErrWrongType
+	} else {
+		value, err := entry.value()
+		if err != nil {
+			return CacheItem{}, err
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return CacheItem{}, ErrNotAnInteger
+		}
+		current = n
+	}
+	if (delta > 0 && current > math.MaxInt64-delta) || (delta < 0 && current < math.MinInt64-delta) {
+		return CacheItem{}, ErrNotAnInteger
+	}
+	entry.Value = strconv.FormatInt(current+delta, 10)
+	entry.Compressed = false
+	return entry, nil
+}
+
+// SetIfNewer 按事件时间进行最后写入者胜出的 Set：仅当键不存在或已存储的事件时间
+// 早于 eventTime 时写入。返回是否写入以及最终胜出的事件时间。
+func (c *GoCacheUsecase) SetIfNewer(ctx context.Context, key, value string, ttl time.Duration, eventTime int64) (bool, int64, error) {
+	c.log.WithContext(ctx).Infof("set if newer key:%s,value:%s,ttl:%v,eventTime:%d", key, value, ttl, eventTime)
+	if err := c.writable(); err != nil {
+		return false, 0, err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return false, 0, err
+	}
+	defer shard.mu.Unlock()
+	if old, exists := shard.active.Data[key]; exists && !old.expired(c.clock.Now().UnixMilli()) && old.EventTime >= eventTime {
+		return false, old.EventTime, nil
+	}
+	ttl = c.jitterTTL(ttl)
+	if err := c.putLocked(ctx, shard.active, key, c.newCacheItem(value, ttl, eventTime), ttl); err != nil {
+		return false, 0, err
+	}
+	c.counters.sets.Add(1)
+	return true, eventTime, nil
+}
+
+// SetIfVersion 乐观并发控制的 Set：仅当键当前的版本号等于 expectedVersion 时写入，
+// expectedVersion 为 0 表示键必须不存在(或已过期)。返回是否写入，写入时返回新的版本号，
+// 未写入时返回当前的版本号(键不存在时为 0)。版本号只在键存续期间递增，键被删除后重新创建会再次从 1 开始，
+// 持有删除前版本号的调用方可能因此误判(ABA)，需要区分时应结合值本身比较
+func (c *GoCacheUsecase) SetIfVersion(ctx context.Context, key, value string, expectedVersion uint64, ttl time.Duration) (bool, uint64, error) {
+	c.log.WithContext(ctx).Infof("set if version key:%s,value:%s,expectedVersion:%d,ttl:%v", key, value, expectedVersion, ttl)
+	if err := c.writable(); err != nil {
+		return false, 0, err
+	}
+	if err := ctx.Err(); err != nil {
+		return false, 0, err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return false, 0, err
+	}
+	defer shard.mu.Unlock()
+	now := c.clock.Now().UnixMilli()
+	var current uint64
+	if old, exists := shard.active.Data[key]; exists && !old.expired(now) {
+		current = old.Version
+	}
+	if current != expectedVersion {
+		return false, current, nil
+	}
+	ttl = c.jitterTTL(ttl)
+	entry, err := c.storeLocked(shard.active, key, c.newCacheItem(value, ttl, now), ttl)
+	if err != nil {
+		return false, 0, err
+	}
+	c.counters.sets.Add(1)
+	return true, entry.Version, c.repo.Write(ctx, EntryRecord(key, entry))
+}
+
+// jitterTTL 把写入时的 ttl 随机放大或缩小最多 ttlJitter 倍，同一批写入的键不会在同一时刻过期。
+// ttl <= 0(永不过期)时原样返回
+func (c *GoCacheUsecase) jitterTTL(ttl time.Duration) time.Duration {
+	if c.ttlJitter == 0 || ttl <= 0 {
+		return ttl
+	}
+	jittered := ttl + time.Duration((2*rand.Float64()-1)*c.ttlJitter*float64(ttl))
+	if jittered <= 0 {
+		return ttl
+	}
+	return jittered
+}
+
+// newCacheItem 根据 ttl 计算过期时间构造条目，ttl <= 0 表示永不过期
+func (c *GoCacheUsecase) newCacheItem(value string, ttl time.Duration, eventTime int64) CacheItem {
 	entry := CacheItem{
-		Value: value,
+		Value:     value,
+		EventTime: eventTime,
 	}
 	if ttl > 0 {
-		entry.ExpiresAt = time.Now().Add(ttl).Unix()
+		entry.ExpiresAt = c.clock.Now().Add(ttl).UnixMilli()
 	}
+	return entry
+}
+
+// isString 判断条目是否为字符串键，字符串操作遇到哈希或列表键时返回 ErrWrongType
+func (item CacheItem) isString() bool {
+	return item.Hash == nil && item.List == nil
+}
 
-	shard.active.Data[key] = entry
+// expired 判断条目在 now(Unix 毫秒)时是否已过期，ExpiresAt 为 0 表示永不过期
+func (item CacheItem) expired(now int64) bool {
+	return item.ExpiresAt > 0 && item.ExpiresAt <= now
+}
+
+// putLocked 写入条目、注册时间轮并追加 SET 记录，调用方需持有分片写锁。
+// 返回 ErrAOFUnavailable 时内存中已写入
+func (c *GoCacheUsecase) putLocked(ctx context.Context, buf *CacheBuffer, key string, entry CacheItem, ttl time.Duration) error {
+	entry, err := c.storeLocked(buf, key, entry, ttl)
+	if err != nil {
+		return err
+	}
+	return c.repo.Write(ctx, EntryRecord(key, entry))
+}
+
+// EntryRecord 返回记录整个条目的 AOF 命令：字符串为 SET(压缩的值为 SETZ)，哈希为 HMSET，列表为 LRESTORE。
+// 固定状态不在其中，需要时另外记录 PIN
+func EntryRecord(key string, entry CacheItem) AOFCommand {
+	cmd := AOFCommand{Key: key, ExpiresAt: entry.ExpiresAt, EventTime: entry.EventTime, Version: entry.Version}
+	switch {
+	case entry.Hash != nil:
+		cmd.Op = AOFHMSet
+		cmd.Args = make([]string, 0, 2*len(entry.Hash))
+		for field, value := range entry.Hash {
+			cmd.Args = append(cmd.Args, field, value)
+		}
+	case entry.List != nil:
+		cmd.Op, cmd.Args = AOFLRestore, entry.List
+	case entry.Compressed:
+		cmd.Op, cmd.Value = AOFSetZ, entry.Value
+	default:
+		cmd.Op, cmd.Value = AOFSet, entry.Value
+	}
+	return cmd
+}
+
+// storeLocked 写入条目、递增版本号并注册时间轮，不记录 AOF，返回实际写入的条目，调用方需持有分片写锁。
+// 分片已满且淘汰策略无法腾出空间时返回 ErrCacheFull
+func (c *GoCacheUsecase) storeLocked(buf *CacheBuffer, key string, entry CacheItem, ttl time.Duration) (CacheItem, error) {
+	entry = c.compress(entry)
+	entry.Version = buf.nextVersion(key)
+	if err := c.evictLocked(buf, key, entrySize(key, entry)); err != nil {
+		return CacheItem{}, err
+	}
+	if old, exists := buf.Data[key]; exists {
+		entry = inheritPin(old, entry)
+		if entry.PinTTLOverride {
+			ttl = 0
+		}
+	}
+	buf.set(key, entry)
 	c.timeWheel.Add(key, ttl)
-	_ = c.repo.Write(ctx, []interface{}{"SET", key, value, entry.ExpiresAt})
-	return nil
+	return entry, nil
+}
+
+// removeLocked 删除键并移除它在时间轮中的定时项，调用方需持有分片写锁
+func (c *GoCacheUsecase) removeLocked(buf *CacheBuffer, key string) {
+	buf.remove(key)
+	c.timeWheel.Remove(key)
 }
 
 // fnv32 计算字符串的 FNV-1a 32 位哈希值
@@ -107,108 +721,781 @@ func fnv32(key string) uint32 {
 	return h.Sum32()
 }
 
-// getShard 根据键获取对应的分片
-func (c *GoCacheUsecase) getShard(key string) *struct {
+// cacheShard 一个分片，写入时整体替换 active，读写都需持有 mu
+type cacheShard struct {
 	active *CacheBuffer
-	mu     sync.RWMutex
-} {
-	hash := fnv32(key)
-	index := hash % numShards
-	return &c.shards[index]
+	mu     timedRWMutex
+	// keys 分片内的键数，包括已过期但尚未被删除的键，不加锁即可读取
+	keys atomic.Int64
+}
+
+// ParseShardCount 校验配置中的分片数，0 为 defaultShards；
+// 不是 2 的幂或超过 maxShards 时返回 defaultShards 和错误
+func ParseShardCount(n int32) (int, error) {
+	switch {
+	case n == 0:
+		return defaultShards, nil
+	case n < 0 || n > maxShards || n&(n-1) != 0:
+		return defaultShards, fmt.Errorf("cache: shard count %d is not a power of two between 1 and %d", n, maxShards)
+	default:
+		return int(n), nil
+	}
+}
+
+// ParseTTLJitter 校验 ttl_jitter，必须在 [0, 1) 内，否则缩小后的 TTL 可能为 0；超出时返回 0 和错误
+func ParseTTLJitter(jitter float64) (float64, error) {
+	if jitter < 0 || jitter >= 1 || math.IsNaN(jitter) {
+		return 0, fmt.Errorf("cache: ttl_jitter %v is not in [0, 1)", jitter)
+	}
+	return jitter, nil
+}
+
+// ParseReloadJitter 校验 reload_ttl_jitter，超出 [0, 1] 时返回 0 和错误
+func ParseReloadJitter(jitter float64) (float64, error) {
+	if jitter < 0 || jitter > 1 || math.IsNaN(jitter) {
+		return 0, fmt.Errorf("cache: reload_ttl_jitter %v is not between 0 and 1", jitter)
+	}
+	return jitter, nil
+}
+
+// shardIndex 返回键所在分片的序号
+func (c *GoCacheUsecase) shardIndex(key string) uint32 {
+	return fnv32(key) & c.shardMask
+}
+
+// lockShard 获取分片写锁，最多等待到 ctx 结束或 lockTimeout，超时或取消时返回 ctx.Err()。
+// 无竞争时不创建带超时的 ctx
+func (c *GoCacheUsecase) lockShard(ctx context.Context, shard *cacheShard) error {
+	_, err := c.lockShardWait(ctx, shard)
+	return err
+}
+
+// lockShardWait 与 lockShard 相同，同时返回等待的时间，供慢请求日志使用。无竞争时返回 0，不读时钟
+func (c *GoCacheUsecase) lockShardWait(ctx context.Context, shard *cacheShard) (time.Duration, error) {
+	if shard.mu.TryLock() {
+		return 0, nil
+	}
+	start := time.Now()
+	if c.lockTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.lockTimeout)
+		defer cancel()
+	}
+	err := shard.mu.LockContext(ctx)
+	return time.Since(start), err
+}
+
+// rlockShard 与 lockShard 相同，获取分片读锁
+func (c *GoCacheUsecase) rlockShard(ctx context.Context, shard *cacheShard) error {
+	_, err := c.rlockShardWait(ctx, shard)
+	return err
+}
+
+// rlockShardWait 与 lockShardWait 相同，获取分片读锁
+func (c *GoCacheUsecase) rlockShardWait(ctx context.Context, shard *cacheShard) (time.Duration, error) {
+	if shard.mu.TryRLock() {
+		return 0, nil
+	}
+	start := time.Now()
+	if c.lockTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.lockTimeout)
+		defer cancel()
+	}
+	err := shard.mu.RLockContext(ctx)
+	return time.Since(start), err
+}
+
+// getShard 根据键获取对应的分片
+func (c *GoCacheUsecase) getShard(key string) *cacheShard {
+	return &c.shards[c.shardIndex(key)]
 }
 
 func (c *GoCacheUsecase) Get(ctx context.Context, key string) (string, error) {
 	c.log.WithContext(ctx).Infof("get key:%s", key)
+	start := time.Now()
+	value, _, wait, err := c.get(ctx, key)
+	d := time.Since(start)
+	c.metrics.ObserveGet(err == nil, d)
+	c.observeSlow(ctx, "get", key, d, wait)
+	return value, err
+}
+
+// GetWithVersion 与 Get 相同，同时返回键当前的版本号，供 SetIfVersion 使用
+func (c *GoCacheUsecase) GetWithVersion(ctx context.Context, key string) (string, uint64, error) {
+	c.log.WithContext(ctx).Infof("get with version key:%s", key)
+	start := time.Now()
+	value, version, wait, err := c.get(ctx, key)
+	d := time.Since(start)
+	c.metrics.ObserveGet(err == nil, d)
+	c.observeSlow(ctx, "get", key, d, wait)
+	return value, version, err
+}
+
+// get 读取字符串键，同时返回等待分片读锁的时间
+func (c *GoCacheUsecase) get(ctx context.Context, key string) (string, uint64, time.Duration, error) {
+	if err := ctx.Err(); err != nil {
+		return "", 0, 0, err
+	}
+	shard := c.getShard(key)
+	wait, err := c.rlockShardWait(ctx, shard)
+	if err != nil {
+		return "", 0, wait, err
+	}
+	entry, exists := shard.active.Data[key]
+	shard.mu.RUnlock()
+
+	if !exists {
+		c.counters.misses.Add(1)
+		c.recordAccess(key, hotKeyMiss)
+		return "", 0, wait, ErrKeyNotFound
+	}
+	if entry.expired(c.clock.Now().UnixMilli()) {
+		// 读锁下不能修改分片，改为加写锁重新检查后删除，同时移除时间轮定时项并追加 DEL 记录
+		c.deleteIfExpired(key)
+		c.counters.misses.Add(1)
+		c.recordAccess(key, hotKeyMiss)
+		return "", 0, wait, ErrKeyNotFound
+	}
+	if !entry.isString() {
+		return "", 0, wait, ErrWrongType
+	}
+	c.counters.hits.Add(1)
+	c.recordAccess(key, hotKeyHit)
+	entry.touch(nowNano())
+	value, err := entry.value()
+	return value, entry.Version, wait, err
+}
+
+// GetBytes 以字节切片返回值，返回的切片是副本，调用方可以修改
+func (c *GoCacheUsecase) GetBytes(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(value), nil
+}
+
+func (c *GoCacheUsecase) Delete(ctx context.Context, key string) error {
+	if err := c.writable(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	start := time.Now()
+	var wait time.Duration
+	defer func() {
+		d := time.Since(start)
+		c.metrics.ObserveDelete(d)
+		c.observeSlow(ctx, "delete", key, d, wait)
+	}()
+	shard := c.getShard(key)
+	var err error
+	if wait, err = c.lockShardWait(ctx, shard); err != nil {
+		return err
+	}
+	defer shard.mu.Unlock()
+	if entry, exists := shard.active.Data[key]; exists && !entry.expired(c.clock.Now().UnixMilli()) {
+		c.counters.deletes.Add(1)
+	}
+	c.removeLocked(shard.active, key)
+	return c.repo.Write(ctx, AOFCommand{Op: AOFDel, Key: key})
+}
+
+// CompareAndDelete 仅当键存在且值等于 expectedValue 时删除，返回是否删除
+func (c *GoCacheUsecase) CompareAndDelete(ctx context.Context, key, expectedValue string) (bool, error) {
+	c.log.WithContext(ctx).Infof("compare and delete key:%s", key)
+	if err := c.writable(); err != nil {
+		return false, err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return false, err
+	}
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) || !entry.isString() {
+		return false, nil
+	}
+	if value, err := entry.value(); err != nil || value != expectedValue {
+		return false, err
+	}
+	c.removeLocked(shard.active, key)
+	c.counters.deletes.Add(1)
+	return true, c.repo.Write(ctx, AOFCommand{Op: AOFDel, Key: key})
+}
+
+// CompareAndSwap 在分片写锁下比较并替换：仅当键当前的值等于 expected 时写入 newValue 并追加 SET 记录，
+// 返回是否替换。键不存在、已过期或不是字符串键时不修改并返回 false
+func (c *GoCacheUsecase) CompareAndSwap(ctx context.Context, key, expected, newValue string, ttl time.Duration) (bool, error) {
+	c.log.WithContext(ctx).Infof("compare and swap key:%s,value:%s,ttl:%v", key, newValue, ttl)
+	return c.compareAndSwap(ctx, key, expected, newValue, ttl, false)
+}
+
+// CompareAndSwapMissingAsEmpty 同 CompareAndSwap，但把不存在或已过期的键当作空字符串，
+// 因此 expected 为空时可以创建键
+func (c *GoCacheUsecase) CompareAndSwapMissingAsEmpty(ctx context.Context, key, expected, newValue string, ttl time.Duration) (bool, error) {
+	c.log.WithContext(ctx).Infof("compare and swap key:%s,value:%s,ttl:%v,missing as empty", key, newValue, ttl)
+	return c.compareAndSwap(ctx, key, expected, newValue, ttl, true)
+}
+
+func (c *GoCacheUsecase) compareAndSwap(ctx context.Context, key, expected, newValue string, ttl time.Duration, missingAsEmpty bool) (bool, error) {
+	if err := c.writable(); err != nil {
+		return false, err
+	}
+	ttl = c.jitterTTL(ttl)
+	entry := c.compress(c.newCacheItem(newValue, ttl, c.clock.Now().UnixMilli()))
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return false, err
+	}
+	defer shard.mu.Unlock()
+	old, exists := shard.active.Data[key]
+	if !exists || old.expired(c.clock.Now().UnixMilli()) {
+		if !missingAsEmpty || expected != "" {
+			return false, nil
+		}
+	} else if !old.isString() {
+		return false, nil
+	} else if value, err := old.value(); err != nil || value != expected {
+		return false, err
+	}
+	if err := c.putLocked(ctx, shard.active, key, entry, ttl); err != nil {
+		return false, err
+	}
+	c.counters.sets.Add(1)
+	return true, nil
+}
+
+// GetDel 在分片写锁下读取并删除键，返回删除前的值，键不存在或已过期时返回 ErrKeyNotFound
+func (c *GoCacheUsecase) GetDel(ctx context.Context, key string) (string, error) {
+	c.log.WithContext(ctx).Infof("getdel key:%s", key)
+	if err := c.writable(); err != nil {
+		return "", err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return "", err
+	}
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) {
+		c.counters.misses.Add(1)
+		return "", ErrKeyNotFound
+	}
+	if !entry.isString() {
+		return "", ErrWrongType
+	}
+	value, err := entry.value()
+	if err != nil {
+		return "", err
+	}
+	c.removeLocked(shard.active, key)
+	c.counters.hits.Add(1)
+	c.counters.deletes.Add(1)
+	return value, c.repo.Write(ctx, AOFCommand{Op: AOFDel, Key: key})
+}
+
+// GetSet 在分片写锁下读取键并写入 newValue，返回写入前的值。与 Redis 相同，新值不保留原有的过期时间；
+// 键不存在或已过期时不创建键并返回 ErrKeyNotFound，哈希和列表键返回 ErrWrongType
+func (c *GoCacheUsecase) GetSet(ctx context.Context, key, newValue string) (string, error) {
+	c.log.WithContext(ctx).Infof("getset key:%s,value:%s", key, newValue)
+	if err := c.writable(); err != nil {
+		return "", err
+	}
 	shard := c.getShard(key)
-	shard.mu.RLock()
+	if err := c.lockShard(ctx, shard); err != nil {
+		return "", err
+	}
+	defer shard.mu.Unlock()
+	now := c.clock.Now().UnixMilli()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(now) {
+		c.counters.misses.Add(1)
+		return "", ErrKeyNotFound
+	}
+	if !entry.isString() {
+		return "", ErrWrongType
+	}
+	value, err := entry.value()
+	if err != nil {
+		return "", err
+	}
+	if err := c.putLocked(ctx, shard.active, key, c.newCacheItem(newValue, 0, now), 0); err != nil {
+		return "", err
+	}
+	c.counters.hits.Add(1)
+	c.counters.sets.Add(1)
+	return value, nil
+}
+
+// GetEx 在分片写锁下读取键并把过期时间改为 ttl 之后，新的过期时间以 SET 记录写入 AOF；
+// ttl <= 0 时只读取不修改过期时间。键不存在或已过期时返回 ErrKeyNotFound，被固定且忽略 TTL 的键返回 ErrKeyPinned
+func (c *GoCacheUsecase) GetEx(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	c.log.WithContext(ctx).Infof("getex key:%s,ttl:%v", key, ttl)
+	if ttl > 0 {
+		if err := c.writable(); err != nil {
+			return "", err
+		}
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return "", err
+	}
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) {
+		c.counters.misses.Add(1)
+		return "", ErrKeyNotFound
+	}
+	if !entry.isString() {
+		return "", ErrWrongType
+	}
+	if ttl > 0 && entry.PinTTLOverride {
+		return "", ErrKeyPinned
+	}
+	c.counters.hits.Add(1)
+	if ttl <= 0 {
+		entry.touch(nowNano())
+		return entry.value()
+	}
+	entry.ExpiresAt = c.clock.Now().Add(ttl).UnixMilli()
+	if err := c.putLocked(ctx, shard.active, key, entry, ttl); err != nil {
+		return "", err
+	}
+	return entry.value()
+}
+
+// TTL 返回键的剩余存活时间，永不过期的键返回 NoExpiration
+func (c *GoCacheUsecase) TTL(ctx context.Context, key string) (time.Duration, error) {
+	shard := c.getShard(key)
+	if err := c.rlockShard(ctx, shard); err != nil {
+		return 0, err
+	}
 	defer shard.mu.RUnlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) {
+		return 0, ErrKeyNotFound
+	}
+	if entry.ExpiresAt == 0 {
+		return NoExpiration, nil
+	}
+	return c.untilMilli(entry.ExpiresAt), nil
+}
+
+// Expire 修改已存在键的过期时间，键不存在时返回 false，ttl <= 0 时直接删除该键
+func (c *GoCacheUsecase) Expire(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	c.log.WithContext(ctx).Infof("expire key:%s,ttl:%v", key, ttl)
+	if err := c.writable(); err != nil {
+		return false, err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return false, err
+	}
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) {
+		return false, nil
+	}
+	if entry.PinTTLOverride {
+		return false, ErrKeyPinned
+	}
+	if ttl <= 0 {
+		c.removeLocked(shard.active, key)
+		c.counters.deletes.Add(1)
+		return true, c.repo.Write(ctx, AOFCommand{Op: AOFDel, Key: key})
+	}
+	entry.ExpiresAt = c.clock.Now().Add(ttl).UnixMilli()
+	if err := c.putLocked(ctx, shard.active, key, entry, ttl); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Touch 把键的过期时间重置为 now+ttl，用于滑动过期：每次访问都延长键的生存时间。键不存在或已过期时返回 false，
+// 忽略 TTL 的固定键返回 ErrKeyPinned。与 Expire 不同，续期不改变版本号，读取时续期不会让 SetIfVersion 失败。
+// 时间轮中每个键最多只有一项，重新登记会替换旧的定时项，频繁续期不会让时间轮增长
+func (c *GoCacheUsecase) Touch(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	c.log.WithContext(ctx).Infof("touch key:%s,ttl:%v", key, ttl)
+	if err := c.writable(); err != nil {
+		return false, err
+	}
+	if ttl <= 0 {
+		return false, ErrInvalidTTL
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return false, err
+	}
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) {
+		return false, nil
+	}
+	if entry.PinTTLOverride {
+		return false, ErrKeyPinned
+	}
+	return true, c.touchLocked(ctx, shard.active, key, entry, ttl)
+}
+
+// GetAndTouch 读取字符串键并把它的过期时间重置为 now+ttl，相当于 Get 之后 Touch，整个过程持有分片写锁。
+// 忽略 TTL 的固定键只读取不续期，从节点上返回 ErrReadOnlyReplica
+func (c *GoCacheUsecase) GetAndTouch(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	c.log.WithContext(ctx).Infof("get and touch key:%s,ttl:%v", key, ttl)
+	if err := c.writable(); err != nil {
+		return "", err
+	}
+	if ttl <= 0 {
+		return "", ErrInvalidTTL
+	}
+	start := time.Now()
+	value, err := c.getAndTouch(ctx, key, ttl)
+	c.metrics.ObserveGet(err == nil, time.Since(start))
+	return value, err
+}
 
+func (c *GoCacheUsecase) getAndTouch(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return "", err
+	}
+	defer shard.mu.Unlock()
 	entry, exists := shard.active.Data[key]
 	if !exists {
+		c.counters.misses.Add(1)
 		return "", ErrKeyNotFound
 	}
-	expiry := entry.ExpiresAt
-	if expiry < time.Now().Unix() {
-		delete(shard.active.Data, key)
+	if entry.expired(c.clock.Now().UnixMilli()) {
+		c.reapLocked(shard.active, key)
+		c.counters.misses.Add(1)
 		return "", ErrKeyNotFound
 	}
-	return entry.Value, nil
+	if !entry.isString() {
+		return "", ErrWrongType
+	}
+	c.counters.hits.Add(1)
+	value, err := entry.value()
+	if err != nil {
+		return "", err
+	}
+	if entry.PinTTLOverride {
+		entry.touch(nowNano())
+		return value, nil
+	}
+	return value, c.touchLocked(ctx, shard.active, key, entry, ttl)
 }
 
-func (c *GoCacheUsecase) Delete(ctx context.Context, key string) error {
+// touchLocked 把 entry 的过期时间重置为 now+ttl 后写回，重新登记时间轮并追加 SET 记录，调用方需持有分片写锁
+func (c *GoCacheUsecase) touchLocked(ctx context.Context, buf *CacheBuffer, key string, entry CacheItem, ttl time.Duration) error {
+	entry.ExpiresAt = c.clock.Now().Add(ttl).UnixMilli()
+	buf.set(key, entry)
+	c.timeWheel.Add(key, ttl)
+	return c.repo.Write(ctx, EntryRecord(key, entry))
+}
+
+// Persist 移除键的过期时间使其永不过期，键不存在或本就没有过期时间时返回 false
+func (c *GoCacheUsecase) Persist(ctx context.Context, key string) (bool, error) {
+	c.log.WithContext(ctx).Infof("persist key:%s", key)
+	if err := c.writable(); err != nil {
+		return false, err
+	}
 	shard := c.getShard(key)
-	shard.mu.Lock()
+	if err := c.lockShard(ctx, shard); err != nil {
+		return false, err
+	}
 	defer shard.mu.Unlock()
-	delete(shard.active.Data, key)
-	_ = c.repo.Write(ctx, []interface{}{"DEL", key})
-	return nil
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) || entry.ExpiresAt == 0 {
+		return false, nil
+	}
+	entry.ExpiresAt = 0
+	if err := c.putLocked(ctx, shard.active, key, entry, 0); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
-func (c *GoCacheUsecase) loadFromDisk() {
+// loadFromDisk 重放 AOF 恢复内存数据，返回应用的命令数和因格式错误跳过的命令数。
+// 文件不存在或为空时从空缓存开始，最后一条不完整的记录由 repo 截断
+func (c *GoCacheUsecase) loadFromDisk() (replayed int, skipped int, err error) {
 	ctx := context.Background()
-	file, _ := c.repo.GetFile(ctx)
-	_, err := file.Seek(0, 0)
+	dir, _ := os.Getwd()
+	c.log.WithContext(ctx).Infof("loadFromDisk start!dir:%s", dir)
+	read, err := c.repo.Replay(ctx, func(command AOFCommand) {
+		c.log.WithContext(ctx).Infof("loadFromDisk command:%v", command)
+		if err := c.replayCommand(command); err != nil {
+			c.log.WithContext(ctx).Warnf("loadFromDisk skipped command %v: %v", command, err)
+			skipped++
+		}
+	})
+	replayed = read - skipped
 	if err != nil {
-		return
+		c.log.WithContext(ctx).Errorf("loadFromDisk stopped after %d commands: %v", read, err)
+		return replayed, skipped, err
 	}
-	defer file.Close()
-
-	decoder := gob.NewDecoder(file)
+	scheduled := c.scheduleReloaded()
+	c.log.WithContext(ctx).Infof("loadFromDisk done! replayed %d commands, skipped %d, scheduled %d keys to expire", replayed, skipped, scheduled)
+	return replayed, skipped, nil
+}
 
-	dir, err := os.Getwd()
-	c.log.WithContext(ctx).Infof("loadFromDisk start!dir:%s", dir)
-	for {
-		var command []interface{}
-		err = decoder.Decode(&command)
-		if err != nil {
-			if err == io.EOF {
-				break
+// scheduleReloaded 重放结束后按剩余 TTL 把所有带过期时间的键重新放入时间轮，返回放入的键数。
+// 重放过程中 EXPIRE、INCR 等记录不会更新时间轮，这里以最终的 ExpiresAt 为准。
+// 同一批写入的键过期时间相同，重启后会在同一格一起删除，因此每个键的删除时间随机延后
+// [0, reloadJitter) 倍的剩余 TTL。只延后不提前：时间轮不会在 ExpiresAt 之前删除键，
+// 读取按 ExpiresAt 判断，到期的键在被删除前同样不可见。重放时已过期的键不会进入内存
+func (c *GoCacheUsecase) scheduleReloaded() int {
+	now := c.clock.Now()
+	scheduled := 0
+	for i := range c.shards {
+		shard := &c.shards[i]
+		shard.mu.RLock()
+		for key, entry := range shard.active.Data {
+			if entry.ExpiresAt == 0 {
+				continue
 			}
-			return
-		}
-		c.log.WithContext(ctx).Infof("loadFromDisk command:%v", command)
-		if len(command) == 4 && command[0] == "SET" {
-			key := command[1].(string)
-			value := command[2].(string)
-			expiresAt := command[3].(int64)
-			//等于0是永不过期
-			if expiresAt == 0 || time.Now().Unix() < expiresAt {
-				shard := c.getShard(key)
-				shard.mu.Lock()
-				entry := CacheItem{
-					Value:     value,
-					ExpiresAt: expiresAt,
-				}
-				shard.active.Data[key] = entry
-				shard.mu.Unlock()
-				//todo 随机
-				c.timeWheel.Add(key, 0)
+			ttl := time.UnixMilli(entry.ExpiresAt).Sub(now)
+			if ttl <= 0 {
+				// 重放期间刚好到期，交给下一次 tick
+				ttl = time.Millisecond
+			}
+			if c.reloadJitter > 0 {
+				ttl += time.Duration(rand.Float64() * c.reloadJitter * float64(ttl))
 			}
-		} else if len(command) == 2 && command[0] == "DEL" {
-			key := command[1].(string)
-			shard := c.getShard(key)
-			shard.mu.Lock()
-			delete(shard.active.Data, key)
-			shard.mu.Unlock()
+			c.timeWheel.Add(key, ttl)
+			scheduled++
+		}
+		shard.mu.RUnlock()
+	}
+	return scheduled
+}
+
+// legacySecondsLimit 小于该值的过期时间来自旧版本按 Unix 秒记录的 AOF。
+// 1e11 秒约为公元 5138 年，而 1e11 毫秒是 1973 年，两种单位不会混淆。
+const legacySecondsLimit = 1e11
+
+// Rename 把 oldKey 改名为 newKey，保留值、类型、剩余过期时间和固定状态，newKey 已存在时被覆盖；
+// oldKey 不存在或已过期时返回 ErrKeyNotFound。两个键可能位于不同分片，按分片序号从小到大加锁，
+// 方向相反的两个 Rename 不会互相等待。AOF 中依次记录 DEL oldKey、DEL newKey(仅当它已存在)
+// 和 newKey 的完整条目，固定的键再追加 PIN，重放时 newKey 不会继承被覆盖的旧条目的固定状态
+func (c *GoCacheUsecase) Rename(ctx context.Context, oldKey, newKey string) error {
+	c.log.WithContext(ctx).Infof("rename key:%s,newKey:%s", oldKey, newKey)
+	if err := c.writable(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	unlock, err := c.lockKeys(ctx, []string{oldKey, newKey})
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	oldBuf, newBuf := c.getShard(oldKey).active, c.getShard(newKey).active
+	entry, exists := oldBuf.Data[oldKey]
+	if !exists {
+		return ErrKeyNotFound
+	}
+	if entry.expired(c.clock.Now().UnixMilli()) {
+		c.reapLocked(oldBuf, oldKey)
+		return ErrKeyNotFound
+	}
+	if oldKey == newKey {
+		return nil
+	}
+	c.removeLocked(oldBuf, oldKey)
+	if err := c.evictLocked(newBuf, newKey, entrySize(newKey, entry)); err != nil {
+		// 腾不出空间时放回原来的键，淘汰只会选中其他键
+		oldBuf.set(oldKey, entry)
+		c.timeWheel.Add(oldKey, c.ttlOf(entry))
+		return err
+	}
+	_, replaced := newBuf.Data[newKey]
+	entry.Version = newBuf.nextVersion(newKey)
+	newBuf.set(newKey, entry)
+	c.timeWheel.Add(newKey, c.ttlOf(entry))
+	commands := []AOFCommand{{Op: AOFDel, Key: oldKey}}
+	if replaced {
+		commands = append(commands, AOFCommand{Op: AOFDel, Key: newKey})
+	}
+	commands = append(commands, EntryRecord(newKey, entry))
+	if entry.Pinned {
+		commands = append(commands, AOFCommand{Op: AOFPin, Key: newKey, TTLOverride: entry.PinTTLOverride})
+	}
+	for _, command := range commands {
+		if err := c.repo.Write(ctx, command); err != nil {
+			return err
 		}
 	}
-	c.log.WithContext(ctx).Infof("loadFromDisk done!")
+	return nil
+}
+
+// ttlOf 返回条目的剩余过期时间，用于重新登记时间轮，永不过期时为 0
+func (c *GoCacheUsecase) ttlOf(entry CacheItem) time.Duration {
+	if entry.ExpiresAt == 0 {
+		return 0
+	}
+	return c.untilMilli(entry.ExpiresAt)
+}
+
+// untilMilli 返回按 c.clock 计算距离 expiresAt(Unix 毫秒)的时间，已过去时为负数
+func (c *GoCacheUsecase) untilMilli(expiresAt int64) time.Duration {
+	return time.UnixMilli(expiresAt).Sub(c.clock.Now())
+}
+
+// expiresAtMillis 把 AOF 中的过期时间统一为 Unix 毫秒，兼容旧版本按秒记录的文件
+func expiresAtMillis(expiresAt int64) int64 {
+	if expiresAt > 0 && expiresAt < legacySecondsLimit {
+		return expiresAt * 1000
+	}
+	return expiresAt
+}
+
+// replayCommand 重放一条 AOF 命令，无法识别的命令或参数个数不符时返回错误
+func (c *GoCacheUsecase) replayCommand(cmd AOFCommand) error {
+	switch cmd.Op {
+	case AOFSet, AOFSetZ:
+		c.replaySet(cmd.Key, cmd.Value, expiresAtMillis(cmd.ExpiresAt), cmd.EventTime, cmd.Op == AOFSetZ, cmd.Version)
+	case AOFDel:
+		c.replayDel(cmd.Key)
+	case AOFMSet:
+		if len(cmd.Args)%2 != 0 {
+			return fmt.Errorf("cache: MSET record with %d arguments", len(cmd.Args))
+		}
+		c.replayMSet(expiresAtMillis(cmd.ExpiresAt), cmd.EventTime, cmd.Args)
+	case AOFMDel:
+		for _, key := range cmd.Args {
+			c.replayDel(key)
+		}
+	case AOFIncr:
+		shard := c.getShard(cmd.Key)
+		shard.mu.Lock()
+		// 运行时失败的 INCR 不会写入 AOF，这里失败只可能是日志被截断或改写过，跳过即可
+		if entry, err := incrItem(shard.active.Data, cmd.Key, cmd.Delta, c.clock.Now().UnixMilli()); err == nil {
+			entry.Version = shard.active.replayedVersion(cmd.Key, cmd.Version)
+			shard.active.set(cmd.Key, entry)
+		}
+		shard.mu.Unlock()
+	case AOFHSet, AOFHDel:
+		if len(cmd.Args) != 1 {
+			return fmt.Errorf("cache: %v record with %d fields", cmd.Op, len(cmd.Args))
+		}
+		if cmd.Op == AOFHSet {
+			c.replayHSet(cmd.Key, cmd.Args[0], cmd.Value, cmd.Version)
+		} else {
+			c.replayHDel(cmd.Key, cmd.Args[0], cmd.Version)
+		}
+	case AOFHMSet:
+		if len(cmd.Args)%2 != 0 {
+			return fmt.Errorf("cache: HMSET record with %d arguments", len(cmd.Args))
+		}
+		fields := make(map[string]string, len(cmd.Args)/2)
+		for i := 0; i < len(cmd.Args); i += 2 {
+			fields[cmd.Args[i]] = cmd.Args[i+1]
+		}
+		c.replayHMSet(cmd.Key, expiresAtMillis(cmd.ExpiresAt), cmd.EventTime, fields, cmd.Version)
+	case AOFLPush, AOFRPush:
+		if len(cmd.Args) == 0 {
+			return fmt.Errorf("cache: %v record without values", cmd.Op)
+		}
+		c.replayPush(cmd.Key, cmd.Op == AOFLPush, cmd.Args, cmd.Version)
+	case AOFLPop, AOFRPop:
+		c.replayPop(cmd.Key, cmd.Op == AOFLPop, cmd.Version)
+	case AOFLRestore:
+		// 列表键不会为空，空的 LRESTORE 会把键变成字符串
+		if len(cmd.Args) == 0 {
+			return fmt.Errorf("cache: LRESTORE record without values")
+		}
+		c.replayLRestore(cmd.Key, expiresAtMillis(cmd.ExpiresAt), cmd.EventTime, cmd.Args, cmd.Version)
+	case AOFLoad:
+		c.replayLoad(cmd.Items)
+	case AOFPin:
+		c.replayPin(cmd.Key, true, cmd.TTLOverride)
+	case AOFUnpin:
+		c.replayPin(cmd.Key, false, false)
+	case AOFExpire:
+		c.replayExpire(cmd.Key, expiresAtMillis(cmd.ExpiresAt))
+	case AOFAppend:
+		c.replayAppend(cmd.Key, cmd.Value, cmd.ExpiresAt, cmd.EventTime, cmd.Version)
+	case AOFMulti:
+		return c.replayMulti(cmd.Commands)
+	default:
+		return fmt.Errorf("cache: unknown AOF command %v", cmd.Op)
+	}
+	return nil
+}
+
+// replaySet 重放 SET/SETZ 记录，旧格式中没有事件时间的 SET 记录 eventTime 为 0，没有版本号的记录 version 为 0
+func (c *GoCacheUsecase) replaySet(key, value string, expiresAt, eventTime int64, compressed bool, version uint64) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	//等于0是永不过期
+	if expiresAt != 0 && c.clock.Now().UnixMilli() >= expiresAt {
+		// Expire 会以 SET 记录新的过期时间，已过期的记录必须覆盖之前的值
+		c.removeLocked(shard.active, key)
+		return
+	}
+	// SETZ 记录的值已经压缩；SET 记录按当前的 compress_threshold 压缩
+	entry := c.compress(CacheItem{
+		Value:      value,
+		ExpiresAt:  expiresAt,
+		EventTime:  eventTime,
+		Compressed: compressed,
+		Version:    shard.active.replayedVersion(key, version),
+	})
+	if old, exists := shard.active.Data[key]; exists {
+		entry = inheritPin(old, entry)
+	}
+	shard.active.set(key, entry)
+	if expiresAt > 0 {
+		c.timeWheel.Add(key, c.untilMilli(expiresAt))
+	}
+}
+
+// replayDel 重放 DEL 记录，MDEL 对其中的每个键各重放一次
+func (c *GoCacheUsecase) replayDel(key string) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	c.removeLocked(shard.active, key)
+	shard.mu.Unlock()
 }
 
+// replayExpire 重放 EXPIRE 记录，键不存在时跳过，重放时已过期则删除
+func (c *GoCacheUsecase) replayExpire(key string, expiresAt int64) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	if entry, exists := shard.active.Data[key]; exists {
+		if c.clock.Now().UnixMilli() < expiresAt {
+			entry.ExpiresAt = expiresAt
+			entry.Version++
+			shard.active.set(key, entry)
+		} else {
+			c.removeLocked(shard.active, key)
+		}
+	}
+}
+
+// startExpirationChecker 定期抽样删除过期键；配置了 expire_full_sweep 时另外每隔 cleanup_interval 全量扫描一次
 func (c *GoCacheUsecase) startExpirationChecker() {
-	c.wg.Add(1)
 	defer c.wg.Done()
+	sample := time.NewTicker(c.sampler.interval)
+	defer sample.Stop()
+	var fullSweep <-chan time.Time
+	if c.fullSweep {
+		fullSweep = c.ticker.C
+	}
 	for {
 		select {
-		case <-c.ticker.C:
-			expiredKeys := c.collectExpiredKeys()
-			if len(expiredKeys) > 0 {
-				c.cleanupMemory(expiredKeys)
-				err := c.repo.CleanupAOF(context.Background(), expiredKeys)
-				if err != nil {
-					c.log.WithContext(context.Background()).Errorf("cleanup CleanupAOF err: %v", err)
-				}
-			}
+		case <-sample.C:
+			c.sampleExpired()
+		case <-fullSweep:
+			c.sweepExpired()
 
 		case <-c.stop:
 			c.ticker.Stop()
@@ -218,27 +1505,148 @@ func (c *GoCacheUsecase) startExpirationChecker() {
 	}
 }
 
-// collectExpiredKeys 收集过期的键
-func (c *GoCacheUsecase) collectExpiredKeys() []string {
+// sweepExpired 一次全量扫描：删除过期的键并从 AOF 中清除它们的记录。整次扫描最多耗时 cleanupInterval，
+// 分片锁长时间被占用或磁盘卡住时放弃本次剩余的工作，未处理的键留给下一次扫描。
+// 遍历时持有每个分片的读锁，键很多时会明显阻塞写入，默认关闭，只用于排查问题
+func (c *GoCacheUsecase) sweepExpired() {
+	ctx, cancel := context.WithTimeout(context.Background(), c.cleanupInterval)
+	defer cancel()
+	removed, err := c.cleanupMemory(ctx, c.collectExpiredKeys(ctx))
+	if err != nil {
+		c.log.WithContext(ctx).Warnf("cleanup expired keys stopped after %d keys: %v", len(removed), err)
+	}
+	// 超时后不再清理 AOF，留下的记录都已过期，重放时会被跳过，AOF 重写时丢弃
+	if len(removed) == 0 || ctx.Err() != nil {
+		return
+	}
+	err = c.repo.CleanupAOF(ctx, func() []string { return c.absentKeys(ctx, removed) })
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("cleanup CleanupAOF err: %v", err)
+	}
+}
+
+// absentKeys 返回 keys 中当前不存在的键。清理 AOF 截取旧文件之后调用：删除之后、截取之前又被写入的键，
+// 新的记录已在截取点之前，不能随旧记录一起清除。ctx 结束时只返回已确认的部分
+func (c *GoCacheUsecase) absentKeys(ctx context.Context, keys []string) []string {
+	absent := make([]string, 0, len(keys))
+	for _, key := range keys {
+		shard := c.getShard(key)
+		if c.rlockShard(ctx, shard) != nil {
+			break
+		}
+		_, exists := shard.active.Data[key]
+		shard.mu.RUnlock()
+		if !exists {
+			absent = append(absent, key)
+		}
+	}
+	return absent
+}
+
+// collectExpiredKeys 收集过期的键，同时上报各分片的键数。ctx 结束时返回已收集的部分
+func (c *GoCacheUsecase) collectExpiredKeys(ctx context.Context) []string {
 	var expiredKeys []string
 	for i := range c.shards {
-		c.shards[i].mu.RLock()
+		if c.shards[i].mu.RLockContext(ctx) != nil {
+			break
+		}
 		for key, entry := range c.shards[i].active.Data {
-			if entry.ExpiresAt > 0 && time.Now().Unix() > (entry.ExpiresAt) {
+			if entry.expired(c.clock.Now().UnixMilli()) {
 				expiredKeys = append(expiredKeys, key)
 			}
 		}
+		c.metrics.SetKeyCount(i, len(c.shards[i].active.Data))
 		c.shards[i].mu.RUnlock()
 	}
 	return expiredKeys
 }
 
-// cleanupMemory 清理内存中的过期数据
-func (c *GoCacheUsecase) cleanupMemory(expiredKeys []string) {
+// cleanupMemory 清理内存中的过期数据，返回实际删除的键。收集之后被重新写入或续期的键不会删除，
+// 也不会交给 CleanupAOF。ctx 结束时停止并返回 ctx.Err()
+func (c *GoCacheUsecase) cleanupMemory(ctx context.Context, expiredKeys []string) ([]string, error) {
+	removed := make([]string, 0, len(expiredKeys))
+	now := c.clock.Now().UnixMilli()
 	for _, key := range expiredKeys {
 		shard := c.getShard(key)
+		if err := shard.mu.LockContext(ctx); err != nil {
+			return removed, err
+		}
+		if entry, exists := shard.active.Data[key]; exists && entry.expired(now) {
+			c.dropExpiredLocked(shard.active, key)
+			removed = append(removed, key)
+		}
+		shard.mu.Unlock()
+	}
+	return removed, nil
+}
+
+// deleteIfExpired 读取时发现键已过期后调用，加写锁重新检查，仅在键当前确实已过期时删除
+func (c *GoCacheUsecase) deleteIfExpired(key string) {
+	// 从节点等待主节点复制的 DEL，读取时已按 ExpiresAt 视为不存在
+	if c.replica != nil {
+		return
+	}
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || !entry.expired(c.clock.Now().UnixMilli()) {
+		return
+	}
+	c.reapLocked(shard.active, key)
+}
+
+// deleteExpired 删除时间轮一格中到期的键，只删除当前确实已过期的键。按分片分组，每个分片只加一次写锁，
+// 分片内删除的键合并为一条 MDEL 记录并在持有分片锁时写入，不会排在之后重新写入这些键的记录后面。
+// 大量键同时到期时每格最多追加分片数条记录，而不是每个键一条 DEL
+func (c *GoCacheUsecase) deleteExpired(keys []string) {
+	// 从节点等待主节点复制的 DEL，读取时已按 ExpiresAt 视为不存在
+	if c.replica != nil {
+		return
+	}
+	for index, group := range c.groupByShard(keys) {
+		shard := &c.shards[index]
 		shard.mu.Lock()
-		delete(shard.active.Data, key)
+		now := c.clock.Now().UnixMilli()
+		var removed []string
+		for _, key := range group {
+			if entry, exists := shard.active.Data[key]; exists && entry.expired(now) {
+				c.dropExpiredLocked(shard.active, key)
+				removed = append(removed, key)
+			}
+		}
+		c.logExpiredLocked(removed)
 		shard.mu.Unlock()
 	}
 }
+
+// reapLocked 删除已过期的键并追加 DEL 记录，调用方需持有分片写锁
+func (c *GoCacheUsecase) reapLocked(buf *CacheBuffer, key string) {
+	c.dropExpiredLocked(buf, key)
+	c.logExpiredLocked([]string{key})
+}
+
+// logExpiredLocked 为删除的过期键追加 AOF 记录，单个键写 DEL，多个键合并为一条 MDEL。
+// 从节点不写；开启 aof_skip_expired 时也不写，重放时这些键的记录按过期时间跳过。
+// 调用方需持有这些键所在分片的写锁，记录不会排在之后重新写入这些键的记录后面
+func (c *GoCacheUsecase) logExpiredLocked(keys []string) {
+	if len(keys) == 0 || c.replica != nil || c.skipExpiredAOF {
+		return
+	}
+	command := AOFCommand{Op: AOFMDel, Args: keys}
+	if len(keys) == 1 {
+		command = AOFCommand{Op: AOFDel, Key: keys[0]}
+	}
+	if err := c.repo.Write(context.Background(), command); err != nil {
+		c.log.Errorf("write %d expired keys to AOF err: %v", len(keys), err)
+	}
+}
+
+// dropExpiredLocked 删除已过期的键、计数并通知 WatchExpired 的订阅者，不写 AOF，调用方需持有分片写锁
+func (c *GoCacheUsecase) dropExpiredLocked(buf *CacheBuffer, key string) {
+	expiresAt := buf.Data[key].ExpiresAt
+	c.removeLocked(buf, key)
+	c.counters.expired.Add(1)
+	c.metrics.IncExpired(1)
+	c.watchers.publish(key, expiresAt)
+}
@@ -0,0 +1,84 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSetWithExpiresAtSubSecond(t *testing.T) {
+	ctx := context.Background()
+	clock := newMilliClock()
+	repo := &memRepo{}
+	c := newTestCache(t, nil, repo, WithClock(clock))
+	step := manualWheel(t, c, clock, 100*time.Millisecond)
+
+	// 不足一毫秒的部分被截去，过期时间是 now+250ms
+	deadline := clock.Now().Add(250*time.Millisecond + 700*time.Microsecond)
+	if err := c.SetWithExpiresAt(ctx, "k", "v", deadline); err != nil {
+		t.Fatal(err)
+	}
+	if ttl, err := c.TTL(ctx, "k"); err != nil || ttl != 250*time.Millisecond {
+		t.Fatalf("TTL = %v, %v, want 250ms", ttl, err)
+	}
+	// 时间轮按绝对时间登记：第 3 格(300ms)触发，之前的两格不会提前删除
+	if fired := step(200 * time.Millisecond); len(fired) != 0 {
+		t.Fatalf("time wheel fired %v before the deadline", fired)
+	}
+	clock.Advance(49 * time.Millisecond)
+	if v, err := c.Get(ctx, "k"); err != nil || v != "v" {
+		t.Fatalf("Get 1ms before the deadline = %q, %v, want v", v, err)
+	}
+	clock.Advance(time.Millisecond)
+	if _, err := c.Get(ctx, "k"); err != ErrKeyNotFound {
+		t.Fatalf("Get at the deadline err = %v, want ErrKeyNotFound", err)
+	}
+
+	// AOF 记录中保存毫秒精度的 ExpiresAt，重放后剩余 TTL 不受取整影响
+	deadline = clock.Now().Add(1500 * time.Millisecond)
+	if err := c.SetWithExpiresAt(ctx, "replayed", "v", deadline); err != nil {
+		t.Fatal(err)
+	}
+	records := repo.records()
+	if last := records[len(records)-1]; last.Key != "replayed" || last.ExpiresAt != deadline.UnixMilli() {
+		t.Fatalf("last AOF record = %+v, want replayed expiring at %d", last, deadline.UnixMilli())
+	}
+	clock.Advance(200 * time.Millisecond)
+	restarted := newTestCache(t, nil, repo, WithClock(clock))
+	if ttl, err := restarted.TTL(ctx, "replayed"); err != nil || ttl != 1300*time.Millisecond {
+		t.Fatalf("TTL after restart = %v, %v, want 1.3s", ttl, err)
+	}
+}
+
+func TestSetWithExpiresAtRejectsPastDeadlines(t *testing.T) {
+	ctx := context.Background()
+	clock := newMilliClock()
+	c := newTestCache(t, nil, nil, WithClock(clock))
+	now := clock.Now()
+	for name, deadline := range map[string]time.Time{
+		"an hour ago":         now.Add(-time.Hour),
+		"now":                 now,
+		"within this ms":      now.Add(999 * time.Microsecond),
+		"1ms ago":             now.Add(-time.Millisecond),
+		"1ms after the epoch": time.UnixMilli(1),
+	} {
+		if err := c.SetWithExpiresAt(ctx, "k", "v", deadline); err != ErrExpiresAtPassed {
+			t.Fatalf("%s: err = %v, want ErrExpiresAtPassed", name, err)
+		}
+	}
+	if n, _ := c.DBSize(ctx); n != 0 {
+		t.Fatalf("DBSize = %d after rejected writes, want 0", n)
+	}
+
+	// 零值表示永不过期
+	if err := c.SetWithExpiresAt(ctx, "k", "v", time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(24 * time.Hour)
+	if ttl, err := c.TTL(ctx, "k"); err != nil || ttl != NoExpiration {
+		t.Fatalf("TTL = %v, %v, want NoExpiration", ttl, err)
+	}
+	if err := c.SetWithExpiresAt(ctx, "next", "v", clock.Now().Add(time.Millisecond)); err != nil {
+		t.Fatalf("deadline 1ms ahead: %v", err)
+	}
+}
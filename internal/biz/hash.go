@@ -0,0 +1,210 @@
+package biz
+
+import (
+	"context"
+)
+
+// 哈希键的字段保存在 CacheItem.Hash 中。Hash 写入分片后不再原地修改，HSet、HDel 复制一份修改后替换整个条目：
+// 快照、迭代器和 AOF 写入协程在释放分片锁之后仍会读取条目，原地修改会与它们产生数据竞争。
+// 过期时间、固定与淘汰都作用于整个键。
+
+// HSet 设置哈希键的字段，键不存在或已过期时创建新的哈希，已有的过期时间保持不变
+func (c *GoCacheUsecase) HSet(ctx context.Context, key, field, value string) error {
+	c.log.WithContext(ctx).Infof("hset key:%s,field:%s,value:%s", key, field, value)
+	if err := c.writable(); err != nil {
+		return err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return err
+	}
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if exists && entry.expired(c.clock.Now().UnixMilli()) {
+		// 先记录 DEL，重放时 HSET 不会落在已过期的旧条目上
+		c.reapLocked(shard.active, key)
+		exists = false
+	}
+	if !exists {
+		entry = CacheItem{}
+	} else if entry.Hash == nil {
+		return ErrWrongType
+	}
+	entry.Hash = withField(entry.Hash, field, value)
+	entry.EventTime = c.clock.Now().UnixMilli()
+	entry.Version = shard.active.nextVersion(key)
+	if err := c.evictLocked(shard.active, key, entrySize(key, entry)); err != nil {
+		return err
+	}
+	shard.active.set(key, entry)
+	c.counters.sets.Add(1)
+	return c.repo.Write(ctx, AOFCommand{Op: AOFHSet, Key: key, Value: value, Args: []string{field}, Version: entry.Version})
+}
+
+// HGet 返回哈希键中字段的值，键或字段不存在时返回 ErrKeyNotFound
+func (c *GoCacheUsecase) HGet(ctx context.Context, key, field string) (string, error) {
+	c.log.WithContext(ctx).Infof("hget key:%s,field:%s", key, field)
+	entry, err := c.getHash(key)
+	if err != nil {
+		return "", err
+	}
+	value, ok := entry.Hash[field]
+	if !ok {
+		c.counters.misses.Add(1)
+		return "", ErrKeyNotFound
+	}
+	c.counters.hits.Add(1)
+	entry.touch(nowNano())
+	return value, nil
+}
+
+// HGetAll 返回哈希键的所有字段，返回的 map 是副本；键不存在时返回 ErrKeyNotFound
+func (c *GoCacheUsecase) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	c.log.WithContext(ctx).Infof("hgetall key:%s", key)
+	entry, err := c.getHash(key)
+	if err != nil {
+		return nil, err
+	}
+	c.counters.hits.Add(1)
+	entry.touch(nowNano())
+	fields := make(map[string]string, len(entry.Hash))
+	for field, value := range entry.Hash {
+		fields[field] = value
+	}
+	return fields, nil
+}
+
+// HLen 返回哈希键的字段数，键不存在时返回 ErrKeyNotFound
+func (c *GoCacheUsecase) HLen(ctx context.Context, key string) (int, error) {
+	entry, err := c.getHash(key)
+	if err != nil {
+		return 0, err
+	}
+	c.counters.hits.Add(1)
+	return len(entry.Hash), nil
+}
+
+// HDel 删除哈希键中的字段，返回字段是否存在；删除最后一个字段时整个键被删除
+func (c *GoCacheUsecase) HDel(ctx context.Context, key, field string) (bool, error) {
+	c.log.WithContext(ctx).Infof("hdel key:%s,field:%s", key, field)
+	if err := c.writable(); err != nil {
+		return false, err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return false, err
+	}
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) {
+		return false, nil
+	}
+	if entry.Hash == nil {
+		return false, ErrWrongType
+	}
+	if _, ok := entry.Hash[field]; !ok {
+		return false, nil
+	}
+	entry.Version++
+	c.deleteFieldLocked(shard.active, key, entry, field)
+	return true, c.repo.Write(ctx, AOFCommand{Op: AOFHDel, Key: key, Args: []string{field}, Version: entry.Version})
+}
+
+// getHash 在读锁下取出哈希键的条目，已过期的键与 Get 一样改为加写锁删除
+func (c *GoCacheUsecase) getHash(key string) (CacheItem, error) {
+	shard := c.getShard(key)
+	shard.mu.RLock()
+	entry, exists := shard.active.Data[key]
+	shard.mu.RUnlock()
+	if !exists {
+		c.counters.misses.Add(1)
+		return CacheItem{}, ErrKeyNotFound
+	}
+	if entry.expired(c.clock.Now().UnixMilli()) {
+		c.deleteIfExpired(key)
+		c.counters.misses.Add(1)
+		return CacheItem{}, ErrKeyNotFound
+	}
+	if entry.Hash == nil {
+		return CacheItem{}, ErrWrongType
+	}
+	return entry, nil
+}
+
+// deleteFieldLocked 用去掉 field 的副本替换条目，哈希变为空时删除整个键。entry 的版本号由调用方设置，
+// 调用方需持有分片写锁
+func (c *GoCacheUsecase) deleteFieldLocked(buf *CacheBuffer, key string, entry CacheItem, field string) {
+	if len(entry.Hash) == 1 {
+		c.removeLocked(buf, key)
+		c.counters.deletes.Add(1)
+		return
+	}
+	fields := make(map[string]string, len(entry.Hash)-1)
+	for f, v := range entry.Hash {
+		if f != field {
+			fields[f] = v
+		}
+	}
+	entry.Hash = fields
+	buf.set(key, entry)
+}
+
+// withField 返回加入 field 后的哈希副本，fields 本身不会被修改
+func withField(fields map[string]string, field, value string) map[string]string {
+	copied := make(map[string]string, len(fields)+1)
+	for f, v := range fields {
+		copied[f] = v
+	}
+	copied[field] = value
+	return copied
+}
+
+// replayHSet 重放 HSET 记录: HSET key field value。运行时 HSET 遇到已过期的键会先记录 DEL，
+// 这里不再判断过期，直接在已有的哈希上修改并保留其过期时间
+func (c *GoCacheUsecase) replayHSet(key, field, value string, version uint64) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.Hash == nil {
+		entry = inheritPin(entry, CacheItem{})
+	}
+	entry.Hash = withField(entry.Hash, field, value)
+	entry.Version = shard.active.replayedVersion(key, version)
+	shard.active.set(key, entry)
+}
+
+// replayHDel 重放 HDEL 记录: HDEL key field
+func (c *GoCacheUsecase) replayHDel(key, field string, version uint64) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.Hash == nil {
+		return
+	}
+	if _, ok := entry.Hash[field]; ok {
+		entry.Version = shard.active.replayedVersion(key, version)
+		c.deleteFieldLocked(shard.active, key, entry, field)
+	}
+}
+
+// replayHMSet 重放 HMSET 记录: HMSET key expiresAt eventTime fields，记录的是整个哈希，
+// 由 Expire、Persist 等修改过期时间的操作以及 AOF 重写写入
+func (c *GoCacheUsecase) replayHMSet(key string, expiresAt, eventTime int64, fields map[string]string, version uint64) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	if expiresAt != 0 && c.clock.Now().UnixMilli() >= expiresAt {
+		c.removeLocked(shard.active, key)
+		return
+	}
+	entry := CacheItem{Hash: fields, ExpiresAt: expiresAt, EventTime: eventTime, Version: shard.active.replayedVersion(key, version)}
+	if old, exists := shard.active.Data[key]; exists {
+		entry = inheritPin(old, entry)
+	}
+	shard.active.set(key, entry)
+	if expiresAt > 0 {
+		c.timeWheel.Add(key, c.untilMilli(expiresAt))
+	}
+}
@@ -0,0 +1,125 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"maps"
+	"sync"
+	"testing"
+
+	"gocache-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// memRepo 把命令保存在内存中的 CacheRepo。用同一个 memRepo 创建新的缓存时按写入顺序重放，相当于重启
+type memRepo struct {
+	mu       sync.Mutex
+	commands []AOFCommand
+	// next 重写或保存快照期间生成的新记录，非 nil 时写入的命令同时追加到这里
+	next []AOFCommand
+}
+
+func (r *memRepo) Write(_ context.Context, command AOFCommand) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.commands = append(r.commands, command)
+	if r.next != nil {
+		r.next = append(r.next, command)
+	}
+	return nil
+}
+
+func (r *memRepo) Replay(_ context.Context, apply func(command AOFCommand)) (int, error) {
+	r.mu.Lock()
+	commands := append([]AOFCommand(nil), r.commands...)
+	r.mu.Unlock()
+	for _, command := range commands {
+		apply(command)
+	}
+	return len(commands), nil
+}
+
+// CleanupAOF 与 AOF 的清理方式相同：以调用 expiredKeys 之前写入的命令为旧文件，删除其中属于返回的键的记录
+func (r *memRepo) CleanupAOF(_ context.Context, expiredKeys func() []string) error {
+	r.mu.Lock()
+	cut := len(r.commands)
+	r.mu.Unlock()
+	expired := make(map[string]bool)
+	for _, key := range expiredKeys() {
+		expired[key] = true
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	kept := r.commands[:0:0]
+	for i, command := range r.commands {
+		if i < cut && command.Key != "" && expired[command.Key] {
+			continue
+		}
+		kept = append(kept, command)
+	}
+	r.commands = kept
+	return nil
+}
+
+func (r *memRepo) Close(context.Context) error { return nil }
+
+func (r *memRepo) AOFStats(context.Context) (AOFStats, error) { return AOFStats{}, nil }
+
+func (r *memRepo) RewriteAOF(_ context.Context, snapshot func(emit func(items map[string]CacheItem)) error) (int64, error) {
+	r.mu.Lock()
+	r.next = []AOFCommand{}
+	r.mu.Unlock()
+	err := snapshot(func(items map[string]CacheItem) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.next = append(r.next, AOFCommand{Op: AOFLoad, Items: maps.Clone(items)})
+	})
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err == nil {
+		r.commands = r.next
+	}
+	r.next = nil
+	return 0, err
+}
+
+func (r *memRepo) SaveSnapshot(ctx context.Context, snapshot func(emit func(items map[string]CacheItem)) error) (int64, error) {
+	return r.RewriteAOF(ctx, snapshot)
+}
+
+func (r *memRepo) Truncate(context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.commands = nil
+	return nil
+}
+
+// records 返回目前写入的命令
+func (r *memRepo) records() []AOFCommand {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]AOFCommand(nil), r.commands...)
+}
+
+// discardRepo 丢弃所有命令的 CacheRepo，用于测量内存和基准测试，AOF 记录不会随迭代次数增长
+type discardRepo struct{ memRepo }
+
+func (*discardRepo) Write(context.Context, AOFCommand) error { return nil }
+
+// newTestCache 用 cfg 和 repo 创建缓存并在测试结束时关闭，cfg 或 repo 为 nil 时使用默认配置和新的 memRepo
+func newTestCache(tb testing.TB, cfg *conf.Data_Cache, repo CacheRepo, opts ...Option) *GoCacheUsecase {
+	tb.Helper()
+	if cfg == nil {
+		cfg = &conf.Data_Cache{}
+	}
+	if repo == nil {
+		repo = &memRepo{}
+	}
+	c, _, err := NewGoCacheUsecaseWithOptions(&conf.Data{Cache: cfg}, repo, nil, nil, log.NewStdLogger(io.Discard), opts...)
+	if err != nil {
+		tb.Fatalf("NewGoCacheUsecase: %v", err)
+	}
+	tb.Cleanup(func() { _ = c.Close(context.Background()) })
+	return c
+}
@@ -0,0 +1,225 @@
+package biz
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gocache-service/internal/conf"
+)
+
+const (
+	// defaultHotKeyWindow 未配置时热点键的统计窗口
+	defaultHotKeyWindow = time.Minute
+	// defaultHotKeyCapacity 未配置时每个分片跟踪的键数
+	defaultHotKeyCapacity = 64
+	// defaultTopKeys TopKeys 未指定数量时返回的键数
+	defaultTopKeys = 10
+)
+
+// hotKeyAccess 被记录的访问类型
+type hotKeyAccess int
+
+const (
+	hotKeyHit hotKeyAccess = iota
+	hotKeyMiss
+	hotKeyWrite
+)
+
+// KeyStat TopKeys 返回的一个热点键，计数已按抽样比例放大，是统计窗口内的估计值
+type KeyStat struct {
+	Key string
+	// Hits、Misses Get 命中与未命中的次数，Writes Set 的次数
+	Hits   int64
+	Misses int64
+	Writes int64
+	// QPS 统计窗口内平均每秒的访问次数
+	QPS float64
+}
+
+// hotKeyCounts 一个被跟踪的键的计数。base 是替换掉的键留下的计数，只用于排序，
+// 使新出现的键不会刚进入就被下一个新键挤出
+type hotKeyCounts struct {
+	base                 int64
+	hits, misses, writes int64
+}
+
+func (h *hotKeyCounts) total() int64 {
+	return h.base + h.hits + h.misses + h.writes
+}
+
+// hotKeyShard 一个分片的热点键样本。current 是当前窗口，previous 是上一个窗口，
+// 查询时合并两者，窗口切换后旧的热点键最多再保留一个窗口
+type hotKeyShard struct {
+	mu        sync.Mutex
+	current   map[string]*hotKeyCounts
+	previous  map[string]*hotKeyCounts
+	started   time.Time
+	prevStart time.Time
+}
+
+// hotKeyTracker 按分片抽样统计 Get 与 Set 访问的键。每个分片最多跟踪 capacity 个键，
+// 满了以后按 Space-Saving 算法替换计数最小的键，内存占用与请求量无关。
+// 关闭时 Get 与 Set 只多一次原子读
+type hotKeyTracker struct {
+	enabled    atomic.Bool
+	window     time.Duration
+	capacity   int
+	sampleRate int
+	shards     []hotKeyShard
+}
+
+// init 按配置初始化，未开启时不分配分片
+func (t *hotKeyTracker) init(cfg *conf.Data_Cache, shards int) {
+	if !cfg.GetHotKeys() {
+		return
+	}
+	t.window = cfg.GetHotKeyWindow().AsDuration()
+	if t.window <= 0 {
+		t.window = defaultHotKeyWindow
+	}
+	t.capacity = int(cfg.GetHotKeyCapacity())
+	if t.capacity <= 0 {
+		t.capacity = defaultHotKeyCapacity
+	}
+	t.sampleRate = max(int(cfg.GetHotKeySampleRate()), 1)
+	t.shards = make([]hotKeyShard, shards)
+	now := time.Now()
+	for i := range t.shards {
+		t.shards[i].current = make(map[string]*hotKeyCounts, t.capacity)
+		t.shards[i].started = now
+	}
+	t.enabled.Store(true)
+}
+
+// record 记录一次访问，按 sampleRate 抽样
+func (t *hotKeyTracker) record(index uint32, key string, access hotKeyAccess) {
+	if t.sampleRate > 1 && rand.Intn(t.sampleRate) != 0 {
+		return
+	}
+	shard := &t.shards[index]
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	counts, exists := shard.current[key]
+	if !exists {
+		counts = &hotKeyCounts{}
+		if len(shard.current) >= t.capacity {
+			var minKey string
+			var minCounts *hotKeyCounts
+			for k, c := range shard.current {
+				if minCounts == nil || c.total() < minCounts.total() {
+					minKey, minCounts = k, c
+				}
+			}
+			delete(shard.current, minKey)
+			counts.base = minCounts.total()
+		}
+		shard.current[key] = counts
+	}
+	switch access {
+	case hotKeyHit:
+		counts.hits++
+	case hotKeyMiss:
+		counts.misses++
+	case hotKeyWrite:
+		counts.writes++
+	}
+}
+
+// rotate 开始新的统计窗口，当前窗口成为上一个窗口
+func (t *hotKeyTracker) rotate(now time.Time) {
+	for i := range t.shards {
+		shard := &t.shards[i]
+		shard.mu.Lock()
+		shard.previous, shard.current = shard.current, make(map[string]*hotKeyCounts, t.capacity)
+		shard.prevStart, shard.started = shard.started, now
+		shard.mu.Unlock()
+	}
+}
+
+// recordAccess 开启热点键统计时记录一次 Get 或 Set 访问
+func (c *GoCacheUsecase) recordAccess(key string, access hotKeyAccess) {
+	if !c.hotKeys.enabled.Load() {
+		return
+	}
+	c.hotKeys.record(c.shardIndex(key), key, access)
+}
+
+// startHotKeyWindow 每个统计窗口切换一次热点键样本，只在开启 hot_keys 时运行
+func (c *GoCacheUsecase) startHotKeyWindow() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(c.hotKeys.window)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			c.hotKeys.rotate(now)
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// TopKeys 返回最近一到两个统计窗口内访问次数最多的 n 个键(n <= 0 时为 defaultTopKeys)，按访问次数从多到少排列。
+// 结果来自抽样和有限的样本容量，是近似值；未开启 hot_keys 时返回 ErrHotKeysDisabled
+func (c *GoCacheUsecase) TopKeys(ctx context.Context, n int) ([]KeyStat, error) {
+	c.log.WithContext(ctx).Infof("top keys n:%d", n)
+	if !c.hotKeys.enabled.Load() {
+		return nil, ErrHotKeysDisabled
+	}
+	if n <= 0 {
+		n = defaultTopKeys
+	}
+	type ranked struct {
+		stat  KeyStat
+		total int64
+	}
+	var all []ranked
+	now := time.Now()
+	scale := int64(c.hotKeys.sampleRate)
+	for i := range c.hotKeys.shards {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		shard := &c.hotKeys.shards[i]
+		shard.mu.Lock()
+		merged := make(map[string]hotKeyCounts, len(shard.current)+len(shard.previous))
+		for _, window := range []map[string]*hotKeyCounts{shard.previous, shard.current} {
+			for key, counts := range window {
+				m := merged[key]
+				m.base += counts.base
+				m.hits += counts.hits
+				m.misses += counts.misses
+				m.writes += counts.writes
+				merged[key] = m
+			}
+		}
+		since := shard.started
+		if shard.previous != nil {
+			since = shard.prevStart
+		}
+		shard.mu.Unlock()
+		seconds := now.Sub(since).Seconds()
+		for key, m := range merged {
+			stat := KeyStat{Key: key, Hits: m.hits * scale, Misses: m.misses * scale, Writes: m.writes * scale}
+			if seconds > 0 {
+				stat.QPS = float64(stat.Hits+stat.Misses+stat.Writes) / seconds
+			}
+			all = append(all, ranked{stat: stat, total: m.total()})
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].total != all[j].total {
+			return all[i].total > all[j].total
+		}
+		return all[i].stat.Key < all[j].stat.Key
+	})
+	stats := make([]KeyStat, 0, min(n, len(all)))
+	for _, r := range all[:min(n, len(all))] {
+		stats = append(stats, r.stat)
+	}
+	return stats, nil
+}
@@ -0,0 +1,46 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"gocache-service/internal/conf"
+)
+
+// BenchmarkGetHotKeys Get 在关闭和开启热点键统计(记录每次访问或每 8 次抽样一次)时的吞吐量，
+// 开启时的开销应低于 5%
+func BenchmarkGetHotKeys(b *testing.B) {
+	const keys = 1024
+	ctx := context.Background()
+	names := make([]string, keys)
+	for i := range names {
+		names[i] = fmt.Sprintf("key:%d", i)
+	}
+	for _, bench := range []struct {
+		name string
+		cfg  *conf.Data_Cache
+	}{
+		{"disabled", &conf.Data_Cache{}},
+		{"enabled", &conf.Data_Cache{HotKeys: true}},
+		{"enabled/sample=8", &conf.Data_Cache{HotKeys: true, HotKeySampleRate: 8}},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			c := newTestCache(b, bench.cfg, &discardRepo{})
+			for _, key := range names {
+				if err := c.Set(ctx, key, "value", 0); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for i := 0; pb.Next(); i++ {
+					if _, err := c.Get(ctx, names[i%keys]); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"context"
+	"sync"
+)
+
+// iteratorBatchSize 每遍历多少条检查一次 ctx
+const iteratorBatchSize = 1024
+
+// Entry 分片快照中的只读条目
+type Entry struct {
+	Key   string
+	Value string
+	// ExpiresAt 过期时间(Unix 毫秒)，0 表示永不过期
+	ExpiresAt int64
+	// compressed 快照中保存的是压缩后的值，Next 前进到该条目时解压
+	compressed bool
+}
+
+// Iterator 按顺序遍历某个分片的快照
+type Iterator interface {
+	// Next 前进到下一条，遍历结束或 ctx 被取消时返回 false
+	Next() bool
+	// Entry 返回当前条目
+	Entry() Entry
+	// Err 返回导致遍历提前结束的错误
+	Err() error
+}
+
+type shardIterator struct {
+	ctx     context.Context
+	entries []Entry
+	pos     int
+	err     error
+}
+
+func (it *shardIterator) Next() bool {
+	if it.err != nil || it.pos >= len(it.entries) {
+		return false
+	}
+	if it.pos%iteratorBatchSize == 0 {
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+	}
+	entry := &it.entries[it.pos]
+	if entry.compressed {
+		value, err := CacheItem{Value: entry.Value, Compressed: true}.value()
+		if err != nil {
+			it.err = err
+			return false
+		}
+		entry.Value, entry.compressed = value, false
+	}
+	it.pos++
+	return true
+}
+
+func (it *shardIterator) Entry() Entry {
+	return it.entries[it.pos-1]
+}
+
+func (it *shardIterator) Err() error {
+	return it.err
+}
+
+// snapshotShard 在读锁下复制分片中未过期的条目，复制完成后即释放锁
+func (c *GoCacheUsecase) snapshotShard(index int) []Entry {
+	shard := &c.shards[index]
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	now := c.clock.Now().UnixMilli()
+	entries := make([]Entry, 0, len(shard.active.Data))
+	for key, item := range shard.active.Data {
+		if item.expired(now) {
+			continue
+		}
+		entries = append(entries, Entry{Key: key, Value: item.Value, ExpiresAt: item.ExpiresAt, compressed: item.Compressed})
+	}
+	return entries
+}
+
+// ForEachShard 为每个分片启动一个 goroutine 并发调用 fn，迭代器遍历的是该分片的快照，
+// 不会看到快照之后的写入，也不会在快照之后阻塞写入。返回第一个非 nil 的错误。
+func (c *GoCacheUsecase) ForEachShard(ctx context.Context, fn func(shardIndex int, iter Iterator) error) error {
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for i := range c.shards {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			if ctx.Err() != nil {
+				return
+			}
+			iter := &shardIterator{ctx: ctx, entries: c.snapshotShard(index)}
+			err := fn(index, iter)
+			if err == nil {
+				err = iter.Err()
+			}
+			if err != nil {
+				once.Do(func() { firstErr = err })
+			}
+		}(i)
+	}
+	wg.Wait()
+	if firstErr == nil {
+		firstErr = ctx.Err()
+	}
+	return firstErr
+}
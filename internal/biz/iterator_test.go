@@ -0,0 +1,173 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"gocache-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestForEachShardSeesSnapshot(t *testing.T) {
+	const keys = 1000
+	ctx := context.Background()
+	c := newTestCache(t, nil, nil)
+	for i := 0; i < keys; i++ {
+		if err := c.Set(ctx, fmt.Sprintf("k%d", i), "old", 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var visited atomic.Int64
+	err := c.ForEachShard(ctx, func(shardIndex int, iter Iterator) error {
+		// 快照之后的覆盖、删除和新增都不影响遍历，写入也不会被遍历阻塞
+		for i := 0; i < keys; i++ {
+			key := fmt.Sprintf("k%d", i)
+			if int(c.shardIndex(key)) != shardIndex {
+				continue
+			}
+			if i%2 == 0 {
+				if err := c.Set(ctx, key, "new", 0); err != nil {
+					return err
+				}
+			} else if err := c.Delete(ctx, key); err != nil {
+				return err
+			}
+		}
+		// 新增的键只写入本分片，其他分片的快照可能还没有生成
+		for i := 0; i < keys; i++ {
+			if key := fmt.Sprintf("added%d", i); int(c.shardIndex(key)) == shardIndex {
+				if err := c.Set(ctx, key, "new", 0); err != nil {
+					return err
+				}
+			}
+		}
+		for iter.Next() {
+			e := iter.Entry()
+			if !strings.HasPrefix(e.Key, "k") || e.Value != "old" {
+				return fmt.Errorf("shard %d: saw %s=%s written after the snapshot", shardIndex, e.Key, e.Value)
+			}
+			visited.Add(1)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := visited.Load(); n != keys {
+		t.Fatalf("visited %d entries, want %d", n, keys)
+	}
+	// 一半的键被删除，新增了 keys 个键
+	if n, _ := c.DBSize(ctx); n != keys/2+keys {
+		t.Fatalf("DBSize = %d after the writes, want %d", n, keys/2+keys)
+	}
+}
+
+func TestForEachShardCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newTestCache(t, &conf.Data_Cache{Shards: 1}, nil)
+	for i := 0; i < 3*iteratorBatchSize; i++ {
+		if err := c.Set(ctx, fmt.Sprintf("k%d", i), "v", 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	visited := 0
+	err := c.ForEachShard(ctx, func(_ int, iter Iterator) error {
+		for iter.Next() {
+			if visited++; visited == 1 {
+				cancel()
+			}
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("ForEachShard err = %v, want context.Canceled", err)
+	}
+	// 取消在下一批开始前生效
+	if visited != iteratorBatchSize {
+		t.Fatalf("visited %d entries after cancel, want %d", visited, iteratorBatchSize)
+	}
+}
+
+func TestForEachShardReturnsFirstError(t *testing.T) {
+	c := newTestCache(t, nil, nil)
+	errStop := errors.New("stop")
+	var calls atomic.Int32
+	err := c.ForEachShard(context.Background(), func(shardIndex int, _ Iterator) error {
+		calls.Add(1)
+		if shardIndex == 0 {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) {
+		t.Fatalf("ForEachShard err = %v, want errStop", err)
+	}
+	if n := calls.Load(); int(n) != len(c.shards) {
+		t.Fatalf("fn called for %d shards, want %d", n, len(c.shards))
+	}
+}
+
+// 并发统计每个前缀下值的总字节数
+func ExampleGoCacheUsecase_ForEachShard() {
+	ctx := context.Background()
+	c, _, err := NewGoCacheUsecase(&conf.Data{}, &memRepo{}, nil, nil, log.NewStdLogger(io.Discard))
+	if err != nil {
+		panic(err)
+	}
+	defer c.Close(ctx)
+	for key, value := range map[string]string{
+		"user:1":    "alice",
+		"user:2":    "bob",
+		"session:1": "0123456789",
+		"session:2": "abcdef",
+		"config":    "{}",
+	} {
+		if err := c.Set(ctx, key, value, 0); err != nil {
+			panic(err)
+		}
+	}
+
+	var mu sync.Mutex
+	bytesByPrefix := make(map[string]int)
+	err = c.ForEachShard(ctx, func(_ int, iter Iterator) error {
+		local := make(map[string]int)
+		for iter.Next() {
+			e := iter.Entry()
+			prefix, _, _ := strings.Cut(e.Key, ":")
+			local[prefix] += len(e.Value)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for prefix, n := range local {
+			bytesByPrefix[prefix] += n
+		}
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	prefixes := make([]string, 0, len(bytesByPrefix))
+	for prefix := range bytesByPrefix {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		fmt.Printf("%s: %d bytes\n", prefix, bytesByPrefix[prefix])
+	}
+	// Output:
+	// config: 2 bytes
+	// session: 16 bytes
+	// user: 8 bytes
+}
@@ -0,0 +1,309 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// defaultScanCount Scan 未指定 count 时每页返回的键数
+const defaultScanCount = 10
+
+// Type 返回的键类型
+const (
+	TypeString = "string"
+	TypeHash   = "hash"
+	TypeList   = "list"
+)
+
+// Keys 返回所有匹配 glob 模式的未过期键，支持 *、?、[abc]、[a-z]、[^a] 和 \ 转义。
+// 会在读锁下逐个遍历全部分片，数据量大时应使用 Scan 分页。
+func (c *GoCacheUsecase) Keys(ctx context.Context, pattern string) ([]string, error) {
+	if !validPattern(pattern) {
+		return nil, ErrInvalidPattern
+	}
+	var keys []string
+	now := c.clock.Now().UnixMilli()
+	for i := range c.shards {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := c.rlockShard(ctx, &c.shards[i]); err != nil {
+			return nil, err
+		}
+		for key, entry := range c.shards[i].active.Data {
+			if !entry.expired(now) && matchPattern(pattern, key) {
+				keys = append(keys, key)
+			}
+		}
+		c.shards[i].mu.RUnlock()
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// DBSize 返回总键数和每个分片的键数，按分片序号排列。键数由 set 和 remove 维护，读取时不加分片锁，
+// 开销只与分片数有关，但包括已过期、尚未被删除的键：主节点上这样的键最多保留一个 time_wheel_tick，
+// 重启后重载的键再加上 reload_ttl_jitter 的延后；从节点上保留到主节点复制的 DEL 到达为止。
+// 各分片的计数不是在同一时刻读取的，并发写入时总数可能与任一时刻的实际键数略有出入
+func (c *GoCacheUsecase) DBSize(ctx context.Context) (int64, []int64) {
+	var total int64
+	perShard := make([]int64, len(c.shards))
+	for i := range c.shards {
+		perShard[i] = c.shards[i].keys.Load()
+		total += perShard[i]
+	}
+	return total, perShard
+}
+
+// MemoryUsage 返回键的估算内存占用(字节)：键和值的长度、哈希字段与列表元素的长度以及固定的结构开销，
+// 与 max_bytes 使用的估算相同，压缩的值按压缩后的长度计算。键不存在或已过期时返回 ErrKeyNotFound
+func (c *GoCacheUsecase) MemoryUsage(ctx context.Context, key string) (int64, error) {
+	shard := c.getShard(key)
+	if err := c.rlockShard(ctx, shard); err != nil {
+		return 0, err
+	}
+	defer shard.mu.RUnlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) {
+		return 0, ErrKeyNotFound
+	}
+	return entrySize(key, entry), nil
+}
+
+// RandomKey 返回一个随机的未过期键。从随机选择的分片开始，利用 map 遍历顺序的随机性取分片内的键，
+// 分片为空或只剩已过期的键时依次尝试后面的分片，同一时间只持有一个分片的读锁。
+// 每个分片被选中的概率相同，与分片内的键数无关，因此不是在所有键中均匀抽取。
+// 只有所有分片都没有未过期的键时才返回 ErrKeyNotFound
+func (c *GoCacheUsecase) RandomKey(ctx context.Context) (string, error) {
+	start := rand.Intn(len(c.shards))
+	now := c.clock.Now().UnixMilli()
+	for i := range c.shards {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		if key, ok := c.randomKeyIn(&c.shards[(start+i)%len(c.shards)], now); ok {
+			return key, nil
+		}
+	}
+	return "", ErrKeyNotFound
+}
+
+func (c *GoCacheUsecase) randomKeyIn(shard *cacheShard, now int64) (string, bool) {
+	if shard.keys.Load() == 0 {
+		return "", false
+	}
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+	for key, entry := range shard.active.Data {
+		if !entry.expired(now) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// Type 返回键的类型 TypeString、TypeHash 或 TypeList，键不存在或已过期时返回 ErrKeyNotFound
+func (c *GoCacheUsecase) Type(ctx context.Context, key string) (string, error) {
+	shard := c.getShard(key)
+	if err := c.rlockShard(ctx, shard); err != nil {
+		return "", err
+	}
+	entry, exists := shard.active.Data[key]
+	shard.mu.RUnlock()
+	switch {
+	case !exists || entry.expired(c.clock.Now().UnixMilli()):
+		return "", ErrKeyNotFound
+	case entry.Hash != nil:
+		return TypeHash, nil
+	case entry.List != nil:
+		return TypeList, nil
+	default:
+		return TypeString, nil
+	}
+}
+
+// Scan 按游标分页遍历所有匹配 glob 模式的未过期键，游标为 0 表示从头开始，返回的 next 为 0 表示遍历结束。
+// 游标高 32 位是分片序号，低 32 位是分片内下一个键的最小哈希值；分片内按键的哈希值排序，
+// 因此整个遍历期间一直存在的键恰好返回一次，期间新增或删除的键可能返回也可能不返回。
+// 同一时间只持有一个分片的读锁。同一哈希值的键总是在同一页返回，单页可能略多于 count。
+func (c *GoCacheUsecase) Scan(ctx context.Context, cursor uint64, pattern string, count int) ([]string, uint64, error) {
+	if !validPattern(pattern) {
+		return nil, 0, ErrInvalidPattern
+	}
+	if count <= 0 {
+		count = defaultScanCount
+	}
+	shardIndex, start := cursor>>32, uint32(cursor)
+	var keys []string
+	for ; shardIndex < uint64(len(c.shards)); shardIndex, start = shardIndex+1, 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+		if len(keys) >= count {
+			return keys, shardIndex<<32 | uint64(start), nil
+		}
+		page, last, more, err := c.scanShard(ctx, int(shardIndex), start, pattern, count-len(keys))
+		if err != nil {
+			return nil, 0, err
+		}
+		keys = append(keys, page...)
+		if more {
+			if last == math.MaxUint32 {
+				return keys, (shardIndex + 1) << 32, nil
+			}
+			return keys, shardIndex<<32 | uint64(last+1), nil
+		}
+	}
+	return keys, 0, nil
+}
+
+// scanShard 返回分片内哈希值不小于 start 且匹配 pattern 的前 limit 个键(同哈希值的键不拆开)，
+// last 是本页最后一个键的哈希值，more 表示分片内还有剩余的键。获取读锁时 ctx 结束或等待超时返回错误
+func (c *GoCacheUsecase) scanShard(ctx context.Context, index int, start uint32, pattern string, limit int) (keys []string, last uint32, more bool, err error) {
+	type hashedKey struct {
+		key  string
+		hash uint32
+	}
+	shard := &c.shards[index]
+	now := c.clock.Now().UnixMilli()
+	if err := c.rlockShard(ctx, shard); err != nil {
+		return nil, 0, false, err
+	}
+	candidates := make([]hashedKey, 0)
+	for key, entry := range shard.active.Data {
+		if entry.expired(now) || !matchPattern(pattern, key) {
+			continue
+		}
+		if h := fnv32(key); h >= start {
+			candidates = append(candidates, hashedKey{key: key, hash: h})
+		}
+	}
+	shard.mu.RUnlock()
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].hash != candidates[j].hash {
+			return candidates[i].hash < candidates[j].hash
+		}
+		return candidates[i].key < candidates[j].key
+	})
+	n := 0
+	for n < len(candidates) && (n < limit || (n > 0 && candidates[n].hash == candidates[n-1].hash)) {
+		keys = append(keys, candidates[n].key)
+		n++
+	}
+	if n == 0 {
+		return nil, 0, false, nil
+	}
+	return keys, candidates[n-1].hash, n < len(candidates), nil
+}
+
+// validPattern 检查方括号是否闭合、转义符后是否有字符
+func validPattern(pattern string) bool {
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+			if i >= len(pattern) {
+				return false
+			}
+		case '[':
+			_, next, ok := matchClass(pattern, i, 0)
+			if !ok {
+				return false
+			}
+			i = next - 1
+		}
+	}
+	return true
+}
+
+// matchPattern 判断 name 是否匹配 glob 模式，* 可以匹配包括 / 在内的任意字符
+func matchPattern(pattern, name string) bool {
+	p, n := 0, 0
+	// 最近一个 * 的位置及其匹配到的 name 位置，用于回溯
+	star, starN := -1, 0
+	for n < len(name) {
+		if p < len(pattern) {
+			switch pattern[p] {
+			case '*':
+				star, starN = p, n
+				p++
+				continue
+			case '?':
+				p++
+				n++
+				continue
+			case '[':
+				if matched, next, ok := matchClass(pattern, p, name[n]); ok && matched {
+					p = next
+					n++
+					continue
+				}
+			case '\\':
+				if p+1 < len(pattern) && pattern[p+1] == name[n] {
+					p += 2
+					n++
+					continue
+				}
+			default:
+				if pattern[p] == name[n] {
+					p++
+					n++
+					continue
+				}
+			}
+		}
+		if star < 0 {
+			return false
+		}
+		starN++
+		p, n = star+1, starN
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// matchClass 匹配从 pattern[start] 的 '[' 开始的字符类，返回是否匹配、类之后的位置和类是否合法
+func matchClass(pattern string, start int, c byte) (matched bool, next int, ok bool) {
+	i := start + 1
+	negate := false
+	if i < len(pattern) && pattern[i] == '^' {
+		negate = true
+		i++
+	}
+	first := true
+	for i < len(pattern) {
+		if pattern[i] == ']' && !first {
+			return matched != negate, i + 1, true
+		}
+		first = false
+		lo := pattern[i]
+		if lo == '\\' {
+			i++
+			if i >= len(pattern) {
+				return false, 0, false
+			}
+			lo = pattern[i]
+		}
+		hi := lo
+		if i+2 < len(pattern) && pattern[i+1] == '-' && pattern[i+2] != ']' {
+			hi = pattern[i+2]
+			if hi == '\\' {
+				if i+3 >= len(pattern) {
+					return false, 0, false
+				}
+				hi = pattern[i+3]
+				i++
+			}
+			i += 2
+		}
+		if lo <= c && c <= hi {
+			matched = true
+		}
+		i++
+	}
+	return false, 0, false
+}
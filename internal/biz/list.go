@@ -0,0 +1,253 @@
+package biz
+
+import (
+	"context"
+)
+
+// 列表键的元素保存在 CacheItem.List 中，与哈希一样写入分片后不再原地修改，LPush、RPop 等操作复制一份修改后替换整个条目。
+// 列表不会为空，弹出最后一个元素时整个键被删除。过期时间、固定与淘汰都作用于整个键。
+
+// LPush 把 values 依次插入列表头部，键不存在或已过期时创建新的列表，返回插入后的长度；values 为空时只返回当前长度
+func (c *GoCacheUsecase) LPush(ctx context.Context, key string, values ...string) (int, error) {
+	c.log.WithContext(ctx).Infof("lpush key:%s,values:%v", key, values)
+	return c.push(ctx, key, true, values)
+}
+
+// RPush 把 values 依次追加到列表尾部，键不存在或已过期时创建新的列表，返回追加后的长度
+func (c *GoCacheUsecase) RPush(ctx context.Context, key string, values ...string) (int, error) {
+	c.log.WithContext(ctx).Infof("rpush key:%s,values:%v", key, values)
+	return c.push(ctx, key, false, values)
+}
+
+// LPop 移除并返回列表的第一个元素，键不存在时返回 ErrKeyNotFound
+func (c *GoCacheUsecase) LPop(ctx context.Context, key string) (string, error) {
+	c.log.WithContext(ctx).Infof("lpop key:%s", key)
+	return c.pop(ctx, key, true)
+}
+
+// RPop 移除并返回列表的最后一个元素，键不存在时返回 ErrKeyNotFound
+func (c *GoCacheUsecase) RPop(ctx context.Context, key string) (string, error) {
+	c.log.WithContext(ctx).Infof("rpop key:%s", key)
+	return c.pop(ctx, key, false)
+}
+
+// LRange 返回列表下标 start 到 stop(都包含)之间的元素，负数下标从尾部计数，-1 为最后一个元素，
+// 越界的下标按 Redis 的规则截断，范围为空时返回空切片；键不存在时返回 ErrKeyNotFound
+func (c *GoCacheUsecase) LRange(ctx context.Context, key string, start, stop int64) ([]string, error) {
+	c.log.WithContext(ctx).Infof("lrange key:%s,start:%d,stop:%d", key, start, stop)
+	entry, err := c.getList(key)
+	if err != nil {
+		return nil, err
+	}
+	c.counters.hits.Add(1)
+	entry.touch(nowNano())
+	n := int64(len(entry.List))
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+	if start > stop {
+		return []string{}, nil
+	}
+	values := make([]string, stop-start+1)
+	copy(values, entry.List[start:stop+1])
+	return values, nil
+}
+
+// LLen 返回列表的长度，键不存在时返回 ErrKeyNotFound
+func (c *GoCacheUsecase) LLen(ctx context.Context, key string) (int, error) {
+	entry, err := c.getList(key)
+	if err != nil {
+		return 0, err
+	}
+	c.counters.hits.Add(1)
+	return len(entry.List), nil
+}
+
+func (c *GoCacheUsecase) push(ctx context.Context, key string, head bool, values []string) (int, error) {
+	if err := c.writable(); err != nil {
+		return 0, err
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return 0, err
+	}
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if exists && entry.expired(c.clock.Now().UnixMilli()) {
+		// 先记录 DEL，重放时 PUSH 不会落在已过期的旧条目上
+		c.reapLocked(shard.active, key)
+		exists = false
+	}
+	if !exists {
+		entry = CacheItem{}
+	} else if entry.List == nil {
+		return 0, ErrWrongType
+	}
+	if len(values) == 0 {
+		// 与 MSet 的空调用一样不做修改，也不写 AOF
+		return len(entry.List), nil
+	}
+	entry.List = withElements(entry.List, head, values)
+	entry.EventTime = c.clock.Now().UnixMilli()
+	entry.Version = shard.active.nextVersion(key)
+	if err := c.evictLocked(shard.active, key, entrySize(key, entry)); err != nil {
+		return 0, err
+	}
+	shard.active.set(key, entry)
+	c.counters.sets.Add(1)
+	op := AOFRPush
+	if head {
+		op = AOFLPush
+	}
+	return len(entry.List), c.repo.Write(ctx, AOFCommand{Op: op, Key: key, Args: values, Version: entry.Version})
+}
+
+func (c *GoCacheUsecase) pop(ctx context.Context, key string, head bool) (string, error) {
+	if err := c.writable(); err != nil {
+		return "", err
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return "", err
+	}
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if exists && entry.expired(c.clock.Now().UnixMilli()) {
+		c.reapLocked(shard.active, key)
+		exists = false
+	}
+	if !exists {
+		c.counters.misses.Add(1)
+		return "", ErrKeyNotFound
+	}
+	if entry.List == nil {
+		return "", ErrWrongType
+	}
+	c.counters.hits.Add(1)
+	entry.Version++
+	value := c.popLocked(shard.active, key, entry, head)
+	op := AOFRPop
+	if head {
+		op = AOFLPop
+	}
+	return value, c.repo.Write(ctx, AOFCommand{Op: op, Key: key, Version: entry.Version})
+}
+
+// getList 在读锁下取出列表键的条目，已过期的键与 Get 一样改为加写锁删除
+func (c *GoCacheUsecase) getList(key string) (CacheItem, error) {
+	shard := c.getShard(key)
+	shard.mu.RLock()
+	entry, exists := shard.active.Data[key]
+	shard.mu.RUnlock()
+	if !exists {
+		c.counters.misses.Add(1)
+		return CacheItem{}, ErrKeyNotFound
+	}
+	if entry.expired(c.clock.Now().UnixMilli()) {
+		c.deleteIfExpired(key)
+		c.counters.misses.Add(1)
+		return CacheItem{}, ErrKeyNotFound
+	}
+	if entry.List == nil {
+		return CacheItem{}, ErrWrongType
+	}
+	return entry, nil
+}
+
+// popLocked 用去掉首个或末个元素的副本替换条目，列表变为空时删除整个键。entry 的版本号由调用方设置，
+// 调用方需持有分片写锁
+func (c *GoCacheUsecase) popLocked(buf *CacheBuffer, key string, entry CacheItem, head bool) string {
+	n := len(entry.List)
+	var value string
+	var rest []string
+	if head {
+		value, rest = entry.List[0], entry.List[1:]
+	} else {
+		value, rest = entry.List[n-1], entry.List[:n-1]
+	}
+	if n == 1 {
+		c.removeLocked(buf, key)
+		c.counters.deletes.Add(1)
+		return value
+	}
+	entry.List = append([]string(nil), rest...)
+	buf.set(key, entry)
+	return value
+}
+
+// withElements 返回插入 values 后的列表副本，list 本身不会被修改。
+// 与 Redis 相同，LPUSH a b c 逐个插入头部，结果为 c b a
+func withElements(list []string, head bool, values []string) []string {
+	copied := make([]string, 0, len(list)+len(values))
+	if !head {
+		copied = append(copied, list...)
+		return append(copied, values...)
+	}
+	for i := len(values) - 1; i >= 0; i-- {
+		copied = append(copied, values[i])
+	}
+	return append(copied, list...)
+}
+
+// replayPush 重放 LPUSH/RPUSH 记录: LPUSH key value...。运行时遇到已过期的键会先记录 DEL，
+// 这里不再判断过期，直接在已有的列表上修改并保留其过期时间
+func (c *GoCacheUsecase) replayPush(key string, head bool, values []string, version uint64) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.List == nil {
+		entry = inheritPin(entry, CacheItem{})
+	}
+	entry.List = withElements(entry.List, head, values)
+	entry.Version = shard.active.replayedVersion(key, version)
+	shard.active.set(key, entry)
+}
+
+// replayPop 重放 LPOP/RPOP 记录: LPOP key
+func (c *GoCacheUsecase) replayPop(key string, head bool, version uint64) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.List == nil {
+		return
+	}
+	entry.Version = shard.active.replayedVersion(key, version)
+	c.popLocked(shard.active, key, entry, head)
+}
+
+// replayLRestore 重放 LRESTORE 记录: LRESTORE key expiresAt eventTime values，记录的是整个列表，
+// 由 Expire、Persist 等修改过期时间的操作以及 AOF 重写写入
+func (c *GoCacheUsecase) replayLRestore(key string, expiresAt, eventTime int64, values []string, version uint64) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	if expiresAt != 0 && c.clock.Now().UnixMilli() >= expiresAt {
+		c.removeLocked(shard.active, key)
+		return
+	}
+	entry := CacheItem{List: values, ExpiresAt: expiresAt, EventTime: eventTime, Version: shard.active.replayedVersion(key, version)}
+	if old, exists := shard.active.Data[key]; exists {
+		entry = inheritPin(old, entry)
+	}
+	shard.active.set(key, entry)
+	if expiresAt > 0 {
+		c.timeWheel.Add(key, c.untilMilli(expiresAt))
+	}
+}
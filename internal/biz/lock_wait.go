@@ -0,0 +1,206 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// defaultLockWaitThreshold 未配置 lock_wait_threshold 时，等待时间低于该值的加锁不计入直方图
+const defaultLockWaitThreshold = time.Millisecond
+
+// lockWaitBuckets 直方图各桶的上界，最后一个桶之外的记入 +Inf
+var lockWaitBuckets = [...]time.Duration{
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+}
+
+// LockWaitSnapshot 某把锁的等待时间直方图快照，Counts 比 Buckets 多一个 +Inf 桶
+type LockWaitSnapshot struct {
+	Buckets []time.Duration
+	Counts  []uint64
+	Count   uint64
+	Sum     time.Duration
+}
+
+// lockWaitHistogram 用原子计数实现的等待时间直方图。threshold 在后台任务启动前设置，之后只读
+type lockWaitHistogram struct {
+	counts    [len(lockWaitBuckets) + 1]atomic.Uint64
+	count     atomic.Uint64
+	sum       atomic.Int64
+	threshold time.Duration
+}
+
+func (h *lockWaitHistogram) observe(d time.Duration) {
+	if d < h.threshold {
+		return
+	}
+	i := 0
+	for i < len(lockWaitBuckets) && d > lockWaitBuckets[i] {
+		i++
+	}
+	h.counts[i].Add(1)
+	h.count.Add(1)
+	h.sum.Add(int64(d))
+}
+
+func (h *lockWaitHistogram) snapshot() LockWaitSnapshot {
+	s := LockWaitSnapshot{
+		Buckets: lockWaitBuckets[:],
+		Counts:  make([]uint64, len(h.counts)),
+		Count:   h.count.Load(),
+		Sum:     time.Duration(h.sum.Load()),
+	}
+	for i := range h.counts {
+		s.Counts[i] = h.counts[i].Load()
+	}
+	return s
+}
+
+// timedRWMutex 先 TryLock，失败时才读时钟，无竞争时几乎没有额外开销
+type timedRWMutex struct {
+	sync.RWMutex
+	waits lockWaitHistogram
+}
+
+func (m *timedRWMutex) Lock() {
+	if m.RWMutex.TryLock() {
+		return
+	}
+	start := time.Now()
+	m.RWMutex.Lock()
+	m.waits.observe(time.Since(start))
+}
+
+func (m *timedRWMutex) RLock() {
+	if m.RWMutex.TryRLock() {
+		return
+	}
+	start := time.Now()
+	m.RWMutex.RLock()
+	m.waits.observe(time.Since(start))
+}
+
+// LockContext 与 Lock 相同，但在 ctx 结束时放弃等待并返回 ctx.Err()。
+// 需要等待时由另一个协程调用 Lock，放弃后该协程拿到锁立即释放，因此不会饿死写者
+func (m *timedRWMutex) LockContext(ctx context.Context) error {
+	if m.RWMutex.TryLock() {
+		return nil
+	}
+	return m.waitContext(ctx, m.RWMutex.Lock, m.RWMutex.Unlock)
+}
+
+// RLockContext 与 RLock 相同，但在 ctx 结束时放弃等待并返回 ctx.Err()
+func (m *timedRWMutex) RLockContext(ctx context.Context) error {
+	if m.RWMutex.TryRLock() {
+		return nil
+	}
+	return m.waitContext(ctx, m.RWMutex.RLock, m.RWMutex.RUnlock)
+}
+
+func (m *timedRWMutex) waitContext(ctx context.Context, lock, unlock func()) error {
+	start := time.Now()
+	if ctx.Done() == nil {
+		lock()
+		m.waits.observe(time.Since(start))
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	acquired, abandoned := make(chan struct{}), make(chan struct{})
+	go func() {
+		lock()
+		select {
+		case acquired <- struct{}{}:
+		case <-abandoned:
+			unlock()
+		}
+	}()
+	select {
+	case <-acquired:
+		m.waits.observe(time.Since(start))
+		return nil
+	case <-ctx.Done():
+		close(abandoned)
+		return ctx.Err()
+	}
+}
+
+// timedMutex 与 timedRWMutex 相同，用于互斥锁
+type timedMutex struct {
+	sync.Mutex
+	waits lockWaitHistogram
+}
+
+func (m *timedMutex) Lock() {
+	if m.Mutex.TryLock() {
+		return
+	}
+	start := time.Now()
+	m.Mutex.Lock()
+	m.waits.observe(time.Since(start))
+}
+
+// LockWaits 返回每个分片锁以及时间轮锁的等待时间直方图
+func (c *GoCacheUsecase) LockWaits() (shards []LockWaitSnapshot, timeWheel LockWaitSnapshot) {
+	shards = make([]LockWaitSnapshot, len(c.shards))
+	for i := range c.shards {
+		shards[i] = c.shards[i].mu.waits.snapshot()
+	}
+	return shards, c.timeWheel.mutex.waits.snapshot()
+}
+
+// setLockWaitThreshold 设置所有分片锁和时间轮锁的直方图阈值，<= 0 时使用 defaultLockWaitThreshold
+func (c *GoCacheUsecase) setLockWaitThreshold(threshold time.Duration) {
+	if threshold <= 0 {
+		threshold = defaultLockWaitThreshold
+	}
+	for i := range c.shards {
+		c.shards[i].mu.waits.threshold = threshold
+	}
+	c.timeWheel.mutex.waits.threshold = threshold
+}
+
+// SlowLogEntry 一次耗时超过 slow_log_threshold 的 Get、Set 或 Delete
+type SlowLogEntry struct {
+	Op       string
+	Key      string
+	Duration time.Duration
+	// LockWait 其中等待分片锁的时间，无竞争时为 0
+	LockWait time.Duration
+}
+
+// slowLog 慢请求日志的配置，threshold 为 0 时关闭
+type slowLog struct {
+	threshold time.Duration
+	hook      func(SlowLogEntry)
+}
+
+// WithSlowLogHook 慢请求除了写日志外再交给 hook。hook 在请求的协程中同步调用，不能阻塞；
+// 只在配置了 slow_log_threshold 时调用
+func WithSlowLogHook(hook func(SlowLogEntry)) Option {
+	return func(c *GoCacheUsecase) {
+		c.slowLog.hook = hook
+	}
+}
+
+// observeSlow 耗时 d 不低于 slow_log_threshold 时记录一条慢请求，wait 是其中等待分片锁的时间
+func (c *GoCacheUsecase) observeSlow(ctx context.Context, op, key string, d, wait time.Duration) {
+	if c.slowLog.threshold <= 0 || d < c.slowLog.threshold {
+		return
+	}
+	c.log.WithContext(ctx).Warnf("slow %s key:%s,duration:%v,lockWait:%v", op, key, d, wait)
+	if c.slowLog.hook != nil {
+		c.slowLog.hook(SlowLogEntry{Op: op, Key: key, Duration: d, LockWait: wait})
+	}
+}
@@ -0,0 +1,170 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"gocache-service/internal/conf"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// holdLock 持有 mu 的写锁 hold 时间，期间调用 wait 并等它返回
+func holdLock(mu *timedRWMutex, hold time.Duration, wait func()) {
+	mu.Lock()
+	done := make(chan struct{})
+	go func() {
+		wait()
+		close(done)
+	}()
+	time.Sleep(hold)
+	mu.Unlock()
+	<-done
+}
+
+func TestLockWaitHistogramRecordsContention(t *testing.T) {
+	var mu timedRWMutex
+	mu.waits.threshold = time.Millisecond
+	holdLock(&mu, 20*time.Millisecond, func() {
+		mu.Lock()
+		mu.Unlock()
+	})
+	holdLock(&mu, 20*time.Millisecond, func() {
+		mu.RLock()
+		mu.RUnlock()
+	})
+	s := mu.waits.snapshot()
+	if s.Count != 2 {
+		t.Fatalf("Count = %d, want 2", s.Count)
+	}
+	if s.Sum < 20*time.Millisecond {
+		t.Fatalf("Sum = %v, want at least 20ms", s.Sum)
+	}
+	var total uint64
+	for _, n := range s.Counts {
+		total += n
+	}
+	if total != s.Count || len(s.Counts) != len(s.Buckets)+1 {
+		t.Fatalf("Counts = %v for %d buckets, want %d observations", s.Counts, len(s.Buckets), s.Count)
+	}
+}
+
+func TestLockWaitHistogramSkipsWaitsBelowThreshold(t *testing.T) {
+	var mu timedRWMutex
+	mu.waits.threshold = time.Hour
+	holdLock(&mu, 5*time.Millisecond, func() {
+		mu.Lock()
+		mu.Unlock()
+	})
+	if s := mu.waits.snapshot(); s.Count != 0 {
+		t.Fatalf("Count = %d, want 0", s.Count)
+	}
+}
+
+func TestSlowLogReportsLockWait(t *testing.T) {
+	var mu sync.Mutex
+	var entries []SlowLogEntry
+	c := newTestCache(t, &conf.Data_Cache{
+		LockWaitThreshold: durationpb.New(time.Millisecond),
+		SlowLogThreshold:  durationpb.New(5 * time.Millisecond),
+	}, nil, WithSlowLogHook(func(e SlowLogEntry) {
+		mu.Lock()
+		defer mu.Unlock()
+		entries = append(entries, e)
+	}))
+	ctx := context.Background()
+	if err := c.Set(ctx, "fast", "v", 0); err != nil {
+		t.Fatal(err)
+	}
+	shard := c.getShard("k")
+	holdLock(&shard.mu, 20*time.Millisecond, func() {
+		if err := c.Set(ctx, "k", "v", 0); err != nil {
+			t.Error(err)
+		}
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(entries) != 1 {
+		t.Fatalf("slow log entries = %+v, want one for k", entries)
+	}
+	if e := entries[0]; e.Op != "set" || e.Key != "k" || e.LockWait < 20*time.Millisecond || e.Duration < e.LockWait {
+		t.Fatalf("slow log entry = %+v", e)
+	}
+	stats, err := c.Stats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := stats.Shards[c.shardIndex("k")].LockWait.Count; got != 1 {
+		t.Fatalf("shard lock wait count = %d, want 1", got)
+	}
+}
+
+// 写锁被长时间持有时，读操作在 lock_timeout 后放弃并返回 context.DeadlineExceeded，而不是一直等待
+func TestReadsGiveUpAfterLockTimeout(t *testing.T) {
+	c := newTestCache(t, &conf.Data_Cache{LockTimeout: durationpb.New(10 * time.Millisecond)}, nil)
+	ctx := context.Background()
+	if err := c.Set(ctx, "k", "v", 0); err != nil {
+		t.Fatal(err)
+	}
+	shard := c.getShard("k")
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	for name, read := range map[string]func() error{
+		"Get":         func() error { _, err := c.Get(ctx, "k"); return err },
+		"TTL":         func() error { _, err := c.TTL(ctx, "k"); return err },
+		"Type":        func() error { _, err := c.Type(ctx, "k"); return err },
+		"MemoryUsage": func() error { _, err := c.MemoryUsage(ctx, "k"); return err },
+		"MGet":        func() error { _, err := c.MGet(ctx, []string{"k"}); return err },
+		"Exists":      func() error { _, err := c.Exists(ctx, "k"); return err },
+		"Keys":        func() error { _, err := c.Keys(ctx, "*"); return err },
+		"Scan": func() error {
+			for cursor := uint64(0); ; {
+				_, next, err := c.Scan(ctx, cursor, "*", 10)
+				if err != nil || next == 0 {
+					return err
+				}
+				cursor = next
+			}
+		},
+	} {
+		if err := read(); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("%s with the shard write-locked: err = %v, want context.DeadlineExceeded", name, err)
+		}
+	}
+}
+
+func BenchmarkLockUncontended(b *testing.B) {
+	b.Run("sync.RWMutex", func(b *testing.B) {
+		var mu sync.RWMutex
+		for i := 0; i < b.N; i++ {
+			mu.Lock()
+			mu.Unlock()
+		}
+	})
+	b.Run("timedRWMutex", func(b *testing.B) {
+		var mu timedRWMutex
+		mu.waits.threshold = defaultLockWaitThreshold
+		for i := 0; i < b.N; i++ {
+			mu.Lock()
+			mu.Unlock()
+		}
+	})
+}
+
+func BenchmarkGetUncontended(b *testing.B) {
+	ctx := context.Background()
+	c := newTestCache(b, nil, nil)
+	if err := c.Set(ctx, "k", "v", 0); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.Get(ctx, "k"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package biz
+
+import "time"
+
+// Metrics 缓存内部的监控指标上报接口。Get、Set 等热路径上每次调用都会上报，
+// 实现只能做原子计数和直方图观测这类开销很小的操作，不能加锁或做 I/O
+type Metrics interface {
+	// ObserveGet 一次 Get 的耗时，hit 表示是否返回了值
+	ObserveGet(hit bool, d time.Duration)
+	ObserveSet(d time.Duration)
+	ObserveDelete(d time.Duration)
+	// SetKeyCount 分片 shard 当前的键数(包括已过期但尚未删除的键)，每次定期清理后上报
+	SetKeyCount(shard, n int)
+	// ObserveAOFQueueDepth AOF 写入队列中等待的命令数，写入协程每处理完一批上报一次
+	ObserveAOFQueueDepth(n int)
+	// IncExpired 因过期被删除的键数
+	IncExpired(n int)
+	// ObserveWheelTick 时间轮一格内删除到期键的耗时
+	ObserveWheelTick(d time.Duration)
+	// SetLockWaits 各分片锁和时间轮锁的等待时间直方图(累计值)，每轮过期抽样前上报
+	SetLockWaits(shards []LockWaitSnapshot, timeWheel LockWaitSnapshot)
+}
+
+// NopMetrics 不上报任何指标，未注入 Metrics 时使用
+type NopMetrics struct{}
+
+func (NopMetrics) ObserveGet(bool, time.Duration)                    {}
+func (NopMetrics) ObserveSet(time.Duration)                          {}
+func (NopMetrics) ObserveDelete(time.Duration)                       {}
+func (NopMetrics) SetKeyCount(int, int)                              {}
+func (NopMetrics) ObserveAOFQueueDepth(int)                          {}
+func (NopMetrics) IncExpired(int)                                    {}
+func (NopMetrics) ObserveWheelTick(time.Duration)                    {}
+func (NopMetrics) SetLockWaits([]LockWaitSnapshot, LockWaitSnapshot) {}
@@ -0,0 +1,128 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+)
+
+// PinnedKeys ListPinned 的结果
+type PinnedKeys struct {
+	Keys  []string
+	Bytes int64
+}
+
+// pinnedCounters 被固定的键数与字节数(键长+值长，与 ListPinned 相同)。CacheBuffer 写入和删除条目时
+// 按新旧条目的固定状态增减，Pin、Unpin、覆盖、删除、过期和淘汰都经过这两个方法，计数不需要单独维护
+type pinnedCounters struct {
+	keys  atomic.Int64
+	bytes atomic.Int64
+}
+
+// add 条目被固定时按 sign(1 或 -1)计入或移出
+func (p *pinnedCounters) add(key string, entry CacheItem, sign int64) {
+	if !entry.Pinned {
+		return
+	}
+	p.keys.Add(sign)
+	p.bytes.Add(sign * pinnedSize(key, entry))
+}
+
+// pinnedSize 固定键占用的字节数
+func pinnedSize(key string, entry CacheItem) int64 {
+	return int64(len(key) + len(entry.Value))
+}
+
+// inheritPin 覆盖写入时保留旧条目的固定状态，忽略 TTL 的固定会清掉新条目的过期时间
+func inheritPin(old, entry CacheItem) CacheItem {
+	if !old.Pinned {
+		return entry
+	}
+	entry.Pinned = true
+	entry.PinTTLOverride = old.PinTTLOverride
+	if entry.PinTTLOverride {
+		entry.ExpiresAt = 0
+	}
+	return entry
+}
+
+// Pin 固定一个已存在的键，ttlOverride 为 true 时同时清除其过期时间且之后不允许再设置
+func (c *GoCacheUsecase) Pin(ctx context.Context, key string, ttlOverride bool) error {
+	c.log.WithContext(ctx).Infof("pin key:%s,ttlOverride:%v", key, ttlOverride)
+	if err := c.writable(); err != nil {
+		return err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return err
+	}
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) {
+		return ErrKeyNotFound
+	}
+	entry.Pinned = true
+	entry.PinTTLOverride = ttlOverride
+	if ttlOverride {
+		entry.ExpiresAt = 0
+		c.timeWheel.Remove(key)
+	}
+	shard.active.set(key, entry)
+	return c.repo.Write(ctx, AOFCommand{Op: AOFPin, Key: key, TTLOverride: ttlOverride})
+}
+
+// Unpin 取消固定，之前被忽略的 TTL 不会恢复
+func (c *GoCacheUsecase) Unpin(ctx context.Context, key string) error {
+	c.log.WithContext(ctx).Infof("unpin key:%s", key)
+	if err := c.writable(); err != nil {
+		return err
+	}
+	shard := c.getShard(key)
+	if err := c.lockShard(ctx, shard); err != nil {
+		return err
+	}
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists || entry.expired(c.clock.Now().UnixMilli()) {
+		return ErrKeyNotFound
+	}
+	entry.Pinned = false
+	entry.PinTTLOverride = false
+	shard.active.set(key, entry)
+	return c.repo.Write(ctx, AOFCommand{Op: AOFUnpin, Key: key})
+}
+
+// ListPinned 列出所有被固定的键及其占用的字节数(键长+值长)
+func (c *GoCacheUsecase) ListPinned(ctx context.Context) (PinnedKeys, error) {
+	var result PinnedKeys
+	now := c.clock.Now().UnixMilli()
+	for i := range c.shards {
+		c.shards[i].mu.RLock()
+		for key, entry := range c.shards[i].active.Data {
+			if entry.Pinned && !entry.expired(now) {
+				result.Keys = append(result.Keys, key)
+				result.Bytes += pinnedSize(key, entry)
+			}
+		}
+		c.shards[i].mu.RUnlock()
+	}
+	sort.Strings(result.Keys)
+	return result, nil
+}
+
+// replayPin 重放 PIN/UNPIN 记录
+func (c *GoCacheUsecase) replayPin(key string, pinned, ttlOverride bool) {
+	shard := c.getShard(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	entry, exists := shard.active.Data[key]
+	if !exists {
+		return
+	}
+	entry.Pinned = pinned
+	entry.PinTTLOverride = ttlOverride
+	if ttlOverride {
+		entry.ExpiresAt = 0
+	}
+	shard.active.set(key, entry)
+}
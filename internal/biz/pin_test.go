@@ -0,0 +1,146 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"gocache-service/internal/conf"
+)
+
+// pin 写入 keys 并固定它们
+func pin(t *testing.T, c *GoCacheUsecase, keys ...string) {
+	t.Helper()
+	ctx := context.Background()
+	for _, key := range keys {
+		if err := c.Set(ctx, key, "v", 0); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.Pin(ctx, key, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestEvictionSkipsPinnedKeys(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCache(t, &conf.Data_Cache{Shards: 1, MaxKeys: 10}, nil)
+	pinned := []string{"cfg:0", "cfg:1", "cfg:2", "cfg:3", "cfg:4"}
+	pin(t, c, pinned...)
+	for i := 0; i < 100; i++ {
+		if err := c.Set(ctx, fmt.Sprintf("k%d", i), "v", 0); err != nil {
+			t.Fatalf("Set(k%d): %v", i, err)
+		}
+	}
+	for _, key := range pinned {
+		if _, err := c.Get(ctx, key); err != nil {
+			t.Fatalf("pinned %s evicted: %v", key, err)
+		}
+	}
+	if n, _ := c.DBSize(ctx); n != 10 {
+		t.Fatalf("DBSize = %d, want 10", n)
+	}
+	if stats, _ := c.Stats(ctx); stats.Eviction.Evicted != 95 {
+		t.Fatalf("evicted %d keys, want 95", stats.Eviction.Evicted)
+	}
+
+	// 只剩固定的键可以淘汰时拒绝写入新键
+	full := newTestCache(t, &conf.Data_Cache{Shards: 1, MaxKeys: 2}, nil)
+	pin(t, full, "cfg:a", "cfg:b")
+	if err := full.Set(ctx, "new", "v", 0); err != ErrCacheFull {
+		t.Fatalf("Set with only pinned keys err = %v, want ErrCacheFull", err)
+	}
+}
+
+func TestPatternDeletesNeedIncludePinned(t *testing.T) {
+	ctx := context.Background()
+	c := newTestCache(t, nil, nil)
+	pin(t, c, "cfg:pinned")
+	for _, key := range []string{"cfg:plain", "other"} {
+		if err := c.Set(ctx, key, "v", 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n, err := c.FlushByPrefix(ctx, "cfg:", false); err != nil || n != 1 {
+		t.Fatalf("FlushByPrefix = %d, %v, want 1", n, err)
+	}
+	if _, err := c.Get(ctx, "cfg:pinned"); err != nil {
+		t.Fatalf("FlushByPrefix without include_pinned deleted the pinned key: %v", err)
+	}
+	if n, err := c.FlushAll(ctx, false); err != nil || n != 1 {
+		t.Fatalf("FlushAll = %d, %v, want 1", n, err)
+	}
+	if keys, _ := c.Keys(ctx, "*"); !reflect.DeepEqual(keys, []string{"cfg:pinned"}) {
+		t.Fatalf("keys after FlushAll = %v, want only the pinned key", keys)
+	}
+
+	if n, err := c.FlushByPrefix(ctx, "cfg:", true); err != nil || n != 1 {
+		t.Fatalf("FlushByPrefix with include_pinned = %d, %v, want 1", n, err)
+	}
+	pin(t, c, "cfg:pinned")
+	if n, err := c.FlushAll(ctx, true); err != nil || n != 1 {
+		t.Fatalf("FlushAll with include_pinned = %d, %v, want 1", n, err)
+	}
+	if stats, _ := c.Stats(ctx); stats.PinnedKeys != 0 || stats.PinnedBytes != 0 {
+		t.Fatalf("pinned %d keys, %d bytes after FlushAll, want none", stats.PinnedKeys, stats.PinnedBytes)
+	}
+}
+
+func TestPinSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	repo := &memRepo{}
+	c := newTestCache(t, nil, repo)
+	pin(t, c, "cfg:kept", "cfg:unpinned")
+	if err := c.Set(ctx, "cfg:ttl", "v", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Pin(ctx, "cfg:ttl", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Unpin(ctx, "cfg:unpinned"); err != nil {
+		t.Fatal(err)
+	}
+	// 覆盖写入保留固定状态
+	if err := c.Set(ctx, "cfg:kept", "v2", 0); err != nil {
+		t.Fatal(err)
+	}
+	want, err := c.ListPinned(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(want.Keys, []string{"cfg:kept", "cfg:ttl"}) {
+		t.Fatalf("pinned keys = %v", want.Keys)
+	}
+
+	check := func(c *GoCacheUsecase, when string) {
+		t.Helper()
+		got, err := c.ListPinned(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("pinned %s = %+v, want %+v", when, got, want)
+		}
+		if stats, _ := c.Stats(ctx); stats.PinnedKeys != 2 || stats.PinnedBytes != want.Bytes {
+			t.Fatalf("Stats %s: pinned %d keys, %d bytes, want 2 keys, %d bytes", when, stats.PinnedKeys, stats.PinnedBytes, want.Bytes)
+		}
+		if v, err := c.Get(ctx, "cfg:kept"); err != nil || v != "v2" {
+			t.Fatalf("Get(cfg:kept) %s = %q, %v, want v2", when, v, err)
+		}
+		// 忽略 TTL 的固定同样保留
+		if ttl, err := c.TTL(ctx, "cfg:ttl"); err != nil || ttl != NoExpiration {
+			t.Fatalf("TTL(cfg:ttl) %s = %v, %v, want no expiration", when, ttl, err)
+		}
+	}
+	// PIN 和 UNPIN 记录的重放
+	restarted := newTestCache(t, nil, repo)
+	check(restarted, "after restart")
+	// FlushAll 清空 AOF 后固定的键以 LOAD 记录重新写入
+	if _, err := restarted.FlushAll(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	check(newTestCache(t, nil, repo), "after FlushAll and restart")
+}
@@ -0,0 +1,130 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Pipeline 支持的命令
+const (
+	CommandSet    = "SET"
+	CommandDel    = "DEL"
+	CommandExpire = "EXPIRE"
+)
+
+// Command Pipeline 中的一条命令：SET 使用 Key、Value 和 TTL，DEL 只使用 Key，
+// EXPIRE 使用 Key 和 TTL(ttl <= 0 时删除键)
+type Command struct {
+	Op    string
+	Key   string
+	Value string
+	TTL   time.Duration
+}
+
+// Result 一条命令的执行结果。OK 对 SET 表示已写入，对 DEL 表示删除前键仍存活，
+// 对 EXPIRE 表示键存在且已修改；Err 为该命令自身的错误，不影响同批的其他命令
+type Result struct {
+	OK  bool
+	Err error
+}
+
+// Pipeline 按提交顺序执行一批命令，结果与 cmds 一一对应。涉及的分片按序号从小到大各加一次写锁，
+// 直到整批执行完才释放，读者不会看到只执行了一部分的批次。成功修改数据的命令在 AOF 中合并为一条
+// MULTI 记录，重放时整体应用。返回的 error 只表示 ctx 已取消或 AOF 写入失败
+func (c *GoCacheUsecase) Pipeline(ctx context.Context, cmds []Command) ([]Result, error) {
+	c.log.WithContext(ctx).Infof("pipeline commands:%d", len(cmds))
+	if err := c.writable(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	results := make([]Result, len(cmds))
+	keys := make([]string, len(cmds))
+	for i, cmd := range cmds {
+		keys[i] = cmd.Key
+	}
+	unlock, err := c.lockKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	record := AOFCommand{Op: AOFMulti, Commands: make([]AOFCommand, 0, len(cmds))}
+	for i, cmd := range cmds {
+		var command *AOFCommand
+		results[i].OK, command, results[i].Err = c.execLocked(cmd)
+		if command != nil {
+			record.Commands = append(record.Commands, *command)
+		}
+	}
+	if len(record.Commands) == 0 {
+		return results, nil
+	}
+	return results, c.repo.Write(ctx, record)
+}
+
+// execLocked 执行一条命令，返回结果以及需要记录到 AOF 的命令(没有修改数据时为 nil)，调用方需持有键所在分片的写锁
+func (c *GoCacheUsecase) execLocked(cmd Command) (bool, *AOFCommand, error) {
+	buf := c.getShard(cmd.Key).active
+	now := c.clock.Now().UnixMilli()
+	switch cmd.Op {
+	case CommandSet:
+		ttl := c.jitterTTL(cmd.TTL)
+		entry, err := c.storeLocked(buf, cmd.Key, c.newCacheItem(cmd.Value, ttl, now), ttl)
+		if err != nil {
+			return false, nil, err
+		}
+		c.counters.sets.Add(1)
+		record := EntryRecord(cmd.Key, entry)
+		return true, &record, nil
+	case CommandDel:
+		entry, exists := buf.Data[cmd.Key]
+		if !exists {
+			return false, nil, nil
+		}
+		live := !entry.expired(now)
+		if live {
+			c.counters.deletes.Add(1)
+		}
+		c.removeLocked(buf, cmd.Key)
+		return live, &AOFCommand{Op: AOFDel, Key: cmd.Key}, nil
+	case CommandExpire:
+		entry, exists := buf.Data[cmd.Key]
+		if !exists || entry.expired(now) {
+			return false, nil, nil
+		}
+		if entry.PinTTLOverride {
+			return false, nil, ErrKeyPinned
+		}
+		if cmd.TTL <= 0 {
+			c.removeLocked(buf, cmd.Key)
+			c.counters.deletes.Add(1)
+			return true, &AOFCommand{Op: AOFDel, Key: cmd.Key}, nil
+		}
+		entry.ExpiresAt = c.clock.Now().Add(cmd.TTL).UnixMilli()
+		entry, err := c.storeLocked(buf, cmd.Key, entry, cmd.TTL)
+		if err != nil {
+			return false, nil, err
+		}
+		record := EntryRecord(cmd.Key, entry)
+		return true, &record, nil
+	default:
+		return false, nil, ErrUnknownCommand
+	}
+}
+
+// replayMulti 重放 MULTI 记录。先检查整批命令，其中有嵌套的 MULTI 或 LOAD 时整批跳过，不会只应用一部分
+func (c *GoCacheUsecase) replayMulti(commands []AOFCommand) error {
+	for _, command := range commands {
+		if command.Op == AOFMulti || command.Op == AOFLoad {
+			return fmt.Errorf("cache: %v command in MULTI record", command.Op)
+		}
+	}
+	for _, command := range commands {
+		if err := c.replayCommand(command); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,433 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 主从复制：每条写入 AOF 的命令同时按顺序追加到复制积压缓冲区并分配递增的偏移。从节点以
+// (复制 ID, 已应用的偏移) 连接主节点，偏移仍在积压缓冲区中时从断开处继续(部分同步)，否则先
+// 接收全部键的快照再接着接收之后的命令(全量同步)。复制 ID 在进程启动时随机生成，主节点重启后
+// 从节点总是全量同步。
+//
+// 从节点拒绝本地写入，过期键由主节点复制的 DEL 和条目自带的 ExpiresAt 决定：读取时按 ExpiresAt
+// 判断为不存在，定期扫描按 ExpiresAt 回收内存，时间轮不会在从节点上删除键。
+
+const (
+	// defaultReplicationBacklog 未配置时积压缓冲区保留的命令数
+	defaultReplicationBacklog = 1024
+	// replicationBatch 每次从积压缓冲区取出的最大命令数
+	replicationBatch = 256
+	// replicationChunkBytes 全量同步时一条 LOAD 记录包含的条目的估算大小上限
+	replicationChunkBytes = 1 << 20
+	// replicationHeartbeat 没有新命令时主节点发送心跳的间隔，用于发现已断开的连接
+	replicationHeartbeat = time.Second
+	// replicaRetryInterval 从节点与主节点断开后重连的间隔
+	replicaRetryInterval = time.Second
+)
+
+// errReplicationOffset 从节点的偏移不在积压缓冲区中，需要重新全量同步
+var errReplicationOffset = errors.New("cache: replication offset is no longer in the backlog")
+
+// ReplicationEvent 复制流中的一项。FullSync 为 true 时从节点清空数据，开始接收位于
+// (ReplicationID, Offset) 的快照，偏移与之相同的 LOAD 是快照数据，之后的第一项(心跳或下一条命令)
+// 表示快照已收完，从节点这时才把位置设为该偏移；Command 为 nil 的事件是心跳，其余命令的偏移必须依次加一
+type ReplicationEvent struct {
+	ReplicationID string
+	Offset        int64
+	FullSync      bool
+	Command       *AOFCommand
+}
+
+// LeaderClient 从节点连接主节点的客户端
+type LeaderClient interface {
+	// Replicate 请求从 (replicationID, offset) 之后开始的复制流，把收到的事件依次交给 apply，
+	// 直到连接断开、apply 返回错误或 ctx 被取消
+	Replicate(ctx context.Context, replicationID string, offset int64, apply func(ReplicationEvent) error) error
+}
+
+// ReplicationStats 复制状态。主节点的 Offset 是最新命令的偏移，从节点的 Offset 是已应用的偏移
+type ReplicationStats struct {
+	// Role RoleStandalone、RoleLeader(有从节点连接时)或 RoleReplica
+	Role          string
+	ReplicationID string
+	Offset        int64
+	// Followers 当前连接的从节点数，只对主节点有意义
+	Followers int
+	// LeaderConnected 从节点是否正在接收主节点的复制流
+	LeaderConnected bool
+}
+
+// replicationLog 复制积压缓冲区，按偏移保存最近写入的命令
+type replicationLog struct {
+	id string
+
+	mu      sync.Mutex
+	offset  int64
+	entries []replicationEntry
+	// notify 有从节点在等待新命令时非空，追加命令后关闭
+	notify chan struct{}
+
+	followers atomic.Int32
+	// closed 关闭后所有复制流结束
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+type replicationEntry struct {
+	offset  int64
+	command AOFCommand
+}
+
+func newReplicationLog(size int) *replicationLog {
+	if size <= 0 {
+		size = defaultReplicationBacklog
+	}
+	id := make([]byte, 20)
+	_, _ = rand.Read(id)
+	return &replicationLog{
+		id:      hex.EncodeToString(id),
+		entries: make([]replicationEntry, size),
+		closed:  make(chan struct{}),
+	}
+}
+
+func (l *replicationLog) append(command AOFCommand) {
+	l.mu.Lock()
+	l.offset++
+	l.entries[l.offset%int64(len(l.entries))] = replicationEntry{offset: l.offset, command: command}
+	if l.notify != nil {
+		close(l.notify)
+		l.notify = nil
+	}
+	l.mu.Unlock()
+}
+
+func (l *replicationLog) current() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.offset
+}
+
+// contains 判断从 offset 之后继续是否还能从积压缓冲区中取到全部命令
+func (l *replicationLog) contains(offset int64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return offset <= l.offset && offset >= l.offset-int64(len(l.entries))
+}
+
+// read 返回 offset 之后最多 max 条命令，没有新命令时返回在下一条命令追加后关闭的通道
+func (l *replicationLog) read(offset int64, max int) ([]replicationEntry, <-chan struct{}, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	size := int64(len(l.entries))
+	if offset > l.offset || offset < l.offset-size {
+		return nil, nil, errReplicationOffset
+	}
+	if offset == l.offset {
+		if l.notify == nil {
+			l.notify = make(chan struct{})
+		}
+		return nil, l.notify, nil
+	}
+	n := l.offset - offset
+	if n > int64(max) {
+		n = int64(max)
+	}
+	entries := make([]replicationEntry, n)
+	for i := range entries {
+		entries[i] = l.entries[(offset+1+int64(i))%size]
+	}
+	return entries, nil, nil
+}
+
+// replicatedRepo 在写入 AOF 之前把命令追加到复制积压缓冲区。调用方在持有分片锁时写入，
+// 因此同一个键的命令在积压缓冲区中的顺序与内存中的修改顺序一致
+type replicatedRepo struct {
+	CacheRepo
+	log *replicationLog
+}
+
+func (r *replicatedRepo) Write(ctx context.Context, command AOFCommand) error {
+	r.log.append(command)
+	return r.CacheRepo.Write(ctx, command)
+}
+
+// Truncate 清空 AOF，从节点收到 FLUSHALL 后同样清空全部数据
+func (r *replicatedRepo) Truncate(ctx context.Context) error {
+	if err := r.CacheRepo.Truncate(ctx); err != nil {
+		return err
+	}
+	r.log.append(AOFCommand{Op: AOFFlushAll})
+	return nil
+}
+
+// replicaState 从节点的复制位置，只由复制协程修改
+type replicaState struct {
+	leader    LeaderClient
+	mu        sync.Mutex
+	id        string
+	offset    int64
+	connected atomic.Bool
+	// snapshot 正在接收的全量同步快照的位置，收完之前位置为空，中途断开后重新全量同步
+	snapshot *replicaPosition
+}
+
+type replicaPosition struct {
+	id     string
+	offset int64
+}
+
+func (r *replicaState) position() (string, int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.id, r.offset
+}
+
+func (r *replicaState) setPosition(id string, offset int64) {
+	r.mu.Lock()
+	r.id, r.offset = id, offset
+	r.mu.Unlock()
+}
+
+// beginSnapshot 清空位置并记下正在接收的快照的位置
+func (r *replicaState) beginSnapshot(id string, offset int64) {
+	r.mu.Lock()
+	r.id, r.offset = "", 0
+	r.snapshot = &replicaPosition{id: id, offset: offset}
+	r.mu.Unlock()
+}
+
+// endSnapshot 快照收完，位置设为快照的位置。没有正在接收的快照时返回 false
+func (r *replicaState) endSnapshot() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.snapshot == nil {
+		return false
+	}
+	r.id, r.offset = r.snapshot.id, r.snapshot.offset
+	r.snapshot = nil
+	return true
+}
+
+// snapshotOffset 返回正在接收的快照的偏移
+func (r *replicaState) snapshotOffset() (int64, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.snapshot == nil {
+		return 0, false
+	}
+	return r.snapshot.offset, true
+}
+
+// writable 从节点拒绝本地写入，reject 策略下剩余空间低于下限时同样拒绝
+func (c *GoCacheUsecase) writable() error {
+	if c.replica != nil {
+		return ErrReadOnlyReplica
+	}
+	return c.diskWritable()
+}
+
+// Replicate 向从节点发送复制流，直到 send 返回错误、ctx 被取消或调用了 CloseReplication。
+// (replicationID, offset) 与本节点匹配且偏移仍在积压缓冲区中时从 offset 之后继续，否则先全量同步。
+// 从节点处理过慢、偏移被积压缓冲区覆盖时返回错误，从节点重连后会重新全量同步
+func (c *GoCacheUsecase) Replicate(ctx context.Context, replicationID string, offset int64, send func(ReplicationEvent) error) error {
+	c.log.WithContext(ctx).Infof("replicate replicationID:%s,offset:%d", replicationID, offset)
+	c.replication.followers.Add(1)
+	defer c.replication.followers.Add(-1)
+	if replicationID == c.replication.id && c.replication.contains(offset) {
+		if err := send(ReplicationEvent{ReplicationID: c.replication.id, Offset: offset}); err != nil {
+			return err
+		}
+	} else {
+		var err error
+		if offset, err = c.fullSync(send); err != nil {
+			return err
+		}
+	}
+	heartbeat := time.NewTicker(replicationHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		entries, wait, err := c.replication.read(offset, replicationBatch)
+		if err != nil {
+			return err
+		}
+		for i := range entries {
+			entry := &entries[i]
+			if err := send(ReplicationEvent{Offset: entry.offset, Command: &entry.command}); err != nil {
+				return err
+			}
+			offset = entry.offset
+		}
+		if wait == nil {
+			continue
+		}
+		select {
+		case <-wait:
+		case <-heartbeat.C:
+			if err := send(ReplicationEvent{Offset: offset}); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.replication.closed:
+			return nil
+		}
+	}
+}
+
+// CloseReplication 结束所有从节点的复制流。与订阅过期事件的流一样，需要在 gRPC 的
+// GracefulStop 之前调用，从节点随后会重连并从断开处继续
+func (c *GoCacheUsecase) CloseReplication() {
+	c.replication.closeOnce.Do(func() { close(c.replication.closed) })
+}
+
+// fullSync 按分片序号持有全部分片的读锁，记下当前偏移并复制所有未过期的条目，
+// 释放锁之后以 LOAD 记录分块发送，返回快照对应的偏移
+func (c *GoCacheUsecase) fullSync(send func(ReplicationEvent) error) (int64, error) {
+	for i := range c.shards {
+		c.shards[i].mu.RLock()
+	}
+	offset := c.replication.current()
+	now := c.clock.Now().UnixMilli()
+	shards := make([]map[string]CacheItem, len(c.shards))
+	for i := range c.shards {
+		shards[i] = make(map[string]CacheItem, len(c.shards[i].active.Data))
+		for key, entry := range c.shards[i].active.Data {
+			if !entry.expired(now) {
+				shards[i][key] = entry
+			}
+		}
+	}
+	for i := range c.shards {
+		c.shards[i].mu.RUnlock()
+	}
+	if err := send(ReplicationEvent{ReplicationID: c.replication.id, Offset: offset, FullSync: true}); err != nil {
+		return 0, err
+	}
+	chunk, size := make(map[string]CacheItem), int64(0)
+	for _, items := range shards {
+		for key, entry := range items {
+			chunk[key] = entry
+			if size += entrySize(key, entry); size < replicationChunkBytes {
+				continue
+			}
+			if err := send(ReplicationEvent{Offset: offset, Command: &AOFCommand{Op: AOFLoad, Items: chunk}}); err != nil {
+				return 0, err
+			}
+			chunk, size = make(map[string]CacheItem), 0
+		}
+	}
+	if len(chunk) > 0 {
+		if err := send(ReplicationEvent{Offset: offset, Command: &AOFCommand{Op: AOFLoad, Items: chunk}}); err != nil {
+			return 0, err
+		}
+	}
+	// 心跳表示快照结束，没有新命令时从节点也不必等到下一次心跳
+	if err := send(ReplicationEvent{Offset: offset}); err != nil {
+		return 0, err
+	}
+	return offset, nil
+}
+
+// startReplica 从节点的复制协程，断开后按 replicaRetryInterval 重连，重连时从已应用的偏移继续
+func (c *GoCacheUsecase) startReplica() {
+	defer c.wg.Done()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-c.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	for {
+		id, offset := c.replica.position()
+		err := c.replica.leader.Replicate(ctx, id, offset, func(ev ReplicationEvent) error {
+			c.replica.connected.Store(true)
+			return c.applyReplicated(ctx, ev)
+		})
+		c.replica.connected.Store(false)
+		if ctx.Err() != nil {
+			return
+		}
+		_, offset = c.replica.position()
+		c.log.Warnf("replication from leader stopped at offset %d: %v, reconnecting", offset, err)
+		select {
+		case <-time.After(replicaRetryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// applyReplicated 应用主节点的一项复制事件，命令同时写入本节点的 AOF，重启后仍可读取
+func (c *GoCacheUsecase) applyReplicated(ctx context.Context, ev ReplicationEvent) error {
+	if ev.FullSync {
+		c.log.Infof("full sync from leader %s at offset %d", ev.ReplicationID, ev.Offset)
+		if _, err := c.flushAll(ctx, true); err != nil {
+			return err
+		}
+		c.replica.beginSnapshot(ev.ReplicationID, ev.Offset)
+		return nil
+	}
+	if offset, ok := c.replica.snapshotOffset(); ok {
+		if ev.Command != nil && ev.Command.Op == AOFLoad && ev.Offset == offset {
+			if err := c.replayCommand(*ev.Command); err != nil {
+				c.log.Warnf("replication skipped snapshot chunk at offset %d: %v", ev.Offset, err)
+			} else if err := c.repo.Write(ctx, *ev.Command); err != nil {
+				c.log.Errorf("write replicated snapshot at offset %d to AOF err: %v", ev.Offset, err)
+			}
+			return nil
+		}
+		c.replica.endSnapshot()
+	}
+	if ev.Command == nil {
+		return nil
+	}
+	id, offset := c.replica.position()
+	if ev.Offset != offset+1 {
+		return fmt.Errorf("cache: replication offset %d does not follow %d", ev.Offset, offset)
+	}
+	if ev.Command.Op == AOFFlushAll {
+		if _, err := c.flushAll(ctx, true); err != nil {
+			return err
+		}
+	} else if err := c.replayCommand(*ev.Command); err != nil {
+		c.log.Warnf("replication skipped command %v at offset %d: %v", *ev.Command, ev.Offset, err)
+	} else if err := c.repo.Write(ctx, *ev.Command); err != nil {
+		c.log.Errorf("write replicated command at offset %d to AOF err: %v", ev.Offset, err)
+	}
+	c.replica.setPosition(id, ev.Offset)
+	return nil
+}
+
+// ReplicationStats 返回本节点的复制状态
+func (c *GoCacheUsecase) ReplicationStats() ReplicationStats {
+	if c.replica != nil {
+		id, offset := c.replica.position()
+		return ReplicationStats{
+			Role:            RoleReplica,
+			ReplicationID:   id,
+			Offset:          offset,
+			LeaderConnected: c.replica.connected.Load(),
+		}
+	}
+	stats := ReplicationStats{
+		Role:          RoleStandalone,
+		ReplicationID: c.replication.id,
+		Offset:        c.replication.current(),
+		Followers:     int(c.replication.followers.Load()),
+	}
+	if stats.Followers > 0 {
+		stats.Role = RoleLeader
+	}
+	return stats
+}
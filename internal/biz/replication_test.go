@@ -0,0 +1,293 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gocache-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// replicationLag 从节点追上主节点允许的最长时间
+const replicationLag = 2 * time.Second
+
+// localLeader 在进程内把主节点的复制流交给从节点，命令经过编码和解码，与 gRPC 传输时一样不共享内存
+type localLeader struct {
+	leader    *GoCacheUsecase
+	fullSyncs atomic.Int32
+	// dropSnapshots 大于 0 时在全量同步的第一块快照之后断开，每断开一次减一
+	dropSnapshots atomic.Int32
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+func (l *localLeader) Replicate(ctx context.Context, replicationID string, offset int64, apply func(ReplicationEvent) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	l.mu.Lock()
+	l.cancel = cancel
+	l.mu.Unlock()
+	var snapshot bool
+	return l.leader.Replicate(ctx, replicationID, offset, func(ev ReplicationEvent) error {
+		if ev.FullSync {
+			l.fullSyncs.Add(1)
+			snapshot = true
+		}
+		if ev.Command != nil {
+			command, err := DecodeCommand(EncodeCommand(*ev.Command))
+			if err != nil {
+				return err
+			}
+			ev.Command = &command
+		}
+		if err := apply(ev); err != nil {
+			return err
+		}
+		if snapshot && ev.Command != nil && ev.Command.Op == AOFLoad && l.dropSnapshots.Add(-1) >= 0 {
+			return errors.New("connection lost during the snapshot")
+		}
+		return nil
+	})
+}
+
+// disconnect 断开当前的复制流，从节点随后重连
+func (l *localLeader) disconnect() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.cancel != nil {
+		l.cancel()
+	}
+}
+
+// newTestReplica 创建复制 leader 的从节点并在测试结束时关闭
+func newTestReplica(t *testing.T, leader *localLeader) *GoCacheUsecase {
+	t.Helper()
+	c, _, err := NewGoCacheUsecase(&conf.Data{Cache: &conf.Data_Cache{}}, &memRepo{}, nil, leader, log.NewStdLogger(io.Discard))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = c.Close(context.Background()) })
+	return c
+}
+
+// waitFor 在 replicationLag 内等待 cond 成立
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(replicationLag)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("%s not reached within %v", what, replicationLag)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// waitCaughtUp 等待从节点应用到主节点当前的偏移
+func waitCaughtUp(t *testing.T, leader, replica *GoCacheUsecase) {
+	t.Helper()
+	offset := leader.ReplicationStats().Offset
+	waitFor(t, fmt.Sprintf("replica offset %d", offset), func() bool {
+		return replica.ReplicationStats().Offset >= offset
+	})
+}
+
+func TestReplicaFollowsLeader(t *testing.T) {
+	ctx := context.Background()
+	leader := newTestCache(t, nil, nil)
+	// 复制开始之前的数据通过全量同步到达
+	if err := leader.Set(ctx, "before", "v", 0); err != nil {
+		t.Fatal(err)
+	}
+	replica := newTestReplica(t, &localLeader{leader: leader})
+
+	for i := 0; i < 200; i++ {
+		key := fmt.Sprintf("k%d", i)
+		if err := leader.Set(ctx, key, fmt.Sprint(i), time.Hour); err != nil {
+			t.Fatal(err)
+		}
+		// 每次写入之后从节点都在限定的延迟内读到同样的值
+		waitFor(t, "Get("+key+") on the replica", func() bool {
+			v, err := replica.Get(ctx, key)
+			return err == nil && v == fmt.Sprint(i)
+		})
+	}
+	if err := leader.Delete(ctx, "k0"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := leader.Incr(ctx, "k1", 10); err != nil {
+		t.Fatal(err)
+	}
+	waitCaughtUp(t, leader, replica)
+
+	if v, err := replica.Get(ctx, "before"); err != nil || v != "v" {
+		t.Fatalf("Get(before) on the replica = %q, %v, want v", v, err)
+	}
+	if _, err := replica.Get(ctx, "k0"); err != ErrKeyNotFound {
+		t.Fatalf("Get(k0) on the replica err = %v, want ErrKeyNotFound", err)
+	}
+	if v, err := replica.Get(ctx, "k1"); err != nil || v != "11" {
+		t.Fatalf("Get(k1) on the replica = %q, %v, want 11", v, err)
+	}
+	if ttl, err := replica.TTL(ctx, "k2"); err != nil || ttl <= 0 || ttl > time.Hour {
+		t.Fatalf("TTL(k2) on the replica = %v, %v, want up to 1h", ttl, err)
+	}
+	leaderSize, _ := leader.DBSize(ctx)
+	if replicaSize, _ := replica.DBSize(ctx); replicaSize != leaderSize {
+		t.Fatalf("replica has %d keys, leader %d", replicaSize, leaderSize)
+	}
+	if err := replica.Set(ctx, "local", "v", 0); !errors.Is(err, ErrReadOnlyReplica) {
+		t.Fatalf("Set on the replica err = %v, want ErrReadOnlyReplica", err)
+	}
+}
+
+func TestReplicaResumesAfterDisconnect(t *testing.T) {
+	ctx := context.Background()
+	leader := newTestCache(t, nil, nil)
+	link := &localLeader{leader: leader}
+	replica := newTestReplica(t, link)
+
+	if err := leader.Set(ctx, "a", "1", 0); err != nil {
+		t.Fatal(err)
+	}
+	waitCaughtUp(t, leader, replica)
+	link.disconnect()
+	waitFor(t, "replica disconnected", func() bool { return !replica.ReplicationStats().LeaderConnected })
+	// 断开期间的写入在重连后从已应用的偏移继续复制，不再全量同步
+	if err := leader.Set(ctx, "b", "2", 0); err != nil {
+		t.Fatal(err)
+	}
+	offset := leader.ReplicationStats().Offset
+	deadline := time.Now().Add(replicaRetryInterval + replicationLag)
+	for replica.ReplicationStats().Offset < offset {
+		if time.Now().After(deadline) {
+			t.Fatalf("replica did not resume to offset %d", offset)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if v, err := replica.Get(ctx, "b"); err != nil || v != "2" {
+		t.Fatalf("Get(b) on the replica = %q, %v, want 2", v, err)
+	}
+	if n := link.fullSyncs.Load(); n != 1 {
+		t.Fatalf("%d full syncs, want only the initial one", n)
+	}
+}
+
+// dump 返回 c 中所有键的值和过期时间
+func dump(t *testing.T, c *GoCacheUsecase) map[string]ExportEntry {
+	t.Helper()
+	entries := make(map[string]ExportEntry)
+	err := c.Export(context.Background(), 0, func(batch []ExportEntry) error {
+		for _, entry := range batch {
+			entry.EventTime = 0
+			entries[entry.Key] = entry
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entries
+}
+
+func TestReplicaFullSyncDuringConcurrentWrites(t *testing.T) {
+	const preload, writers, minWrites = 3000, 4, 1000
+	ctx := context.Background()
+	// 积压缓冲区足够大，全量同步期间的写入不会让从节点掉出积压缓冲区而重新全量同步
+	leader := newTestCache(t, &conf.Data_Cache{ReplicationBacklog: 1 << 16}, nil)
+	for i := 0; i < preload; i++ {
+		if err := leader.Set(ctx, fmt.Sprintf("k%d", i), "0", 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 写入者在从节点连接和全量同步期间一直在改写、删除和新增键，
+	// 快照之前、之间和之后的写入都必须在从节点上得到与主节点相同的结果
+	link := &localLeader{leader: leader}
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			// 看到全量同步开始之后再写 minWrites 次
+			synced := -1
+			for i := 0; synced < 0 || i < synced+minWrites; i++ {
+				if synced < 0 && link.fullSyncs.Load() > 0 {
+					synced = i
+				}
+				var err error
+				switch key := fmt.Sprintf("k%d", (i*writers+w)%preload); i % 4 {
+				case 0:
+					err = leader.Set(ctx, key, fmt.Sprintf("w%d-%d", w, i), 0)
+				case 1:
+					_, err = leader.Incr(ctx, fmt.Sprintf("counter%d", w), 1)
+				case 2:
+					err = leader.Delete(ctx, key)
+				default:
+					err = leader.Set(ctx, fmt.Sprintf("new-w%d-%d", w, i), "v", time.Hour)
+				}
+				if err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(w)
+	}
+	replica := newTestReplica(t, link)
+	wg.Wait()
+	waitCaughtUp(t, leader, replica)
+
+	want, got := dump(t, leader), dump(t, replica)
+	if len(want) < preload/2 {
+		t.Fatalf("leader has only %d keys", len(want))
+	}
+	for key, entry := range want {
+		if !reflect.DeepEqual(got[key], entry) {
+			t.Fatalf("replica has %+v for %s, leader %+v", got[key], key, entry)
+		}
+	}
+	for key := range got {
+		if _, ok := want[key]; !ok {
+			t.Fatalf("replica has %s, which the leader deleted", key)
+		}
+	}
+	if n := link.fullSyncs.Load(); n != 1 {
+		t.Fatalf("%d full syncs, want 1", n)
+	}
+}
+
+func TestReplicaRestartsFullSyncCutShort(t *testing.T) {
+	ctx := context.Background()
+	leader := newTestCache(t, nil, nil)
+	// 每个值约占半块快照，快照至少分成三块
+	big := strings.Repeat("x", replicationChunkBytes/2)
+	for i := 0; i < 6; i++ {
+		if err := leader.Set(ctx, fmt.Sprintf("k%d", i), big, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	link := &localLeader{leader: leader}
+	link.dropSnapshots.Store(1)
+	replica := newTestReplica(t, link)
+
+	// 只收到一部分快照的从节点不能从快照的偏移继续，重连后重新全量同步
+	deadline := time.Now().Add(replicaRetryInterval + replicationLag)
+	for link.fullSyncs.Load() < 2 || replica.ReplicationStats().Offset < leader.ReplicationStats().Offset {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d full syncs, replica offset %d", link.fullSyncs.Load(), replica.ReplicationStats().Offset)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if n, _ := replica.DBSize(ctx); n != 6 {
+		t.Fatalf("replica has %d keys after the second full sync, want 6", n)
+	}
+}
@@ -0,0 +1,116 @@
+package biz
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultRewriteCheckInterval 后台检查 AOF 是否需要重写的间隔
+	defaultRewriteCheckInterval = 10 * time.Second
+	// defaultRewriteMinSize 未配置时自动重写的最小文件大小，与 Redis 的 auto-aof-rewrite-min-size 默认值相同
+	defaultRewriteMinSize = 64 << 20
+)
+
+// aofRewriter 自动重写的阈值与上次重写后的文件大小
+type aofRewriter struct {
+	// mu 同一时间只进行一次重写
+	mu sync.Mutex
+	// percentage 文件相对 baseSize 增长的百分比达到该值时自动重写，0 表示关闭自动重写
+	percentage int64
+	minSize    int64
+	// baseSize 启动时或上次重写后的文件大小
+	baseSize atomic.Int64
+}
+
+// RewriteResult 一次 AOF 重写的结果
+type RewriteResult struct {
+	Keys    int
+	OldSize int64
+	NewSize int64
+}
+
+// needsRewrite 判断文件是否已超过最小大小且相对 base 增长了 percentage%
+func needsRewrite(size, base, minSize, percentage int64) bool {
+	if percentage <= 0 || size < minSize {
+		return false
+	}
+	if base <= 0 {
+		return true
+	}
+	return (size-base)*100/base >= percentage
+}
+
+// RewriteAOF 用内存中的现有数据重建 AOF，每个存活的键只保留一条 SET 记录(固定的键再加一条 PIN)。
+// 逐个分片加读锁拍快照，不会在整个重写期间持有锁；重写期间的写入追加在新文件末尾，不会丢失
+func (c *GoCacheUsecase) RewriteAOF(ctx context.Context) (RewriteResult, error) {
+	c.rewrite.mu.Lock()
+	defer c.rewrite.mu.Unlock()
+	stats, err := c.repo.AOFStats(ctx)
+	if err != nil {
+		return RewriteResult{}, err
+	}
+	result := RewriteResult{OldSize: stats.FileSize}
+	result.NewSize, err = c.repo.RewriteAOF(ctx, func(emit func(items map[string]CacheItem)) error {
+		result.Keys, err = c.snapshotShards(ctx, emit)
+		return err
+	})
+	if err != nil {
+		c.log.WithContext(ctx).Errorf("rewrite AOF err: %v", err)
+		return RewriteResult{}, err
+	}
+	c.rewrite.baseSize.Store(result.NewSize)
+	c.log.WithContext(ctx).Infof("rewrote AOF with %d keys, %d -> %d bytes", result.Keys, result.OldSize, result.NewSize)
+	return result, nil
+}
+
+// snapshotShards 逐个分片在读锁下复制未过期的条目并交给 emit，返回复制的键数
+func (c *GoCacheUsecase) snapshotShards(ctx context.Context, emit func(items map[string]CacheItem)) (int, error) {
+	keys := 0
+	for i := range c.shards {
+		if err := ctx.Err(); err != nil {
+			return keys, err
+		}
+		shard := &c.shards[i]
+		now := c.clock.Now().UnixMilli()
+		shard.mu.RLock()
+		items := make(map[string]CacheItem, len(shard.active.Data))
+		for key, entry := range shard.active.Data {
+			if !entry.expired(now) {
+				items[key] = entry
+			}
+		}
+		emit(items)
+		shard.mu.RUnlock()
+		keys += len(items)
+	}
+	return keys, nil
+}
+
+// startAOFRewriter 定期检查 AOF 大小，超过阈值时在后台重写
+func (c *GoCacheUsecase) startAOFRewriter() {
+	defer c.wg.Done()
+	ctx := context.Background()
+	if stats, err := c.repo.AOFStats(ctx); err == nil {
+		c.rewrite.baseSize.Store(stats.FileSize)
+	}
+	ticker := time.NewTicker(defaultRewriteCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			stats, err := c.repo.AOFStats(ctx)
+			if err != nil {
+				c.log.WithContext(ctx).Errorf("AOF stats err: %v", err)
+				continue
+			}
+			if needsRewrite(stats.FileSize, c.rewrite.baseSize.Load(), c.rewrite.minSize, c.rewrite.percentage) {
+				_, _ = c.RewriteAOF(ctx)
+			}
+		case <-c.stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// cacheCounters 读写路径上的计数，全部使用原子操作，不增加锁竞争
+type cacheCounters struct {
+	hits    atomic.Uint64
+	misses  atomic.Uint64
+	expired atomic.Uint64
+	sets    atomic.Uint64
+	deletes atomic.Uint64
+}
+
+// replayStats 启动时重放 AOF 的结果，只在 NewGoCacheUsecase 中写入一次
+type replayStats struct {
+	replayed int
+	skipped  int
+}
+
+// AOFStats AOF 文件与写入队列的状态
+type AOFStats struct {
+	FileSize   int64
+	QueueDepth int
+	// WriteErrors 写入或刷盘失败的次数
+	WriteErrors uint64
+	// QueueRejected 因写入队列已满被拒绝的命令数
+	QueueRejected uint64
+	// DiskFree AOF 所在文件系统的剩余字节数，DiskTotal 总字节数，为 0 表示未知，不检查磁盘空间
+	DiskFree  int64
+	DiskTotal int64
+}
+
+// ShardStats 单个分片的键数统计
+type ShardStats struct {
+	Keys int
+	// PeakKeys 自上次重建以来的最大键数
+	PeakKeys int
+	// LockWait 分片锁的等待时间直方图
+	LockWait LockWaitSnapshot
+}
+
+// Stats 缓存运行时统计，只包含数值字段，方便以后直接导出为监控指标
+type Stats struct {
+	Keys   int
+	Hits   uint64
+	Misses uint64
+	// Expired 因过期被删除的键数
+	Expired uint64
+	// Sets 成功写入的键数，包括 Set、SetNX、SetIfNewer、MSet 和 Incr
+	Sets uint64
+	// Deletes 客户端删除的存活键数，包括 Delete、CompareAndDelete、MDel 和 ttl <= 0 的 Expire
+	Deletes          uint64
+	AOFFileSize      int64
+	AOFQueueDepth    int
+	AOFWriteErrors   uint64
+	AOFQueueRejected uint64
+	// AOFReplayed 启动时从快照和 AOF 重放的命令数，AOFReplaySkipped 其中因格式错误被跳过的命令数
+	AOFReplayed      int
+	AOFReplaySkipped int
+	// PinnedKeys 被固定的键数，PinnedBytes 它们占用的字节数(键长+值长)，包括已过期但尚未删除的键
+	PinnedKeys   int64
+	PinnedBytes  int64
+	Uptime       time.Duration
+	Shards       []ShardStats
+	Defrag       DefragStats
+	Eviction     EvictionStats
+	Replication  ReplicationStats
+	ExpireSample ExpireSampleStats
+	// TimeWheelLockWait 时间轮锁的等待时间直方图
+	TimeWheelLockWait LockWaitSnapshot
+	Disk              DiskStats
+}
+
+// Stats 返回命中率相关计数、各分片的键数与锁等待直方图、固定键计数、AOF 与剩余空间的状态以及整理任务、淘汰与过期抽样的累计计数
+func (c *GoCacheUsecase) Stats(ctx context.Context) (Stats, error) {
+	aof, err := c.repo.AOFStats(ctx)
+	if err != nil {
+		return Stats{}, err
+	}
+	stats := Stats{
+		Hits:             c.counters.hits.Load(),
+		Misses:           c.counters.misses.Load(),
+		Expired:          c.counters.expired.Load(),
+		Sets:             c.counters.sets.Load(),
+		Deletes:          c.counters.deletes.Load(),
+		AOFFileSize:      aof.FileSize,
+		AOFQueueDepth:    aof.QueueDepth,
+		AOFWriteErrors:   aof.WriteErrors,
+		AOFQueueRejected: aof.QueueRejected,
+		AOFReplayed:      c.replay.replayed,
+		AOFReplaySkipped: c.replay.skipped,
+		PinnedKeys:       c.pinned.keys.Load(),
+		PinnedBytes:      c.pinned.bytes.Load(),
+		Uptime:           time.Since(c.startedAt),
+		Shards:           make([]ShardStats, len(c.shards)),
+		Replication:      c.ReplicationStats(),
+	}
+	lockWaits, timeWheelLockWait := c.LockWaits()
+	stats.TimeWheelLockWait = timeWheelLockWait
+	for i := range c.shards {
+		c.shards[i].mu.RLock()
+		stats.Shards[i] = ShardStats{
+			Keys:     len(c.shards[i].active.Data),
+			PeakKeys: c.shards[i].active.peak,
+			LockWait: lockWaits[i],
+		}
+		c.shards[i].mu.RUnlock()
+		stats.Keys += stats.Shards[i].Keys
+	}
+	stats.Defrag = DefragStats{
+		Passes:           c.defrag.passes.Load(),
+		ShardsRebuilt:    c.defrag.shardsRebuilt.Load(),
+		ReclaimedBuckets: c.defrag.reclaimedBuckets.Load(),
+	}
+	stats.Eviction = EvictionStats{
+		Policy:    c.policy,
+		MaxKeys:   c.maxKeys,
+		MaxBytes:  c.maxBytes,
+		UsedBytes: c.usedBytes.Load(),
+		Evicted:   c.eviction.evicted.Load(),
+		Rejected:  c.eviction.rejected.Load(),
+	}
+	stats.ExpireSample = ExpireSampleStats{
+		Examined:    c.sampler.examined.Load(),
+		Expired:     c.sampler.expired.Load(),
+		TimeLimited: c.sampler.timeLimited.Load(),
+	}
+	stats.Disk = c.DiskStats()
+	return stats, nil
+}
+
+// HitRatio 读请求的命中率，没有读请求时为 0
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
@@ -1,45 +1,100 @@
 package biz
 
 import (
-	"context"
 	"sync"
 	"time"
 )
 
+// wheelEntry 时间轮中的一个定时项
+type wheelEntry struct {
+	expiresAt time.Time
+	// rounds 还需要转过的整圈数，为 0 时才会在本槽位触发
+	rounds int
+}
+
 // TimeWheel 结构体用于管理过期数据
 type TimeWheel struct {
-	slots []map[string]int64
-	tick  time.Duration
-	index int
-	stop  chan struct{}
-	wg    sync.WaitGroup
-	cache *GoCacheUsecase
-	mutex sync.Mutex
+	slots []map[string]*wheelEntry
+	// positions 键所在的槽位，每个键在时间轮中最多只有一项
+	positions map[string]int
+	tick      time.Duration
+	index     int
+	stop      chan struct{}
+	wg        sync.WaitGroup
+	cache     *GoCacheUsecase
+	mutex     timedMutex
+	// clock 计算定时项的到期时间，与 cache 使用同一个时钟
+	clock Clock
 }
 
 // NewTimeWheel 创建一个新的时间轮
 func NewTimeWheel(slots int, tick time.Duration, cache *GoCacheUsecase) *TimeWheel {
 	tw := &TimeWheel{
-		slots: make([]map[string]int64, slots),
-		tick:  tick,
-		index: 0,
-		stop:  make(chan struct{}),
-		cache: cache,
+		slots:     make([]map[string]*wheelEntry, slots),
+		positions: make(map[string]int),
+		tick:      tick,
+		index:     0,
+		stop:      make(chan struct{}),
+		cache:     cache,
+		clock:     cache.clock,
 	}
 	for i := range tw.slots {
-		tw.slots[i] = make(map[string]int64)
+		tw.slots[i] = make(map[string]*wheelEntry)
 	}
 	tw.wg.Add(1)
 	go tw.run()
 	return tw
 }
 
-// Add 向时间轮添加一个键和过期时间
+// Add 向时间轮添加一个键和过期时间，替换该键之前的定时项；expiration <= 0 表示永不过期，只移除旧项
 func (tw *TimeWheel) Add(key string, expiration time.Duration) {
 	tw.mutex.Lock()
 	defer tw.mutex.Unlock()
-	slotIndex := (tw.index + int(expiration/tw.tick)) % len(tw.slots)
-	tw.slots[slotIndex][key] = time.Now().Add(expiration).Unix()
+	if expiration <= 0 {
+		tw.removeLocked(key)
+		return
+	}
+	tw.schedule(key, tw.clock.Now().Add(expiration), expiration)
+}
+
+// Remove 移除键的定时项，键被删除或不再过期时调用
+func (tw *TimeWheel) Remove(key string) {
+	tw.mutex.Lock()
+	defer tw.mutex.Unlock()
+	tw.removeLocked(key)
+}
+
+func (tw *TimeWheel) removeLocked(key string) {
+	if slot, ok := tw.positions[key]; ok {
+		delete(tw.slots[slot], key)
+		delete(tw.positions, key)
+	}
+}
+
+// Reset 清空所有定时项
+func (tw *TimeWheel) Reset() {
+	tw.mutex.Lock()
+	defer tw.mutex.Unlock()
+	for i := range tw.slots {
+		tw.slots[i] = make(map[string]*wheelEntry)
+	}
+	tw.positions = make(map[string]int)
+}
+
+// schedule 把键放入 delay 之后的槽位，超过一圈的部分记为圈数，调用方需持有 mutex
+func (tw *TimeWheel) schedule(key string, expiresAt time.Time, delay time.Duration) {
+	// 向上取整，保证不会早于真实过期时间触发
+	ticks := int((delay + tw.tick - 1) / tw.tick)
+	if ticks < 1 {
+		ticks = 1
+	}
+	tw.removeLocked(key)
+	slotIndex := (tw.index + ticks) % len(tw.slots)
+	tw.positions[key] = slotIndex
+	tw.slots[slotIndex][key] = &wheelEntry{
+		expiresAt: expiresAt,
+		rounds:    (ticks - 1) / len(tw.slots),
+	}
 }
 
 // run 时间轮的运行循环
@@ -50,22 +105,42 @@ func (tw *TimeWheel) run() {
 	for {
 		select {
 		case <-ticker.C:
-			tw.mutex.Lock()
-			tw.index = (tw.index + 1) % len(tw.slots)
-			now := time.Now().Unix()
-			for key, expiresAt := range tw.slots[tw.index] {
-				if now > expiresAt {
-					delete(tw.slots[tw.index], key)
-					_ = tw.cache.Delete(context.Background(), key)
-				}
+			start := time.Now()
+			// 在锁外删除，避免与 Set(先拿分片锁再拿时间轮锁)形成锁顺序反转
+			if expired := tw.advance(); len(expired) > 0 {
+				tw.cache.deleteExpired(expired)
 			}
-			tw.mutex.Unlock()
+			tw.cache.metrics.ObserveWheelTick(time.Since(start))
 		case <-tw.stop:
 			return
 		}
 	}
 }
 
+// advance 前进一格并返回到期的键
+func (tw *TimeWheel) advance() []string {
+	tw.mutex.Lock()
+	defer tw.mutex.Unlock()
+	tw.index = (tw.index + 1) % len(tw.slots)
+	now := tw.clock.Now()
+	var expired []string
+	for key, entry := range tw.slots[tw.index] {
+		if entry.rounds > 0 {
+			entry.rounds--
+			continue
+		}
+		delete(tw.slots[tw.index], key)
+		delete(tw.positions, key)
+		if now.Before(entry.expiresAt) {
+			// 取整误差导致提前到达，按剩余时间重新调度
+			tw.schedule(key, entry.expiresAt, entry.expiresAt.Sub(now))
+			continue
+		}
+		expired = append(expired, key)
+	}
+	return expired
+}
+
 // Close 关闭时间轮
 func (tw *TimeWheel) Close() {
 	close(tw.stop)
@@ -0,0 +1,208 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"gocache-service/internal/conf"
+)
+
+// manualWheel 把 c 的时间轮换成不会自行转动的时间轮，返回的 step 把 clock 推进 d，
+// 时间轮随之逐格转动并删除到期的键，返回时间轮报告到期的键
+func manualWheel(t *testing.T, c *GoCacheUsecase, clock *FakeClock, tick time.Duration) (step func(d time.Duration) []string) {
+	t.Helper()
+	c.timeWheel.Close()
+	tw := &TimeWheel{
+		slots:     make([]map[string]*wheelEntry, defaultWheelSlots),
+		positions: make(map[string]int),
+		tick:      tick,
+		stop:      make(chan struct{}),
+		cache:     c,
+		clock:     clock,
+	}
+	for i := range tw.slots {
+		tw.slots[i] = make(map[string]*wheelEntry)
+	}
+	c.timeWheel = tw
+	return func(d time.Duration) []string {
+		var fired []string
+		for ; d > 0; d -= tick {
+			clock.Advance(tick)
+			expired := tw.advance()
+			c.deleteExpired(expired)
+			fired = append(fired, expired...)
+		}
+		return fired
+	}
+}
+
+func TestTimeWheelOverwriteWithLongerTTL(t *testing.T) {
+	ctx := context.Background()
+	clock := NewFakeClock(time.Now())
+	c := newTestCache(t, nil, nil, WithClock(clock))
+	step := manualWheel(t, c, clock, 100*time.Millisecond)
+
+	if err := c.Set(ctx, "k", "v1", 2*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	step(time.Second)
+	if err := c.Set(ctx, "k", "v2", 60*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if fired := step(2 * time.Second); slices.Contains(fired, "k") {
+		t.Fatal("time wheel fired the entry of the overwritten TTL")
+	}
+	if v, err := c.Get(ctx, "k"); err != nil || v != "v2" {
+		t.Fatalf("Get(k) = %q, %v, want v2", v, err)
+	}
+	if fired := step(58 * time.Second); !slices.Contains(fired, "k") {
+		t.Fatal("time wheel did not fire k at its new expiry")
+	}
+	if n, _ := c.DBSize(ctx); n != 0 {
+		t.Fatalf("DBSize = %d after k expired, want 0", n)
+	}
+}
+
+func TestTimeWheelOverwriteWithShorterTTL(t *testing.T) {
+	ctx := context.Background()
+	clock := NewFakeClock(time.Now())
+	c := newTestCache(t, nil, nil, WithClock(clock))
+	step := manualWheel(t, c, clock, 100*time.Millisecond)
+
+	if err := c.Set(ctx, "k", "v1", 60*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Set(ctx, "k", "v2", time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if fired := step(time.Second); !slices.Contains(fired, "k") {
+		t.Fatal("time wheel did not fire k at its shortened expiry")
+	}
+	if n, _ := c.DBSize(ctx); n != 0 {
+		t.Fatalf("DBSize = %d after k expired, want 0", n)
+	}
+	// 旧的 60s 定时项已被替换，之后重新写入的键不受影响
+	if err := c.Set(ctx, "k", "v3", 0); err != nil {
+		t.Fatal(err)
+	}
+	if fired := step(60 * time.Second); len(fired) != 0 {
+		t.Fatalf("time wheel fired %v for a key without TTL", fired)
+	}
+}
+
+func TestTimeWheelDeleteThenRecreate(t *testing.T) {
+	ctx := context.Background()
+	clock := NewFakeClock(time.Now())
+	c := newTestCache(t, nil, nil, WithClock(clock))
+	step := manualWheel(t, c, clock, 100*time.Millisecond)
+
+	for _, ttl := range []time.Duration{0, 60 * time.Second} {
+		if err := c.Set(ctx, "k", "old", time.Second); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.Delete(ctx, "k"); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := c.timeWheel.positions["k"]; ok {
+			t.Fatal("Delete left k in the time wheel")
+		}
+		if err := c.Set(ctx, "k", "new", ttl); err != nil {
+			t.Fatal(err)
+		}
+		if fired := step(2 * time.Second); slices.Contains(fired, "k") {
+			t.Fatalf("ttl %v: time wheel fired the entry of the deleted key", ttl)
+		}
+		if v, err := c.Get(ctx, "k"); err != nil || v != "new" {
+			t.Fatalf("ttl %v: Get(k) = %q, %v, want new", ttl, v, err)
+		}
+		if err := c.Delete(ctx, "k"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestTimeWheelFiresLongTTLsWithinOneTick(t *testing.T) {
+	const tick = time.Second
+	ctx := context.Background()
+	clock := NewFakeClock(time.Now())
+	c := newTestCache(t, nil, nil, WithClock(clock))
+	step := manualWheel(t, c, clock, tick)
+
+	// 超过一圈(60 格)的 TTL 需要转过整圈之后才触发
+	ttls := map[string]time.Duration{"10s": 10 * time.Second, "90s": 90 * time.Second, "1h": time.Hour}
+	for key, ttl := range ttls {
+		if err := c.Set(ctx, key, "v", ttl); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fired := make(map[string]time.Duration)
+	for elapsed := tick; elapsed <= time.Hour+2*tick; elapsed += tick {
+		for _, key := range step(tick) {
+			if _, dup := fired[key]; dup {
+				t.Fatalf("%s fired twice", key)
+			}
+			fired[key] = elapsed
+		}
+	}
+	for key, ttl := range ttls {
+		at, ok := fired[key]
+		if !ok {
+			t.Fatalf("%s never fired", key)
+		}
+		if at < ttl || at >= ttl+tick {
+			t.Fatalf("%s fired after %v, want within one tick of %v", key, at, ttl)
+		}
+	}
+}
+
+// 重放的键按剩余 TTL 重新放入时间轮，开启 reload_ttl_jitter 时最多延后剩余 TTL 的相应比例
+func TestTimeWheelSchedulesReplayedKeys(t *testing.T) {
+	const tick, stopped = time.Second, 5 * time.Second
+	for _, jitter := range []float64{0, 0.1} {
+		ctx := context.Background()
+		clock := NewFakeClock(time.Now())
+		repo := &memRepo{}
+		c := newTestCache(t, nil, repo, WithClock(clock))
+		ttls := map[string]time.Duration{"10s": 10 * time.Second, "90s": 90 * time.Second, "1h": time.Hour}
+		for key, ttl := range ttls {
+			if err := c.Set(ctx, key, "v", ttl); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := c.Set(ctx, "persistent", "v", 0); err != nil {
+			t.Fatal(err)
+		}
+		clock.Advance(stopped)
+
+		restarted := newTestCache(t, &conf.Data_Cache{ReloadTtlJitter: jitter}, repo, WithClock(clock))
+		// 启动时已经调度过一次，换成手动转动的时间轮后重新调度
+		step := manualWheel(t, restarted, clock, tick)
+		if n := restarted.scheduleReloaded(); n != len(ttls) {
+			t.Fatalf("jitter %v: scheduled %d keys, want %d", jitter, n, len(ttls))
+		}
+		fired := make(map[string]time.Duration)
+		for elapsed := tick; elapsed <= time.Hour+time.Duration(jitter*float64(time.Hour))+2*tick; elapsed += tick {
+			for _, key := range step(tick) {
+				fired[key] = elapsed
+			}
+		}
+		for key, ttl := range ttls {
+			remaining := ttl - stopped
+			at, ok := fired[key]
+			if !ok {
+				t.Fatalf("jitter %v: %s never fired", jitter, key)
+			}
+			if latest := remaining + time.Duration(jitter*float64(remaining)) + tick; at < remaining || at >= latest {
+				t.Fatalf("jitter %v: %s fired %v after the restart, want between %v and %v", jitter, key, at, remaining, latest)
+			}
+		}
+		if _, ok := fired["persistent"]; ok {
+			t.Fatalf("jitter %v: key without TTL fired", jitter)
+		}
+		if keys, _ := restarted.Keys(ctx, "*"); !slices.Equal(keys, []string{"persistent"}) {
+			t.Fatalf("jitter %v: keys after every TTL passed = %v, want [persistent]", jitter, keys)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package biz
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// watchBuffer 每个订阅者缓冲的事件数
+const watchBuffer = 256
+
+// ExpiredEvent 键因过期被删除的通知，客户端的 Delete 等显式删除和淘汰不会产生该事件
+type ExpiredEvent struct {
+	Key string
+	// ExpiresAt 键被删除时的过期时间(Unix 毫秒)
+	ExpiresAt int64
+}
+
+// Watcher 一个过期事件的订阅。事件经由带缓冲的通道投递，订阅者处理不及、缓冲区已满时
+// 新事件直接丢弃并计入 Dropped，过期路径在持有分片锁时发布事件，不能等待订阅者
+type Watcher struct {
+	pattern string
+	events  chan ExpiredEvent
+	dropped atomic.Uint64
+	hub     *watchHub
+}
+
+// Events 返回事件通道，订阅被关闭或缓存关闭时通道关闭
+func (w *Watcher) Events() <-chan ExpiredEvent {
+	return w.events
+}
+
+// Dropped 返回因缓冲区已满被丢弃的事件数
+func (w *Watcher) Dropped() uint64 {
+	return w.dropped.Load()
+}
+
+// Close 取消订阅，可以重复调用
+func (w *Watcher) Close() {
+	w.hub.remove(w)
+}
+
+// expireCallback OnExpire 注册的回调。待回调的键放在不限长度的队列中，发布时只追加不等待，
+// 不会丢失；单独的 goroutine 按过期顺序逐个回调
+type expireCallback struct {
+	fn func(key string)
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	pending []string
+	// closed 不再接收新的键，队列中剩余的键回调完后 goroutine 退出
+	closed bool
+	// cancelled 取消后剩余的键直接丢弃
+	cancelled atomic.Bool
+}
+
+func newExpireCallback(fn func(key string)) *expireCallback {
+	cb := &expireCallback{fn: fn}
+	cb.cond = sync.NewCond(&cb.mu)
+	return cb
+}
+
+func (cb *expireCallback) push(key string) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if cb.closed {
+		return
+	}
+	cb.pending = append(cb.pending, key)
+	cb.cond.Signal()
+}
+
+func (cb *expireCallback) close() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.closed = true
+	cb.cond.Signal()
+}
+
+func (cb *expireCallback) run() {
+	for {
+		cb.mu.Lock()
+		for len(cb.pending) == 0 && !cb.closed {
+			cb.cond.Wait()
+		}
+		keys := cb.pending
+		cb.pending = nil
+		cb.mu.Unlock()
+		if len(keys) == 0 {
+			return
+		}
+		for _, key := range keys {
+			if cb.cancelled.Load() {
+				return
+			}
+			cb.fn(key)
+		}
+	}
+}
+
+// watchHub 汇集时间轮、定期清理和读时惰性删除三条过期路径的事件，分发给所有订阅者和 OnExpire 回调
+type watchHub struct {
+	mu        sync.RWMutex
+	watchers  map[*Watcher]struct{}
+	callbacks map[*expireCallback]struct{}
+	closed    bool
+}
+
+// WatchExpired 订阅匹配 glob 模式的键的过期事件，调用方用完后需调用 Close
+func (c *GoCacheUsecase) WatchExpired(pattern string) (*Watcher, error) {
+	if !validPattern(pattern) {
+		return nil, ErrInvalidPattern
+	}
+	w := &Watcher{pattern: pattern, events: make(chan ExpiredEvent, watchBuffer), hub: &c.watchers}
+	c.watchers.mu.Lock()
+	defer c.watchers.mu.Unlock()
+	if c.watchers.closed {
+		close(w.events)
+		return w, nil
+	}
+	if c.watchers.watchers == nil {
+		c.watchers.watchers = make(map[*Watcher]struct{})
+	}
+	c.watchers.watchers[w] = struct{}{}
+	return w, nil
+}
+
+// OnExpire 注册键因过期被删除时的回调，返回取消注册的函数。时间轮、定期清理和读时惰性删除都会触发，
+// 键在分片写锁下只会被删除一次，因此每次过期恰好回调一次。回调在单独的 goroutine 中按过期顺序执行，
+// 不会阻塞过期路径，也不会因为处理得慢而丢失：未处理的键在内存中排队，回调持续跟不上时队列会一直增长。
+// 取消后不再开始新的回调，排队的键被丢弃；缓存关闭时已过期的键仍会回调完，之后不再回调
+func (c *GoCacheUsecase) OnExpire(fn func(key string)) (cancel func()) {
+	cb := newExpireCallback(fn)
+	c.watchers.mu.Lock()
+	defer c.watchers.mu.Unlock()
+	if c.watchers.closed {
+		return func() {}
+	}
+	if c.watchers.callbacks == nil {
+		c.watchers.callbacks = make(map[*expireCallback]struct{})
+	}
+	c.watchers.callbacks[cb] = struct{}{}
+	go cb.run()
+	return func() {
+		cb.cancelled.Store(true)
+		c.watchers.removeCallback(cb)
+	}
+}
+
+// CloseWatchers 关闭所有订阅并拒绝新的订阅。gRPC 的 GracefulStop 会等待流式调用结束，
+// 需要在停止服务之前调用，否则订阅过期事件的流会一直阻塞退出
+func (c *GoCacheUsecase) CloseWatchers() {
+	c.watchers.mu.Lock()
+	defer c.watchers.mu.Unlock()
+	c.watchers.closed = true
+	for w := range c.watchers.watchers {
+		delete(c.watchers.watchers, w)
+		close(w.events)
+	}
+	for cb := range c.watchers.callbacks {
+		delete(c.watchers.callbacks, cb)
+		cb.close()
+	}
+}
+
+// publish 向匹配的订阅者投递事件并把键加入所有回调的队列，不会阻塞
+func (h *watchHub) publish(key string, expiresAt int64) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for cb := range h.callbacks {
+		cb.push(key)
+	}
+	for w := range h.watchers {
+		if !matchPattern(w.pattern, key) {
+			continue
+		}
+		select {
+		case w.events <- ExpiredEvent{Key: key, ExpiresAt: expiresAt}:
+		default:
+			w.dropped.Add(1)
+		}
+	}
+}
+
+func (h *watchHub) remove(w *Watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.watchers[w]; ok {
+		delete(h.watchers, w)
+		close(w.events)
+	}
+}
+
+func (h *watchHub) removeCallback(cb *expireCallback) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.callbacks[cb]; ok {
+		delete(h.callbacks, cb)
+		cb.close()
+	}
+}
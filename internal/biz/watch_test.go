@@ -0,0 +1,142 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"gocache-service/internal/conf"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// expireCounter 记录 OnExpire 回调每个键的次数
+type expireCounter struct {
+	mu     sync.Mutex
+	counts map[string]int
+	total  int
+}
+
+func (e *expireCounter) add(key string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.counts == nil {
+		e.counts = make(map[string]int)
+	}
+	e.counts[key]++
+	e.total++
+}
+
+func (e *expireCounter) snapshot() (map[string]int, int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	counts := make(map[string]int, len(e.counts))
+	for k, v := range e.counts {
+		counts[k] = v
+	}
+	return counts, e.total
+}
+
+func TestOnExpireCallsEachExpiredKeyExactlyOnce(t *testing.T) {
+	const keys = 4 * watchBuffer
+	ctx := context.Background()
+	c := newTestCache(t, &conf.Data_Cache{
+		TimeWheelTick:        durationpb.New(10 * time.Millisecond),
+		ExpireSampleInterval: durationpb.New(5 * time.Millisecond),
+	}, nil)
+
+	// 回调在所有键过期之前一直阻塞，未处理的键远多于 watchBuffer，不能被丢弃
+	release := make(chan struct{})
+	var counter expireCounter
+	cancel := c.OnExpire(func(key string) {
+		<-release
+		counter.add(key)
+	})
+	defer cancel()
+
+	for i := 0; i < keys; i++ {
+		if err := c.Set(ctx, fmt.Sprintf("k%d", i), "v", 50*time.Millisecond); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := c.Set(ctx, "live", "v", 0); err != nil {
+		t.Fatal(err)
+	}
+	// 时间轮、抽样清理和读时惰性删除同时在删除过期键
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		for i := 0; i < keys; i += 7 {
+			_, _ = c.Get(ctx, fmt.Sprintf("k%d", i))
+		}
+		if n, _ := c.DBSize(ctx); n == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			n, _ := c.DBSize(ctx)
+			t.Fatalf("%d keys left after 5s", n)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	close(release)
+
+	for {
+		if _, total := counter.snapshot(); total >= keys || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	// 多等一会，确认没有重复回调
+	time.Sleep(50 * time.Millisecond)
+	counts, total := counter.snapshot()
+	if total != keys {
+		t.Fatalf("callbacks = %d, want %d", total, keys)
+	}
+	for i := 0; i < keys; i++ {
+		if key := fmt.Sprintf("k%d", i); counts[key] != 1 {
+			t.Fatalf("%s called back %d times, want 1", key, counts[key])
+		}
+	}
+	if counts["live"] != 0 {
+		t.Fatal("callback for a key that did not expire")
+	}
+}
+
+func TestOnExpireCancel(t *testing.T) {
+	ctx := context.Background()
+	clock := NewFakeClock(time.Now())
+	c := newTestCache(t, nil, nil, WithClock(clock))
+
+	var counter expireCounter
+	cancel := c.OnExpire(counter.add)
+	for _, key := range []string{"a", "b"} {
+		if err := c.Set(ctx, key, "v", time.Second); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clock.Advance(2 * time.Second)
+	if _, err := c.Get(ctx, "a"); err != ErrKeyNotFound {
+		t.Fatalf("Get(a) err = %v, want ErrKeyNotFound", err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, total := counter.snapshot(); total == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("no callback for a")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	cancel()
+	if _, err := c.Get(ctx, "b"); err != ErrKeyNotFound {
+		t.Fatalf("Get(b) err = %v, want ErrKeyNotFound", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if counts, total := counter.snapshot(); total != 1 || counts["b"] != 0 {
+		t.Fatalf("callbacks after cancel: %v", counts)
+	}
+}
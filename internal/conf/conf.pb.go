@@ -78,6 +78,7 @@ type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
 	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Resp          *Server_RESP           `protobuf:"bytes,3,opt,name=resp,proto3" json:"resp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,10 +127,18 @@ func (x *Server) GetGrpc() *Server_GRPC {
 	return nil
 }
 
+func (x *Server) GetResp() *Server_RESP {
+	if x != nil {
+		return x.Resp
+	}
+	return nil
+}
+
 type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Cache         *Data_Cache            `protobuf:"bytes,3,opt,name=cache,proto3" json:"cache,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -178,6 +187,13 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (x *Data) GetCache() *Data_Cache {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -298,6 +314,60 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+// Redis RESP2 listener for GET, SET, DEL, TTL, EXPIRE, PING and INFO
+type Server_RESP struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// defaults to 0.0.0.0:6380
+	Addr          string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_RESP) Reset() {
+	*x = Server_RESP{}
+	mi := &file_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_RESP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_RESP) ProtoMessage() {}
+
+func (x *Server_RESP) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_RESP.ProtoReflect.Descriptor instead.
+func (*Server_RESP) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *Server_RESP) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Server_RESP) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
 type Data_Database struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
@@ -308,7 +378,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -320,7 +390,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +432,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -374,7 +444,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,6 +488,438 @@ func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	return nil
 }
 
+type Data_Cache struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// always | everysec | no, defaults to always
+	AofFsync string `protobuf:"bytes,1,opt,name=aof_fsync,json=aofFsync,proto3" json:"aof_fsync,omitempty"`
+	// upper bound on the number of keys, 0 means unlimited
+	MaxKeys int64 `protobuf:"varint,2,opt,name=max_keys,json=maxKeys,proto3" json:"max_keys,omitempty"`
+	// noeviction | allkeys-lru | volatile-ttl, defaults to allkeys-lru
+	EvictionPolicy string `protobuf:"bytes,3,opt,name=eviction_policy,json=evictionPolicy,proto3" json:"eviction_policy,omitempty"`
+	// rewrite the AOF once it has grown by this percentage since the last rewrite, 0 disables automatic rewrites
+	AofRewritePercentage int32 `protobuf:"varint,4,opt,name=aof_rewrite_percentage,json=aofRewritePercentage,proto3" json:"aof_rewrite_percentage,omitempty"`
+	// files smaller than this are never rewritten automatically, defaults to 64MB
+	AofRewriteMinSize int64 `protobuf:"varint,5,opt,name=aof_rewrite_min_size,json=aofRewriteMinSize,proto3" json:"aof_rewrite_min_size,omitempty"`
+	// block | drop | spill: what a write does when the AOF queue is full, defaults to block. spill skips
+	// the queue and returns once the writer has written the command to the file, after the commands
+	// already queued
+	AofQueueFull string `protobuf:"bytes,6,opt,name=aof_queue_full,json=aofQueueFull,proto3" json:"aof_queue_full,omitempty"`
+	// how long block waits for queue space before failing the write, defaults to 1s
+	AofQueueTimeout *durationpb.Duration `protobuf:"bytes,7,opt,name=aof_queue_timeout,json=aofQueueTimeout,proto3" json:"aof_queue_timeout,omitempty"`
+	// how often to save a snapshot of all keys so startup only replays the AOF written after it, 0 disables
+	SnapshotInterval *durationpb.Duration `protobuf:"bytes,8,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	// upper bound on the estimated memory used by keys and values in bytes, shared by all shards, 0 means unlimited
+	MaxBytes int64 `protobuf:"varint,9,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// number of shards, each with its own lock; must be a power of two up to 65536, defaults to 32
+	Shards int32 `protobuf:"varint,10,opt,name=shards,proto3" json:"shards,omitempty"`
+	// reject FlushAll and FlushByPrefix, e.g. in production
+	DisableFlush bool `protobuf:"varint,11,opt,name=disable_flush,json=disableFlush,proto3" json:"disable_flush,omitempty"`
+	// path of the AOF file, snapshots and temporary files are created next to it; defaults to cache.aof
+	DataFile string `protobuf:"bytes,12,opt,name=data_file,json=dataFile,proto3" json:"data_file,omitempty"`
+	// length of the AOF write queue, defaults to 1000
+	AofQueueSize int32 `protobuf:"varint,13,opt,name=aof_queue_size,json=aofQueueSize,proto3" json:"aof_queue_size,omitempty"`
+	// how often to scan all shards for expired keys missed by the time wheel when expire_full_sweep is set, defaults to 30s
+	CleanupInterval *durationpb.Duration `protobuf:"bytes,14,opt,name=cleanup_interval,json=cleanupInterval,proto3" json:"cleanup_interval,omitempty"`
+	// number of time wheel slots, defaults to 60
+	TimeWheelSlots int32 `protobuf:"varint,15,opt,name=time_wheel_slots,json=timeWheelSlots,proto3" json:"time_wheel_slots,omitempty"`
+	// time wheel tick, defaults to 1s
+	TimeWheelTick *durationpb.Duration `protobuf:"bytes,16,opt,name=time_wheel_tick,json=timeWheelTick,proto3" json:"time_wheel_tick,omitempty"`
+	// string values at least this many bytes are stored gzip-compressed in memory and in the AOF, 0 disables
+	CompressThreshold int64 `protobuf:"varint,17,opt,name=compress_threshold,json=compressThreshold,proto3" json:"compress_threshold,omitempty"`
+	// refuse to start when replaying the AOF skips more than aof_replay_max_skipped malformed records
+	AofReplayFailFast   bool  `protobuf:"varint,18,opt,name=aof_replay_fail_fast,json=aofReplayFailFast,proto3" json:"aof_replay_fail_fast,omitempty"`
+	AofReplayMaxSkipped int64 `protobuf:"varint,19,opt,name=aof_replay_max_skipped,json=aofReplayMaxSkipped,proto3" json:"aof_replay_max_skipped,omitempty"`
+	// most commands written and fsynced together in one AOF batch, defaults to 512
+	AofBatchSize int32 `protobuf:"varint,20,opt,name=aof_batch_size,json=aofBatchSize,proto3" json:"aof_batch_size,omitempty"`
+	// how long the AOF writer waits for more commands before writing a batch that is not full, 0 writes what is already queued
+	AofFlushDelay *durationpb.Duration `protobuf:"bytes,21,opt,name=aof_flush_delay,json=aofFlushDelay,proto3" json:"aof_flush_delay,omitempty"`
+	// gRPC address of a leader to replicate from; the instance then serves reads only
+	ReplicaOf string `protobuf:"bytes,22,opt,name=replica_of,json=replicaOf,proto3" json:"replica_of,omitempty"`
+	// commands kept for followers to resume from after a reconnect, defaults to 1024
+	ReplicationBacklog int32 `protobuf:"varint,23,opt,name=replication_backlog,json=replicationBacklog,proto3" json:"replication_backlog,omitempty"`
+	// keys reloaded from the AOF are removed by the time wheel up to this fraction of their
+	// remaining TTL late (0.1 = 10%), so a burst written together does not expire in one tick
+	ReloadTtlJitter float64 `protobuf:"fixed64,24,opt,name=reload_ttl_jitter,json=reloadTtlJitter,proto3" json:"reload_ttl_jitter,omitempty"`
+	// longest a read or write request waits for a shard lock before failing with a deadline error, 0 waits until the request's own deadline
+	LockTimeout *durationpb.Duration `protobuf:"bytes,25,opt,name=lock_timeout,json=lockTimeout,proto3" json:"lock_timeout,omitempty"`
+	// longest string value Append may produce in bytes, 0 means unlimited
+	MaxValueSize int64 `protobuf:"varint,26,opt,name=max_value_size,json=maxValueSize,proto3" json:"max_value_size,omitempty"`
+	// how often to sample shards for expired keys missed by the time wheel, defaults to 100ms
+	ExpireSampleInterval *durationpb.Duration `protobuf:"bytes,27,opt,name=expire_sample_interval,json=expireSampleInterval,proto3" json:"expire_sample_interval,omitempty"`
+	// keys examined per shard in one sampling round, defaults to 20
+	ExpireSampleKeys int32 `protobuf:"varint,28,opt,name=expire_sample_keys,json=expireSampleKeys,proto3" json:"expire_sample_keys,omitempty"`
+	// longest one sampling pass may run before resuming from the same shard next time, defaults to 5ms
+	ExpireSampleBudget *durationpb.Duration `protobuf:"bytes,29,opt,name=expire_sample_budget,json=expireSampleBudget,proto3" json:"expire_sample_budget,omitempty"`
+	// also scan every key under each shard's read lock every cleanup_interval; slow with many keys, for debugging
+	ExpireFullSweep bool `protobuf:"varint,30,opt,name=expire_full_sweep,json=expireFullSweep,proto3" json:"expire_full_sweep,omitempty"`
+	// TTLs given to Set, SetNX, SetIfNewer, SetIfVersion, CompareAndSwap, MSet and pipeline SET are randomly
+	// shortened or lengthened by up to this fraction (0.05 = ±5%) so keys written together do not expire
+	// together; one MSet shares a single TTL. 0 disables, must be below 1
+	TtlJitter float64 `protobuf:"fixed64,31,opt,name=ttl_jitter,json=ttlJitter,proto3" json:"ttl_jitter,omitempty"`
+	// every write waits until its own AOF batch is written (and fsynced with aof_fsync always) and returns
+	// that batch's error instead of the last one seen; slower, as the shard lock is held across the disk write
+	AofDurableWrites bool `protobuf:"varint,32,opt,name=aof_durable_writes,json=aofDurableWrites,proto3" json:"aof_durable_writes,omitempty"`
+	// remove expired keys without appending DEL records; replay already skips records whose expiry has
+	// passed, so this only saves AOF writes, and the stale records stay in the file until the next rewrite
+	AofSkipExpired bool `protobuf:"varint,33,opt,name=aof_skip_expired,json=aofSkipExpired,proto3" json:"aof_skip_expired,omitempty"`
+	// track the most accessed keys of Get and Set per shard for TopKeys
+	HotKeys bool `protobuf:"varint,34,opt,name=hot_keys,json=hotKeys,proto3" json:"hot_keys,omitempty"`
+	// hot key counts cover the current and the previous window, defaults to 1m
+	HotKeyWindow *durationpb.Duration `protobuf:"bytes,35,opt,name=hot_key_window,json=hotKeyWindow,proto3" json:"hot_key_window,omitempty"`
+	// keys tracked per shard, the least accessed one is replaced when full; defaults to 64
+	HotKeyCapacity int32 `protobuf:"varint,36,opt,name=hot_key_capacity,json=hotKeyCapacity,proto3" json:"hot_key_capacity,omitempty"`
+	// record one in this many Get and Set calls, counts are scaled back up; defaults to 1
+	HotKeySampleRate int32 `protobuf:"varint,37,opt,name=hot_key_sample_rate,json=hotKeySampleRate,proto3" json:"hot_key_sample_rate,omitempty"`
+	// shard and time wheel lock waits shorter than this are left out of the lock wait histograms, defaults to 1ms
+	LockWaitThreshold *durationpb.Duration `protobuf:"bytes,38,opt,name=lock_wait_threshold,json=lockWaitThreshold,proto3" json:"lock_wait_threshold,omitempty"`
+	// Get, Set and Delete calls slower than this are logged together with the time spent waiting for
+	// the shard lock, 0 disables the slow log
+	SlowLogThreshold *durationpb.Duration `protobuf:"bytes,39,opt,name=slow_log_threshold,json=slowLogThreshold,proto3" json:"slow_log_threshold,omitempty"`
+	// how often to check the free space of the filesystem holding data_file, defaults to 10s
+	DiskCheckInterval *durationpb.Duration `protobuf:"bytes,40,opt,name=disk_check_interval,json=diskCheckInterval,proto3" json:"disk_check_interval,omitempty"`
+	// free bytes below which the disk is reported as warning and the AOF is rewritten early when that
+	// would bring free space back above it, 0 disables
+	DiskWarnFreeBytes int64 `protobuf:"varint,41,opt,name=disk_warn_free_bytes,json=diskWarnFreeBytes,proto3" json:"disk_warn_free_bytes,omitempty"`
+	// free bytes below which disk_full_policy applies, 0 disables
+	DiskMinFreeBytes int64 `protobuf:"varint,42,opt,name=disk_min_free_bytes,json=diskMinFreeBytes,proto3" json:"disk_min_free_bytes,omitempty"`
+	// reject | degrade: below disk_min_free_bytes, reject writes, or keep serving them from memory only
+	// and rewrite the AOF once space is back; defaults to reject
+	DiskFullPolicy string `protobuf:"bytes,43,opt,name=disk_full_policy,json=diskFullPolicy,proto3" json:"disk_full_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Data_Cache) Reset() {
+	*x = Data_Cache{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Cache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Cache) ProtoMessage() {}
+
+func (x *Data_Cache) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Cache.ProtoReflect.Descriptor instead.
+func (*Data_Cache) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Data_Cache) GetAofFsync() string {
+	if x != nil {
+		return x.AofFsync
+	}
+	return ""
+}
+
+func (x *Data_Cache) GetMaxKeys() int64 {
+	if x != nil {
+		return x.MaxKeys
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetEvictionPolicy() string {
+	if x != nil {
+		return x.EvictionPolicy
+	}
+	return ""
+}
+
+func (x *Data_Cache) GetAofRewritePercentage() int32 {
+	if x != nil {
+		return x.AofRewritePercentage
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetAofRewriteMinSize() int64 {
+	if x != nil {
+		return x.AofRewriteMinSize
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetAofQueueFull() string {
+	if x != nil {
+		return x.AofQueueFull
+	}
+	return ""
+}
+
+func (x *Data_Cache) GetAofQueueTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.AofQueueTimeout
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetSnapshotInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SnapshotInterval
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetShards() int32 {
+	if x != nil {
+		return x.Shards
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetDisableFlush() bool {
+	if x != nil {
+		return x.DisableFlush
+	}
+	return false
+}
+
+func (x *Data_Cache) GetDataFile() string {
+	if x != nil {
+		return x.DataFile
+	}
+	return ""
+}
+
+func (x *Data_Cache) GetAofQueueSize() int32 {
+	if x != nil {
+		return x.AofQueueSize
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetCleanupInterval() *durationpb.Duration {
+	if x != nil {
+		return x.CleanupInterval
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetTimeWheelSlots() int32 {
+	if x != nil {
+		return x.TimeWheelSlots
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetTimeWheelTick() *durationpb.Duration {
+	if x != nil {
+		return x.TimeWheelTick
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetCompressThreshold() int64 {
+	if x != nil {
+		return x.CompressThreshold
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetAofReplayFailFast() bool {
+	if x != nil {
+		return x.AofReplayFailFast
+	}
+	return false
+}
+
+func (x *Data_Cache) GetAofReplayMaxSkipped() int64 {
+	if x != nil {
+		return x.AofReplayMaxSkipped
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetAofBatchSize() int32 {
+	if x != nil {
+		return x.AofBatchSize
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetAofFlushDelay() *durationpb.Duration {
+	if x != nil {
+		return x.AofFlushDelay
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetReplicaOf() string {
+	if x != nil {
+		return x.ReplicaOf
+	}
+	return ""
+}
+
+func (x *Data_Cache) GetReplicationBacklog() int32 {
+	if x != nil {
+		return x.ReplicationBacklog
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetReloadTtlJitter() float64 {
+	if x != nil {
+		return x.ReloadTtlJitter
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetLockTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.LockTimeout
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetMaxValueSize() int64 {
+	if x != nil {
+		return x.MaxValueSize
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetExpireSampleInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ExpireSampleInterval
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetExpireSampleKeys() int32 {
+	if x != nil {
+		return x.ExpireSampleKeys
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetExpireSampleBudget() *durationpb.Duration {
+	if x != nil {
+		return x.ExpireSampleBudget
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetExpireFullSweep() bool {
+	if x != nil {
+		return x.ExpireFullSweep
+	}
+	return false
+}
+
+func (x *Data_Cache) GetTtlJitter() float64 {
+	if x != nil {
+		return x.TtlJitter
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetAofDurableWrites() bool {
+	if x != nil {
+		return x.AofDurableWrites
+	}
+	return false
+}
+
+func (x *Data_Cache) GetAofSkipExpired() bool {
+	if x != nil {
+		return x.AofSkipExpired
+	}
+	return false
+}
+
+func (x *Data_Cache) GetHotKeys() bool {
+	if x != nil {
+		return x.HotKeys
+	}
+	return false
+}
+
+func (x *Data_Cache) GetHotKeyWindow() *durationpb.Duration {
+	if x != nil {
+		return x.HotKeyWindow
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetHotKeyCapacity() int32 {
+	if x != nil {
+		return x.HotKeyCapacity
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetHotKeySampleRate() int32 {
+	if x != nil {
+		return x.HotKeySampleRate
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetLockWaitThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.LockWaitThreshold
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetSlowLogThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.SlowLogThreshold
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetDiskCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.DiskCheckInterval
+	}
+	return nil
+}
+
+func (x *Data_Cache) GetDiskWarnFreeBytes() int64 {
+	if x != nil {
+		return x.DiskWarnFreeBytes
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetDiskMinFreeBytes() int64 {
+	if x != nil {
+		return x.DiskMinFreeBytes
+	}
+	return 0
+}
+
+func (x *Data_Cache) GetDiskFullPolicy() string {
+	if x != nil {
+		return x.DiskFullPolicy
+	}
+	return ""
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
@@ -426,10 +928,11 @@ const file_conf_conf_proto_rawDesc = "" +
 	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"]\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
-	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\xb8\x02\n" +
+	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\x9b\x03\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12+\n" +
+	"\x04resp\x18\x03 \x01(\v2\x17.kratos.api.Server.RESPR\x04resp\x1ai\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
@@ -437,10 +940,14 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xdd\x02\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a4\n" +
+	"\x04RESP\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\"\xcd\x13\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
-	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x1a:\n" +
+	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12,\n" +
+	"\x05cache\x18\x03 \x01(\v2\x16.kratos.api.Data.CacheR\x05cache\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xb3\x01\n" +
@@ -448,7 +955,54 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
 	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeoutB$Z\"gocache-service/internal/conf;confb\x06proto3"
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1a\xbf\x10\n" +
+	"\x05Cache\x12\x1b\n" +
+	"\taof_fsync\x18\x01 \x01(\tR\baofFsync\x12\x19\n" +
+	"\bmax_keys\x18\x02 \x01(\x03R\amaxKeys\x12'\n" +
+	"\x0feviction_policy\x18\x03 \x01(\tR\x0eevictionPolicy\x124\n" +
+	"\x16aof_rewrite_percentage\x18\x04 \x01(\x05R\x14aofRewritePercentage\x12/\n" +
+	"\x14aof_rewrite_min_size\x18\x05 \x01(\x03R\x11aofRewriteMinSize\x12$\n" +
+	"\x0eaof_queue_full\x18\x06 \x01(\tR\faofQueueFull\x12E\n" +
+	"\x11aof_queue_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0faofQueueTimeout\x12F\n" +
+	"\x11snapshot_interval\x18\b \x01(\v2\x19.google.protobuf.DurationR\x10snapshotInterval\x12\x1b\n" +
+	"\tmax_bytes\x18\t \x01(\x03R\bmaxBytes\x12\x16\n" +
+	"\x06shards\x18\n" +
+	" \x01(\x05R\x06shards\x12#\n" +
+	"\rdisable_flush\x18\v \x01(\bR\fdisableFlush\x12\x1b\n" +
+	"\tdata_file\x18\f \x01(\tR\bdataFile\x12$\n" +
+	"\x0eaof_queue_size\x18\r \x01(\x05R\faofQueueSize\x12D\n" +
+	"\x10cleanup_interval\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\x0fcleanupInterval\x12(\n" +
+	"\x10time_wheel_slots\x18\x0f \x01(\x05R\x0etimeWheelSlots\x12A\n" +
+	"\x0ftime_wheel_tick\x18\x10 \x01(\v2\x19.google.protobuf.DurationR\rtimeWheelTick\x12-\n" +
+	"\x12compress_threshold\x18\x11 \x01(\x03R\x11compressThreshold\x12/\n" +
+	"\x14aof_replay_fail_fast\x18\x12 \x01(\bR\x11aofReplayFailFast\x123\n" +
+	"\x16aof_replay_max_skipped\x18\x13 \x01(\x03R\x13aofReplayMaxSkipped\x12$\n" +
+	"\x0eaof_batch_size\x18\x14 \x01(\x05R\faofBatchSize\x12A\n" +
+	"\x0faof_flush_delay\x18\x15 \x01(\v2\x19.google.protobuf.DurationR\raofFlushDelay\x12\x1d\n" +
+	"\n" +
+	"replica_of\x18\x16 \x01(\tR\treplicaOf\x12/\n" +
+	"\x13replication_backlog\x18\x17 \x01(\x05R\x12replicationBacklog\x12*\n" +
+	"\x11reload_ttl_jitter\x18\x18 \x01(\x01R\x0freloadTtlJitter\x12<\n" +
+	"\flock_timeout\x18\x19 \x01(\v2\x19.google.protobuf.DurationR\vlockTimeout\x12$\n" +
+	"\x0emax_value_size\x18\x1a \x01(\x03R\fmaxValueSize\x12O\n" +
+	"\x16expire_sample_interval\x18\x1b \x01(\v2\x19.google.protobuf.DurationR\x14expireSampleInterval\x12,\n" +
+	"\x12expire_sample_keys\x18\x1c \x01(\x05R\x10expireSampleKeys\x12K\n" +
+	"\x14expire_sample_budget\x18\x1d \x01(\v2\x19.google.protobuf.DurationR\x12expireSampleBudget\x12*\n" +
+	"\x11expire_full_sweep\x18\x1e \x01(\bR\x0fexpireFullSweep\x12\x1d\n" +
+	"\n" +
+	"ttl_jitter\x18\x1f \x01(\x01R\tttlJitter\x12,\n" +
+	"\x12aof_durable_writes\x18  \x01(\bR\x10aofDurableWrites\x12(\n" +
+	"\x10aof_skip_expired\x18! \x01(\bR\x0eaofSkipExpired\x12\x19\n" +
+	"\bhot_keys\x18\" \x01(\bR\ahotKeys\x12?\n" +
+	"\x0ehot_key_window\x18# \x01(\v2\x19.google.protobuf.DurationR\fhotKeyWindow\x12(\n" +
+	"\x10hot_key_capacity\x18$ \x01(\x05R\x0ehotKeyCapacity\x12-\n" +
+	"\x13hot_key_sample_rate\x18% \x01(\x05R\x10hotKeySampleRate\x12I\n" +
+	"\x13lock_wait_threshold\x18& \x01(\v2\x19.google.protobuf.DurationR\x11lockWaitThreshold\x12G\n" +
+	"\x12slow_log_threshold\x18' \x01(\v2\x19.google.protobuf.DurationR\x10slowLogThreshold\x12I\n" +
+	"\x13disk_check_interval\x18( \x01(\v2\x19.google.protobuf.DurationR\x11diskCheckInterval\x12/\n" +
+	"\x14disk_warn_free_bytes\x18) \x01(\x03R\x11diskWarnFreeBytes\x12-\n" +
+	"\x13disk_min_free_bytes\x18* \x01(\x03R\x10diskMinFreeBytes\x12(\n" +
+	"\x10disk_full_policy\x18+ \x01(\tR\x0ediskFullPolicyB$Z\"gocache-service/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -462,33 +1016,49 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
 	(*Data)(nil),                // 2: kratos.api.Data
 	(*Server_HTTP)(nil),         // 3: kratos.api.Server.HTTP
 	(*Server_GRPC)(nil),         // 4: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 5: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 6: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 7: google.protobuf.Duration
+	(*Server_RESP)(nil),         // 5: kratos.api.Server.RESP
+	(*Data_Database)(nil),       // 6: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 7: kratos.api.Data.Redis
+	(*Data_Cache)(nil),          // 8: kratos.api.Data.Cache
+	(*durationpb.Duration)(nil), // 9: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	2,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	3,  // 2: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
 	4,  // 3: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	5,  // 4: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	6,  // 5: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	7,  // 6: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	7,  // 7: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	7,  // 8: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	7,  // 9: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	5,  // 4: kratos.api.Server.resp:type_name -> kratos.api.Server.RESP
+	6,  // 5: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	7,  // 6: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	8,  // 7: kratos.api.Data.cache:type_name -> kratos.api.Data.Cache
+	9,  // 8: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	9,  // 9: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	9,  // 10: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	9,  // 11: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	9,  // 12: kratos.api.Data.Cache.aof_queue_timeout:type_name -> google.protobuf.Duration
+	9,  // 13: kratos.api.Data.Cache.snapshot_interval:type_name -> google.protobuf.Duration
+	9,  // 14: kratos.api.Data.Cache.cleanup_interval:type_name -> google.protobuf.Duration
+	9,  // 15: kratos.api.Data.Cache.time_wheel_tick:type_name -> google.protobuf.Duration
+	9,  // 16: kratos.api.Data.Cache.aof_flush_delay:type_name -> google.protobuf.Duration
+	9,  // 17: kratos.api.Data.Cache.lock_timeout:type_name -> google.protobuf.Duration
+	9,  // 18: kratos.api.Data.Cache.expire_sample_interval:type_name -> google.protobuf.Duration
+	9,  // 19: kratos.api.Data.Cache.expire_sample_budget:type_name -> google.protobuf.Duration
+	9,  // 20: kratos.api.Data.Cache.hot_key_window:type_name -> google.protobuf.Duration
+	9,  // 21: kratos.api.Data.Cache.lock_wait_threshold:type_name -> google.protobuf.Duration
+	9,  // 22: kratos.api.Data.Cache.slow_log_threshold:type_name -> google.protobuf.Duration
+	9,  // 23: kratos.api.Data.Cache.disk_check_interval:type_name -> google.protobuf.Duration
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -502,7 +1072,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
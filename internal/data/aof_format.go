@@ -0,0 +1,116 @@
+package data
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+
+	"gocache-service/internal/biz"
+)
+
+// AOF 文件以 aofMagic 开头，之后每条命令是一个独立的记录：
+// 4 字节大端长度 + biz.EncodeCommand 的编码(以版本字节开头)。
+//
+// 快照文件以 snapshotMagic 开头，记录格式相同：第一条是 EPOCH 记录，
+// 之后是每个分片一条的 LOAD 记录以及生成快照期间写入的命令。
+// 生成快照后 AOF 换成以同一 EPOCH 记录开头的新文件，启动时先加载该纪元的快照再重放 AOF。
+//
+// 旧版本的文件以 legacyAOFMagic、legacySnapshotMagic 开头，分帧方式相同，但每条记录是
+// 用全新 gob.Encoder 编码的 []interface{}，读取时经 legacyCommand 转换，见 aof_legacy.go。
+const (
+	aofMagic      = "GOCAOF2\n"
+	snapshotMagic = "GOCSNP2\n"
+	// maxRecordSize 单条记录的上限，超过即认为长度字段已损坏
+	maxRecordSize = 64 << 20
+)
+
+var (
+	// errCorruptRecord 记录不完整或无法解码
+	errCorruptRecord = errors.New("cache: corrupt aof record")
+	// errMalformedRecord 旧格式的记录能够解码，但无法转换为 biz.AOFCommand，跳过即可
+	errMalformedRecord = errors.New("cache: malformed legacy aof record")
+)
+
+// encodeRecord 把一条命令编码为带长度前缀的记录写入 w
+func encodeRecord(w io.Writer, command biz.AOFCommand) error {
+	return writeFrame(w, biz.EncodeCommand(command))
+}
+
+func writeFrame(w io.Writer, payload []byte) error {
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(payload)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// aofReader 顺序读取 AOF 记录，offset 是最后一条完整记录的结束位置
+type aofReader struct {
+	r      *bufio.Reader
+	offset int64
+	// legacy 文件头是旧格式，记录是 gob 编码的 []interface{}
+	legacy bool
+}
+
+// newAOFReader 校验文件头并返回读取器，空文件视为没有记录
+func newAOFReader(r io.Reader) (*aofReader, error) {
+	return newRecordReader(r, aofMagic, legacyAOFMagic)
+}
+
+// newRecordReader 校验文件头是否为 header 或旧格式的 legacyHeader 并返回读取器，空文件视为没有记录
+func newRecordReader(r io.Reader, header, legacyHeader string) (*aofReader, error) {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(header))
+	n, err := io.ReadFull(br, magic)
+	if err == io.EOF {
+		return &aofReader{r: br}, nil
+	}
+	if err != nil || string(magic) != header && string(magic) != legacyHeader {
+		return nil, fmt.Errorf("%w: bad header %q", errCorruptRecord, magic[:n])
+	}
+	return &aofReader{r: br, offset: int64(len(header)), legacy: string(magic) == legacyHeader}, nil
+}
+
+// Next 返回下一条命令，正常读完返回 io.EOF，记录被截断或损坏时返回 errCorruptRecord。
+// 旧格式的记录无法转换时返回 errMalformedRecord，offset 已越过该记录，可以继续读取
+func (ar *aofReader) Next() (biz.AOFCommand, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(ar.r, header[:]); err != nil {
+		if err == io.EOF {
+			return biz.AOFCommand{}, io.EOF
+		}
+		return biz.AOFCommand{}, fmt.Errorf("%w at offset %d: %v", errCorruptRecord, ar.offset, err)
+	}
+	size := binary.BigEndian.Uint32(header[:])
+	if size > maxRecordSize {
+		return biz.AOFCommand{}, fmt.Errorf("%w at offset %d: record size %d", errCorruptRecord, ar.offset, size)
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(ar.r, payload); err != nil {
+		return biz.AOFCommand{}, fmt.Errorf("%w at offset %d: %v", errCorruptRecord, ar.offset, err)
+	}
+	if !ar.legacy {
+		command, err := biz.DecodeCommand(payload)
+		if err != nil {
+			return biz.AOFCommand{}, fmt.Errorf("%w at offset %d: %v", errCorruptRecord, ar.offset, err)
+		}
+		ar.offset += int64(len(header)) + int64(size)
+		return command, nil
+	}
+	var legacy []interface{}
+	if err := gob.NewDecoder(bytes.NewReader(payload)).Decode(&legacy); err != nil {
+		return biz.AOFCommand{}, fmt.Errorf("%w at offset %d: %v", errCorruptRecord, ar.offset, err)
+	}
+	ar.offset += int64(len(header)) + int64(size)
+	command, err := legacyCommand(legacy)
+	if err != nil {
+		return biz.AOFCommand{}, fmt.Errorf("%w at offset %d: %v", errMalformedRecord, ar.offset, err)
+	}
+	return command, nil
+}
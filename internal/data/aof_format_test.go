@@ -0,0 +1,86 @@
+package data
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"gocache-service/internal/biz"
+)
+
+// encodeAOF 把 commands 编码为完整的 AOF 文件内容
+func encodeAOF(tb testing.TB, commands ...biz.AOFCommand) []byte {
+	tb.Helper()
+	var buf bytes.Buffer
+	buf.WriteString(aofMagic)
+	for _, command := range commands {
+		if err := encodeRecord(&buf, command); err != nil {
+			tb.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+// readAll 读出 raw 中的命令，直到 io.EOF 或第一个错误
+func readAll(raw []byte) ([]biz.AOFCommand, *aofReader, error) {
+	reader, err := newAOFReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, nil, err
+	}
+	var commands []biz.AOFCommand
+	for {
+		command, err := reader.Next()
+		if errors.Is(err, errMalformedRecord) {
+			continue
+		}
+		if err != nil {
+			return commands, reader, err
+		}
+		commands = append(commands, command)
+	}
+}
+
+func FuzzAOFReader(f *testing.F) {
+	valid := encodeAOF(f,
+		biz.AOFCommand{Op: biz.AOFSet, Key: "k", Value: "v", ExpiresAt: 1700000000000, EventTime: 1},
+		biz.AOFCommand{Op: biz.AOFMDel, Args: []string{"a", "b"}},
+		biz.AOFCommand{Op: biz.AOFLoad, Items: map[string]biz.CacheItem{"x": {Value: "y", Pinned: true}}},
+		biz.AOFCommand{Op: biz.AOFMulti, Commands: []biz.AOFCommand{{Op: biz.AOFIncr, Key: "n", Delta: 3}}},
+	)
+	f.Add(valid)
+	f.Add(valid[:len(valid)-1])
+	f.Add(valid[:len(aofMagic)+2])
+	f.Add(append(append([]byte(nil), valid...), 0, 0, 0, 4, 0xff, 0xff, 0xff, 0xff))
+	f.Add([]byte(aofMagic))
+	f.Add([]byte(legacyAOFMagic + "\x00\x00\x00\x03abc"))
+	f.Add([]byte("not an aof"))
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, raw []byte) {
+		commands, reader, err := readAll(raw)
+		if reader == nil {
+			if !errors.Is(err, errCorruptRecord) {
+				t.Fatalf("newAOFReader err = %v, want errCorruptRecord", err)
+			}
+			return
+		}
+		if err != io.EOF && !errors.Is(err, errCorruptRecord) {
+			t.Fatalf("Next err = %v, want io.EOF or errCorruptRecord", err)
+		}
+		if reader.offset > int64(len(raw)) {
+			t.Fatalf("offset %d past the end of %d bytes", reader.offset, len(raw))
+		}
+		if err == io.EOF {
+			return
+		}
+		// 截断到 offset 之后重新读取，得到同样的命令并正常结束，与启动时的恢复方式相同
+		again, _, err := readAll(raw[:reader.offset])
+		if err != io.EOF {
+			t.Fatalf("reading the truncated file err = %v, want io.EOF", err)
+		}
+		if len(again) != len(commands) {
+			t.Fatalf("truncated file has %d commands, want %d", len(again), len(commands))
+		}
+	})
+}
@@ -0,0 +1,321 @@
+package data
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gocache-service/internal/biz"
+)
+
+// 旧版本的 AOF 与快照把每条命令保存为 gob 编码的 []interface{}。最早的格式(gob-v1)是多段
+// 拼接的 gob 流，之后的格式(gob-framed-v1)带文件头且每条记录独立编码。启动时 convertAOF
+// 把两种旧格式的 AOF 一次性改写为当前格式；旧格式的快照文件只会被读取，下一次保存快照时被替换。
+const (
+	legacyAOFMagic      = "GOCAOF1\n"
+	legacySnapshotMagic = "GOCSNP1\n"
+)
+
+// 解码 []interface{} 中的具体类型前需要注册，只有读取旧格式时用到
+func init() {
+	gob.Register(map[string]interface{}{})
+	gob.Register(map[string]string{})
+	gob.Register([]string{})
+	gob.Register([]interface{}{})
+	gob.Register("")
+	gob.Register(time.Duration(0))
+	gob.Register(map[string]biz.CacheItem{})
+}
+
+// legacyArgs 按位置读取旧格式命令的参数，类型不符时记录第一个错误并返回零值
+type legacyArgs struct {
+	command []interface{}
+	err     error
+}
+
+func (a *legacyArgs) fail(i int, want string) {
+	if a.err == nil {
+		a.err = fmt.Errorf("argument %d of %v is %T, want %s", i, a.command[0], a.command[i], want)
+	}
+}
+
+func (a *legacyArgs) str(i int) string {
+	s, ok := a.command[i].(string)
+	if !ok {
+		a.fail(i, "string")
+	}
+	return s
+}
+
+func (a *legacyArgs) num(i int) int64 {
+	n, ok := a.command[i].(int64)
+	if !ok {
+		a.fail(i, "int64")
+	}
+	return n
+}
+
+func (a *legacyArgs) flag(i int) bool {
+	b, ok := a.command[i].(bool)
+	if !ok {
+		a.fail(i, "bool")
+	}
+	return b
+}
+
+func (a *legacyArgs) strs(from int) []string {
+	values := make([]string, 0, len(a.command)-from)
+	for i := from; i < len(a.command); i++ {
+		values = append(values, a.str(i))
+	}
+	return values
+}
+
+// legacyArity 各旧命令的参数个数(含命令名)，max 为 -1 表示不限
+var legacyArity = map[biz.AOFOp][2]int{
+	biz.AOFSet:      {4, 5},
+	biz.AOFSetZ:     {5, 5},
+	biz.AOFDel:      {2, 2},
+	biz.AOFMSet:     {3, -1},
+	biz.AOFMDel:     {1, -1},
+	biz.AOFIncr:     {3, 3},
+	biz.AOFExpire:   {3, 3},
+	biz.AOFAppend:   {5, 5},
+	biz.AOFHSet:     {4, 4},
+	biz.AOFHDel:     {3, 3},
+	biz.AOFHMSet:    {5, 5},
+	biz.AOFLPush:    {3, -1},
+	biz.AOFRPush:    {3, -1},
+	biz.AOFLPop:     {2, 2},
+	biz.AOFRPop:     {2, 2},
+	biz.AOFLRestore: {5, 5},
+	biz.AOFPin:      {3, 3},
+	biz.AOFUnpin:    {2, 2},
+	biz.AOFLoad:     {2, 2},
+	biz.AOFEpoch:    {2, 2},
+	biz.AOFMulti:    {2, -1},
+	biz.AOFFlushAll: {1, 1},
+}
+
+// legacyCommand 把旧格式的命令转换为 biz.AOFCommand，命令名无法识别、参数个数或类型不符时返回错误。
+// MULTI 必须以 EXEC 结尾且不能嵌套，否则整批无效
+func legacyCommand(command []interface{}) (biz.AOFCommand, error) {
+	if len(command) == 0 {
+		return biz.AOFCommand{}, errors.New("empty command")
+	}
+	name, _ := command[0].(string)
+	op, ok := biz.ParseAOFOp(name)
+	if !ok {
+		return biz.AOFCommand{}, fmt.Errorf("unknown command %v", command[0])
+	}
+	arity := legacyArity[op]
+	if len(command) < arity[0] || arity[1] >= 0 && len(command) > arity[1] {
+		return biz.AOFCommand{}, fmt.Errorf("%v with %d arguments", op, len(command)-1)
+	}
+	a := &legacyArgs{command: command}
+	cmd := biz.AOFCommand{Op: op}
+	switch op {
+	case biz.AOFSet, biz.AOFSetZ:
+		cmd.Key, cmd.Value, cmd.ExpiresAt = a.str(1), a.str(2), a.num(3)
+		// 最早的 SET 记录没有事件时间
+		if len(command) == 5 {
+			cmd.EventTime = a.num(4)
+		}
+	case biz.AOFDel, biz.AOFLPop, biz.AOFRPop, biz.AOFUnpin:
+		cmd.Key = a.str(1)
+	case biz.AOFMSet:
+		// MSET expiresAt eventTime k1 v1 k2 v2 ...，末尾落单的键被忽略
+		cmd.ExpiresAt, cmd.EventTime = a.num(1), a.num(2)
+		cmd.Args = a.strs(3)
+		cmd.Args = cmd.Args[:len(cmd.Args)/2*2]
+	case biz.AOFMDel:
+		cmd.Args = a.strs(1)
+	case biz.AOFIncr:
+		cmd.Key, cmd.Delta = a.str(1), a.num(2)
+	case biz.AOFExpire:
+		cmd.Key, cmd.ExpiresAt = a.str(1), a.num(2)
+	case biz.AOFAppend:
+		cmd.Key, cmd.Value, cmd.ExpiresAt, cmd.EventTime = a.str(1), a.str(2), a.num(3), a.num(4)
+	case biz.AOFHSet:
+		cmd.Key, cmd.Args, cmd.Value = a.str(1), []string{a.str(2)}, a.str(3)
+	case biz.AOFHDel:
+		cmd.Key, cmd.Args = a.str(1), []string{a.str(2)}
+	case biz.AOFHMSet:
+		cmd.Key, cmd.ExpiresAt, cmd.EventTime = a.str(1), a.num(2), a.num(3)
+		fields, ok := command[4].(map[string]string)
+		if !ok {
+			a.fail(4, "map[string]string")
+		}
+		for field, value := range fields {
+			cmd.Args = append(cmd.Args, field, value)
+		}
+	case biz.AOFLPush, biz.AOFRPush:
+		cmd.Key, cmd.Args = a.str(1), a.strs(2)
+	case biz.AOFLRestore:
+		cmd.Key, cmd.ExpiresAt, cmd.EventTime = a.str(1), a.num(2), a.num(3)
+		list, ok := command[4].([]string)
+		if !ok {
+			a.fail(4, "[]string")
+		}
+		cmd.Args = list
+	case biz.AOFPin:
+		cmd.Key, cmd.TTLOverride = a.str(1), a.flag(2)
+	case biz.AOFLoad:
+		items, ok := command[1].(map[string]biz.CacheItem)
+		if !ok {
+			a.fail(1, "map[string]biz.CacheItem")
+		}
+		cmd.Items = items
+	case biz.AOFEpoch:
+		cmd.Value = a.str(1)
+	case biz.AOFMulti:
+		// MULTI cmd1 cmd2 ... EXEC，每条命令都是 []interface{}
+		if command[len(command)-1] != "EXEC" {
+			return biz.AOFCommand{}, errors.New("MULTI record without EXEC")
+		}
+		for i, v := range command[1 : len(command)-1] {
+			sub, ok := v.([]interface{})
+			if !ok {
+				return biz.AOFCommand{}, fmt.Errorf("malformed command %v in MULTI record", v)
+			}
+			if len(sub) > 0 && sub[0] == "MULTI" {
+				return biz.AOFCommand{}, errors.New("nested MULTI record")
+			}
+			subCmd, err := legacyCommand(sub)
+			if err != nil {
+				return biz.AOFCommand{}, fmt.Errorf("command %d in MULTI record: %w", i, err)
+			}
+			cmd.Commands = append(cmd.Commands, subCmd)
+		}
+	}
+	if a.err != nil {
+		return biz.AOFCommand{}, a.err
+	}
+	return cmd, nil
+}
+
+// legacyStreamHeader 返回全新 gob.Encoder 编码 []interface{} 时先输出的类型定义。
+// 旧格式每次进程启动都会新建 Encoder 并追加写入，文件因此由多段独立的 gob 流拼接而成，
+// 每段都以这段类型定义开头。
+func legacyStreamHeader() []byte {
+	var buf bytes.Buffer
+	encoder := gob.NewEncoder(&buf)
+	_ = encoder.Encode([]interface{}{})
+	first := buf.Len()
+	_ = encoder.Encode([]interface{}{})
+	return buf.Bytes()[:first-(buf.Len()-first)]
+}
+
+// decodeLegacyAOF 按 gob 流的起始位置把 gob-v1 格式的文件切成多段，逐段解码。
+// 每段读到第一个错误为止，损坏只会影响所在的那一段。
+func decodeLegacyAOF(raw []byte, fn func(command []interface{}) error) error {
+	header := legacyStreamHeader()
+	var starts []int
+	for offset := 0; ; {
+		i := bytes.Index(raw[offset:], header)
+		if i < 0 {
+			break
+		}
+		starts = append(starts, offset+i)
+		offset += i + len(header)
+	}
+	if len(starts) == 0 || starts[0] != 0 {
+		starts = append([]int{0}, starts...)
+	}
+	for n, start := range starts {
+		end := len(raw)
+		if n+1 < len(starts) {
+			end = starts[n+1]
+		}
+		decoder := gob.NewDecoder(bytes.NewReader(raw[start:end]))
+		for {
+			var command []interface{}
+			if err := decoder.Decode(&command); err != nil {
+				break
+			}
+			if err := fn(command); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// convertAOF 把旧格式(gob-v1 或 gob-framed-v1)的 AOF 改写为当前格式，返回转换的命令数和丢弃的命令数。
+// 无法转换的命令重放时同样会被跳过，这里直接丢弃；gob-framed-v1 末尾不完整的记录与 Replay 一样被截断。
+// 文件已是当前格式、为空或不存在时不做处理。新文件写完并落盘后才替换原文件，中途失败时原文件保持不变
+func convertAOF(path string) (converted, dropped int, err error) {
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(raw) == 0 || bytes.HasPrefix(raw, []byte(aofMagic)) {
+		return 0, 0, nil
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(path), "cache-aof-convert-*.tmp")
+	if err != nil {
+		return 0, 0, err
+	}
+	defer os.Remove(tempFile.Name())
+	defer tempFile.Close()
+	w := bufio.NewWriter(tempFile)
+	if _, err := w.WriteString(aofMagic); err != nil {
+		return 0, 0, err
+	}
+	if bytes.HasPrefix(raw, []byte(legacyAOFMagic)) {
+		reader, err := newAOFReader(bytes.NewReader(raw))
+		if err != nil {
+			return 0, 0, err
+		}
+		for {
+			command, err := reader.Next()
+			if errors.Is(err, errMalformedRecord) {
+				dropped++
+				continue
+			}
+			if err == io.EOF || errors.Is(err, errCorruptRecord) {
+				break
+			}
+			if err != nil {
+				return 0, 0, err
+			}
+			if err := encodeRecord(w, command); err != nil {
+				return 0, 0, err
+			}
+			converted++
+		}
+	} else {
+		err = decodeLegacyAOF(raw, func(legacy []interface{}) error {
+			command, err := legacyCommand(legacy)
+			if err != nil {
+				dropped++
+				return nil
+			}
+			converted++
+			return encodeRecord(w, command)
+		})
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return 0, 0, err
+	}
+	if err := tempFile.Sync(); err != nil {
+		return 0, 0, err
+	}
+	if err := tempFile.Close(); err != nil {
+		return 0, 0, err
+	}
+	return converted, dropped, os.Rename(tempFile.Name(), path)
+}
@@ -1,63 +1,681 @@
 package data
 
 import (
+	"bufio"
+	"bytes"
 	"context"
-	"encoding/gob"
+	"errors"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
+	"gocache-service/internal/biz"
 	"os"
+	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// FsyncPolicy AOF 文件的刷盘策略，与 Redis 的 appendfsync 含义相同
+type FsyncPolicy string
+
+const (
+	// FsyncAlways 每批命令写入后立即 fsync
+	FsyncAlways FsyncPolicy = "always"
+	// FsyncEverySec 每秒最多 fsync 一次
+	FsyncEverySec FsyncPolicy = "everysec"
+	// FsyncNo 不主动 fsync，由操作系统决定何时落盘
+	FsyncNo FsyncPolicy = "no"
+)
+
+// ParseFsyncPolicy 解析配置中的刷盘策略，空字符串为 always，无法识别时返回 always 和错误
+func ParseFsyncPolicy(s string) (FsyncPolicy, error) {
+	switch policy := FsyncPolicy(s); policy {
+	case "":
+		return FsyncAlways, nil
+	case FsyncAlways, FsyncEverySec, FsyncNo:
+		return policy, nil
+	default:
+		return FsyncAlways, fmt.Errorf("cache: unknown aof fsync policy %q", s)
+	}
+}
+
+// QueueFullPolicy 写入队列已满时 Write 的处理方式
+type QueueFullPolicy string
+
+const (
+	// QueueFullBlock 最多等待 queueTimeout，仍无空位时返回 errQueueFull
+	QueueFullBlock QueueFullPolicy = "block"
+	// QueueFullDrop 立即返回 errQueueFull，命令不会写入 AOF
+	QueueFullDrop QueueFullPolicy = "drop"
+	// QueueFullSpill 不进入队列，命令交给写入协程直接写入文件(FsyncAlways 时还包括 fsync)，
+	// Write 等到写完并返回这次写入的结果。队列中之前的命令先写入，顺序不变
+	QueueFullSpill QueueFullPolicy = "spill"
+
+	// defaultQueueTimeout 未配置时 QueueFullBlock 的等待时间
+	defaultQueueTimeout = time.Second
+	// defaultQueueSize 未配置时写入队列的长度
+	defaultQueueSize = 1000
+	// defaultBatchSize 未配置时一批最多合并的命令数
+	defaultBatchSize = 512
+)
+
+// AOFWriterOptions 异步 AOF 写入器的配置，零值字段使用默认值
+type AOFWriterOptions struct {
+	Fsync     FsyncPolicy
+	QueueFull QueueFullPolicy
+	// QueueTimeout QueueFullBlock 等待队列空位的时间，<= 0 时使用 defaultQueueTimeout
+	QueueTimeout time.Duration
+	// QueueSize 写入队列的长度，<= 0 时使用 defaultQueueSize
+	QueueSize int
+	// BatchSize 一批最多合并的命令数，整批只写入和 fsync 一次，<= 0 时使用 defaultBatchSize
+	BatchSize int
+	// FlushDelay 批次未满时最多再等待多久凑齐更多命令，0 表示只合并队列中已有的命令。
+	// 只推迟落盘，Durable 为 false 时不会推迟 Write 返回
+	FlushDelay time.Duration
+	// Durable 为 true 时 Write 等到命令所在的一批写入文件(FsyncAlways 时还包括 fsync)后才返回，
+	// 返回的是这一批的结果；调用方在此期间一直持有分片锁，写入延迟随之变为磁盘延迟
+	Durable bool
+	// Metrics 上报写入队列的深度，为 nil 时不上报
+	Metrics biz.Metrics
+}
+
+// ParseQueueFullPolicy 解析配置中的队列满处理方式，空字符串为 block，无法识别时返回 block 和错误
+func ParseQueueFullPolicy(s string) (QueueFullPolicy, error) {
+	switch policy := QueueFullPolicy(s); policy {
+	case "":
+		return QueueFullBlock, nil
+	case QueueFullBlock, QueueFullDrop, QueueFullSpill:
+		return policy, nil
+	default:
+		return QueueFullBlock, fmt.Errorf("cache: unknown aof queue full policy %q", s)
+	}
+}
+
+// errQueueFull 写入队列已满，命令未能进入队列
+var errQueueFull = errors.New("cache: aof write queue is full")
+
 // AsyncAOFWriter 结构体用于异步写入 AOF 文件
 type AsyncAOFWriter struct {
-	queue chan []interface{}
-	file  *os.File
-	wg    sync.WaitGroup
-	log   *log.Helper
+	queue chan aofOp
+	// spill 队列已满时 QueueFullSpill 绕过队列交给写入协程的命令
+	spill        chan aofOp
+	file         *os.File
+	policy       FsyncPolicy
+	queueFull    QueueFullPolicy
+	queueTimeout time.Duration
+	batchSize    int
+	flushDelay   time.Duration
+	durable      bool
+	wg           sync.WaitGroup
+	log          *log.Helper
+	metrics      biz.Metrics
+
+	// writeErrors 写入或刷盘失败的次数，queueRejected 因队列已满被拒绝的命令数
+	writeErrors   atomic.Uint64
+	queueRejected atomic.Uint64
+	// lastErr 最近一批命令落盘失败的原因，之后成功落盘时清空
+	lastErr atomic.Pointer[error]
+
+	// rewrite 正在进行的重写，只在写入协程中访问
+	rewrite *aofRewrite
+	// waiters 当前批次中等待落盘结果的 Write，只在写入协程中访问
+	waiters []chan error
 }
 
-func (aw *AsyncAOFWriter) init() {
-	gob.Register(map[string]interface{}{})
-	gob.Register([]interface{}{})
-	gob.Register("")
-	gob.Register(time.Duration(0))
+// errRewriteInProgress 上一次重写或清理尚未结束
+var errRewriteInProgress = errors.New("cache: aof rewrite already in progress")
+
+// aofOp 写入队列中的一项，除普通命令外还承载重写的开始、分片快照和结束，
+// 与命令共用一个队列才能保证它们之间的先后顺序
+type aofOp struct {
+	command  biz.AOFCommand
+	begin    *aofRewrite
+	snapshot map[string]biz.CacheItem
+	finish   *rewriteFinish
+	// truncate 非空时清空 AOF，结果写入该通道
+	truncate chan error
+	// done 非空时命令所在的一批落盘后把结果写入该通道，见 AOFWriterOptions.Durable
+	done chan error
+}
+
+// aofRewrite 重写中的新文件，开始之后的命令同时写入旧文件和新文件。
+// 清理时新文件由调用方在写入协程之外生成，期间的命令先暂存在 pending 中，结束时追加到新文件末尾
+type aofRewrite struct {
+	file *os.File
+	// epoch 非空时生成的是快照文件而不是新的 AOF，见 SaveSnapshot
+	epoch   string
+	pending *bytes.Buffer
+	buf     *bufio.Writer
+	err     error
+	// started 清理开始后收到旧文件此时的大小，调用方只处理这之前的记录
+	started chan rewriteResult
+}
+
+// rewriteFinish 结束重写：abort 为 false 时把新文件改名为 path 并切换过去，结果写入 done。
+// 清理时 file 是调用方生成的新文件；生成快照时新文件改名为 snapshotPath，path 换成只有纪元记录的空 AOF
+type rewriteFinish struct {
+	path         string
+	snapshotPath string
+	file         *os.File
+	abort        bool
+	done         chan rewriteResult
+}
+
+// rewriteResult 重写结束后的 AOF 文件，失败时 file 为 nil
+type rewriteResult struct {
+	file *os.File
+	size int64
+	err  error
 }
 
 // NewAsyncAOFWriter 创建一个新的异步 AOF 写入器
-func NewAsyncAOFWriter(file *os.File, log *log.Helper) *AsyncAOFWriter {
+func NewAsyncAOFWriter(file *os.File, opts AOFWriterOptions, log *log.Helper) *AsyncAOFWriter {
+	if opts.QueueTimeout <= 0 {
+		opts.QueueTimeout = defaultQueueTimeout
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = defaultQueueSize
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+	if opts.Metrics == nil {
+		opts.Metrics = biz.NopMetrics{}
+	}
 	aw := &AsyncAOFWriter{
-		queue: make(chan []interface{}, 1000),
-		file:  file,
-		log:   log,
+		queue:        make(chan aofOp, opts.QueueSize),
+		spill:        make(chan aofOp),
+		file:         file,
+		policy:       opts.Fsync,
+		queueFull:    opts.QueueFull,
+		queueTimeout: opts.QueueTimeout,
+		batchSize:    opts.BatchSize,
+		flushDelay:   opts.FlushDelay,
+		durable:      opts.Durable,
+		metrics:      opts.Metrics,
+		log:          log,
 	}
-	aw.init()
 	aw.wg.Add(1)
 	go aw.writeLoop()
 	return aw
 }
 
-// writeLoop 异步写入 AOF 文件的循环
+// writeLoop 异步写入 AOF 文件的循环，每次取出一批命令合并为一次写入，再按策略 fsync
 func (aw *AsyncAOFWriter) writeLoop() {
 	defer aw.wg.Done()
-	encoder := gob.NewEncoder(aw.file)
+	buf := bufio.NewWriter(aw.file)
 	ctx := context.Background()
-	for command := range aw.queue {
-		aw.log.WithContext(ctx).Infof("write command: %v", command)
-		err := encoder.Encode(command)
-		if err != nil {
-			aw.log.WithContext(ctx).Errorf("writing to AOF file err: %v", err)
+	var tick <-chan time.Time
+	if aw.policy == FsyncEverySec {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	dirty := false
+	for {
+		closed := false
+		select {
+		case op, ok := <-aw.queue:
+			closed = !ok
+			if ok {
+				closed = aw.handleBatch(ctx, buf, op)
+			}
+		case op := <-aw.spill:
+			closed = aw.handleSpill(ctx, buf, op)
+		case <-tick:
+			if dirty {
+				aw.report(aw.sync(ctx))
+				dirty = false
+			}
+			continue
+		}
+		if closed {
+			// 正常关闭时无论策略如何都强制落盘，已确认的写入不会丢失
+			err := aw.flush(ctx, buf)
+			if err == nil {
+				err = aw.sync(ctx)
+			}
+			aw.notify(err)
+			return
+		}
+		err := aw.flush(ctx, buf)
+		if err == nil && aw.policy == FsyncAlways {
+			err = aw.sync(ctx)
+		} else if err == nil {
+			dirty = true
+		}
+		aw.report(err)
+		aw.notify(err)
+		aw.metrics.ObserveAOFQueueDepth(len(aw.queue))
+	}
+}
+
+// handleSpill 先处理 QueueFullSpill 发现队列已满时队列中已有的项，再处理 op，
+// 同一个键之前的命令都在这些项中，不会排在 op 之后。返回队列是否已关闭
+func (aw *AsyncAOFWriter) handleSpill(ctx context.Context, buf *bufio.Writer, op aofOp) bool {
+	closed := false
+	for n := len(aw.queue); n > 0; n-- {
+		queued, ok := <-aw.queue
+		if !ok {
+			closed = true
+			break
 		}
-		err = aw.file.Sync()
+		aw.handle(ctx, buf, queued)
+	}
+	aw.handle(ctx, buf, op)
+	return closed
+}
+
+// handleBatch 处理 first 以及随后最多 batchSize-1 项：先取队列中已有的，批次未满且配置了 flushDelay 时
+// 再等待新的命令直到超时。返回队列是否已关闭
+func (aw *AsyncAOFWriter) handleBatch(ctx context.Context, buf *bufio.Writer, first aofOp) bool {
+	aw.handle(ctx, buf, first)
+	var timeout <-chan time.Time
+	for n := 1; n < aw.batchSize; n++ {
+		var op aofOp
+		var ok bool
+		select {
+		case op, ok = <-aw.queue:
+		default:
+			if aw.flushDelay <= 0 {
+				return false
+			}
+			if timeout == nil {
+				timer := time.NewTimer(aw.flushDelay)
+				defer timer.Stop()
+				timeout = timer.C
+			}
+			select {
+			case op, ok = <-aw.queue:
+			case <-timeout:
+				return false
+			}
+		}
+		if !ok {
+			return true
+		}
+		aw.handle(ctx, buf, op)
+	}
+	return false
+}
+
+// handle 处理队列中的一项，重写期间的命令同时写入新文件
+func (aw *AsyncAOFWriter) handle(ctx context.Context, buf *bufio.Writer, op aofOp) {
+	switch {
+	case op.begin != nil:
+		aw.beginRewrite(ctx, buf, op.begin)
+	case op.snapshot != nil:
+		aw.writeSnapshot(op.snapshot)
+	case op.truncate != nil:
+		op.truncate <- aw.truncateFile(ctx, buf)
+	case op.finish != nil:
+		aw.flush(ctx, buf)
+		result := aw.finishRewrite(op.finish)
+		if result.file != nil {
+			buf.Reset(aw.file)
+		}
+		op.finish.done <- result
+	default:
+		aw.encode(ctx, buf, op.command)
+		if rw := aw.rewrite; rw != nil && rw.err == nil {
+			rw.err = encodeRecord(rw.buf, op.command)
+		}
+		if op.done != nil {
+			aw.waiters = append(aw.waiters, op.done)
+		}
+	}
+}
+
+// notify 把一批命令的落盘结果交给其中等待的 Write
+func (aw *AsyncAOFWriter) notify(err error) {
+	for _, done := range aw.waiters {
+		done <- err
+	}
+	aw.waiters = aw.waiters[:0]
+}
+
+func (aw *AsyncAOFWriter) encode(ctx context.Context, buf *bufio.Writer, command biz.AOFCommand) {
+	aw.log.WithContext(ctx).Infof("write command: %v", command)
+	if err := encodeRecord(buf, command); err != nil {
+		aw.log.WithContext(ctx).Errorf("writing to AOF file err: %v", err)
+	}
+}
+
+// beginRewrite 开始重写，新文件先写入文件头；清理时先把已有命令刷到旧文件，再告知调用方旧文件的大小
+func (aw *AsyncAOFWriter) beginRewrite(ctx context.Context, buf *bufio.Writer, rw *aofRewrite) {
+	if aw.rewrite != nil {
+		if rw.started != nil {
+			rw.started <- rewriteResult{err: errRewriteInProgress}
+			return
+		}
+		aw.log.WithContext(ctx).Errorf("AOF rewrite already in progress, discarding %s", rw.file.Name())
+		rw.file.Close()
+		os.Remove(rw.file.Name())
+		return
+	}
+	if rw.started != nil {
+		aw.flush(ctx, buf)
+		info, err := aw.file.Stat()
 		if err != nil {
-			aw.log.WithContext(ctx).Errorf("write async AOF command error: %v", err)
+			rw.started <- rewriteResult{err: err}
+			return
+		}
+		rw.pending = new(bytes.Buffer)
+		rw.buf = bufio.NewWriter(rw.pending)
+		aw.rewrite = rw
+		rw.started <- rewriteResult{size: info.Size()}
+		return
+	}
+	rw.buf = bufio.NewWriter(rw.file)
+	if rw.epoch != "" {
+		if _, rw.err = rw.buf.WriteString(snapshotMagic); rw.err == nil {
+			rw.err = encodeRecord(rw.buf, biz.AOFCommand{Op: biz.AOFEpoch, Value: rw.epoch})
+		}
+	} else {
+		_, rw.err = rw.buf.WriteString(aofMagic)
+	}
+	aw.rewrite = rw
+}
+
+// writeSnapshot 把一个分片的快照以 SET 记录(压缩的值为 SETZ，哈希键为 HMSET，列表键为 LRESTORE)写入新文件，被固定的键再追加一条 PIN 记录；
+// 生成快照文件时整个分片编码为一条 LOAD 记录，启动时可以整块解码
+func (aw *AsyncAOFWriter) writeSnapshot(items map[string]biz.CacheItem) {
+	rw := aw.rewrite
+	if rw == nil || rw.err != nil {
+		return
+	}
+	if rw.epoch != "" {
+		if len(items) > 0 {
+			rw.err = encodeRecord(rw.buf, biz.AOFCommand{Op: biz.AOFLoad, Items: items})
 		}
+		return
 	}
+	for key, entry := range items {
+		if rw.err != nil {
+			return
+		}
+		rw.err = encodeRecord(rw.buf, biz.EntryRecord(key, entry))
+		if rw.err == nil && entry.Pinned {
+			rw.err = encodeRecord(rw.buf, biz.AOFCommand{Op: biz.AOFPin, Key: key, TTLOverride: entry.PinTTLOverride})
+		}
+	}
+}
+
+// finishRewrite 把新文件落盘后改名覆盖 AOF 并重新以追加方式打开，之后的命令只写入新文件。
+// 失败或放弃时删除新文件，继续使用旧文件
+func (aw *AsyncAOFWriter) finishRewrite(finish *rewriteFinish) rewriteResult {
+	rw := aw.rewrite
+	if rw == nil {
+		if finish.file != nil {
+			finish.file.Close()
+			os.Remove(finish.file.Name())
+		}
+		return rewriteResult{err: errors.New("cache: no aof rewrite in progress")}
+	}
+	aw.rewrite = nil
+	if rw.pending != nil {
+		rw.file = finish.file
+		if rw.file == nil {
+			return rewriteResult{err: errors.New("cache: aof rewrite aborted")}
+		}
+	}
+	err := rw.err
+	if err == nil && finish.abort {
+		err = errors.New("cache: aof rewrite aborted")
+	}
+	if err == nil {
+		err = rw.buf.Flush()
+	}
+	if err == nil && rw.pending != nil {
+		_, err = rw.file.Write(rw.pending.Bytes())
+	}
+	if err == nil {
+		err = rw.file.Sync()
+	}
+	if closeErr := rw.file.Close(); err == nil {
+		err = closeErr
+	}
+	var size int64
+	if err == nil && rw.epoch != "" {
+		size, err = installSnapshot(rw.file.Name(), finish.snapshotPath, finish.path, rw.epoch)
+	} else if err == nil {
+		err = os.Rename(rw.file.Name(), finish.path)
+	}
+	if err != nil {
+		os.Remove(rw.file.Name())
+		return rewriteResult{err: err}
+	}
+	file, err := os.OpenFile(finish.path, os.O_APPEND|os.O_RDWR, 0666)
+	if err != nil {
+		// 新文件已经就位但无法打开，之后的命令只能继续写入已被替换的旧文件
+		return rewriteResult{err: err}
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return rewriteResult{err: err}
+	}
+	old := aw.file
+	aw.file = file
+	old.Close()
+	if rw.epoch == "" {
+		size = info.Size()
+	}
+	return rewriteResult{file: file, size: size}
+}
+
+// truncateFile 丢弃已写入和缓冲中的命令，只保留文件头
+func (aw *AsyncAOFWriter) truncateFile(ctx context.Context, buf *bufio.Writer) error {
+	if aw.rewrite != nil {
+		return errRewriteInProgress
+	}
+	buf.Reset(aw.file)
+	if err := aw.file.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := aw.file.WriteString(aofMagic); err != nil {
+		return err
+	}
+	return aw.sync(ctx)
+}
+
+// installSnapshot 先把快照改名为 snapshotPath，再用只含纪元记录的新文件替换 aofPath，返回快照大小。
+// 快照文件名包含纪元，旧 AOF 依赖的快照不会被覆盖，两次改名之间崩溃时启动仍按旧 AOF 的纪元加载
+func installSnapshot(tempPath, snapshotPath, aofPath, epoch string) (int64, error) {
+	aofFile, err := os.CreateTemp(filepath.Dir(aofPath), "cache-aof-epoch-*.tmp")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(aofFile.Name())
+	_, err = aofFile.WriteString(aofMagic)
+	if err == nil {
+		err = encodeRecord(aofFile, biz.AOFCommand{Op: biz.AOFEpoch, Value: epoch})
+	}
+	if err == nil {
+		err = aofFile.Sync()
+	}
+	if closeErr := aofFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, err
+	}
+	info, err := os.Stat(tempPath)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tempPath, snapshotPath); err != nil {
+		return 0, err
+	}
+	return info.Size(), os.Rename(aofFile.Name(), aofPath)
+}
+
+// flush 把缓冲的命令写入文件。bufio.Writer 出错后会一直返回同一个错误，
+// 失败时丢弃缓冲区中的命令并重置，下一批命令还能继续尝试写入
+func (aw *AsyncAOFWriter) flush(ctx context.Context, buf *bufio.Writer) error {
+	err := buf.Flush()
+	if err != nil {
+		aw.log.WithContext(ctx).Errorf("writing to AOF file err: %v", err)
+		buf.Reset(aw.file)
+	}
+	return err
+}
+
+func (aw *AsyncAOFWriter) sync(ctx context.Context) error {
+	err := aw.file.Sync()
+	if err != nil {
+		aw.log.WithContext(ctx).Errorf("write async AOF command error: %v", err)
+	}
+	return err
+}
+
+// report 记录一批命令的落盘结果，失败时计数并保存原因，成功时清空之前的失败
+func (aw *AsyncAOFWriter) report(err error) {
+	if err == nil {
+		aw.lastErr.Store(nil)
+		return
+	}
+	aw.writeErrors.Add(1)
+	aw.lastErr.Store(&err)
+}
+
+// Write 向异步 AOF 写入器写入命令。队列已满时按 queueFull 处理；
+// 命令进入队列但最近一次落盘失败时仍返回该错误，调用方不应认为写入已持久化。
+// durable 或命令被 QueueFullSpill 直接写入时等待命令所在的一批落盘并返回它的结果，
+// 等待期间 ctx 结束时返回 ctx.Err()，命令仍会被写入
+func (aw *AsyncAOFWriter) Write(ctx context.Context, command biz.AOFCommand) error {
+	op := aofOp{command: command}
+	if aw.durable {
+		op.done = make(chan error, 1)
+	}
+	done, err := aw.enqueue(ctx, op)
+	if err != nil {
+		if errors.Is(err, errQueueFull) {
+			aw.queueRejected.Add(1)
+		}
+		return err
+	}
+	if done == nil {
+		return aw.LastWriteError()
+	}
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// enqueue 把 op 放入队列，队列已满时按 queueFull 处理。返回需要等待落盘结果的通道，不需要等待时为 nil
+func (aw *AsyncAOFWriter) enqueue(ctx context.Context, op aofOp) (chan error, error) {
+	select {
+	case aw.queue <- op:
+		return op.done, nil
+	default:
+	}
+	switch aw.queueFull {
+	case QueueFullDrop:
+		return nil, errQueueFull
+	case QueueFullSpill:
+		if op.done == nil {
+			op.done = make(chan error, 1)
+		}
+		select {
+		case aw.spill <- op:
+			return op.done, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	default:
+		timer := time.NewTimer(aw.queueTimeout)
+		defer timer.Stop()
+		select {
+		case aw.queue <- op:
+			return op.done, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return nil, errQueueFull
+		}
+	}
+}
+
+// LastWriteError 返回最近一批命令落盘失败的原因，最近一次落盘成功时返回 nil
+func (aw *AsyncAOFWriter) LastWriteError() error {
+	if err := aw.lastErr.Load(); err != nil {
+		return *err
+	}
+	return nil
+}
+
+// WriteErrors 返回写入或刷盘失败的累计次数
+func (aw *AsyncAOFWriter) WriteErrors() uint64 {
+	return aw.writeErrors.Load()
+}
+
+// QueueRejected 返回因队列已满被拒绝的命令数
+func (aw *AsyncAOFWriter) QueueRejected() uint64 {
+	return aw.queueRejected.Load()
+}
+
+// BeginRewrite 开始向 file 重写 AOF，之后写入的命令会同时追加到 file
+func (aw *AsyncAOFWriter) BeginRewrite(file *os.File) {
+	aw.queue <- aofOp{begin: &aofRewrite{file: file}}
+}
+
+// WriteSnapshot 把一个分片的快照写入重写中的文件，需在持有该分片锁时调用，
+// 这样快照之前的命令都已反映在快照中，之后的命令排在快照之后
+func (aw *AsyncAOFWriter) WriteSnapshot(items map[string]biz.CacheItem) {
+	aw.queue <- aofOp{snapshot: items}
+}
+
+// FinishRewrite 结束重写并等待新文件替换 path，返回新文件及其大小；abort 为 true 时丢弃新文件
+func (aw *AsyncAOFWriter) FinishRewrite(path string, abort bool) (*os.File, int64, error) {
+	return aw.finish(&rewriteFinish{path: path, abort: abort})
+}
+
+// BeginSnapshot 开始向 file 生成纪元为 epoch 的快照，之后写入的命令会同时追加到 file
+func (aw *AsyncAOFWriter) BeginSnapshot(file *os.File, epoch string) {
+	aw.queue <- aofOp{begin: &aofRewrite{file: file, epoch: epoch}}
+}
+
+// FinishSnapshot 结束快照：快照改名为 snapshotPath，path 换成只含纪元记录的新 AOF，返回新 AOF 文件与快照大小
+func (aw *AsyncAOFWriter) FinishSnapshot(path, snapshotPath string, abort bool) (*os.File, int64, error) {
+	return aw.finish(&rewriteFinish{path: path, snapshotPath: snapshotPath, abort: abort})
+}
+
+// Truncate 清空 AOF，之前写入的命令都会被丢弃，之后写入的命令追加在文件头之后
+func (aw *AsyncAOFWriter) Truncate() error {
+	done := make(chan error, 1)
+	aw.queue <- aofOp{truncate: done}
+	return <-done
+}
+
+// BeginCleanup 开始在写入协程之外生成新文件，返回旧文件此时的大小。
+// 调用方只需处理这之前的记录，之后写入的命令暂存在内存中，由 FinishCleanup 追加到新文件末尾
+func (aw *AsyncAOFWriter) BeginCleanup() (int64, error) {
+	started := make(chan rewriteResult, 1)
+	aw.queue <- aofOp{begin: &aofRewrite{started: started}}
+	result := <-started
+	return result.size, result.err
+}
+
+// FinishCleanup 把暂存的命令追加到 file 后用它替换 path，返回新文件及其大小；
+// abort 为 true 或 file 为 nil 时丢弃暂存的命令，继续使用旧文件
+func (aw *AsyncAOFWriter) FinishCleanup(path string, file *os.File, abort bool) (*os.File, int64, error) {
+	return aw.finish(&rewriteFinish{path: path, file: file, abort: abort})
+}
+
+func (aw *AsyncAOFWriter) finish(finish *rewriteFinish) (*os.File, int64, error) {
+	finish.done = make(chan rewriteResult, 1)
+	aw.queue <- aofOp{finish: finish}
+	result := <-finish.done
+	return result.file, result.size, result.err
 }
 
-// Write 向异步 AOF 写入器写入命令
-func (aw *AsyncAOFWriter) Write(command []interface{}) {
-	aw.queue <- command
+// QueueDepth 返回队列中尚未写入的命令数
+func (aw *AsyncAOFWriter) QueueDepth() int {
+	return len(aw.queue)
 }
 
 // Close 关闭异步 AOF 写入器
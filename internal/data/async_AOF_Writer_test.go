@@ -0,0 +1,214 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"gocache-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// readAOF 读出 path 中的全部命令
+func readAOF(t *testing.T, path string) []biz.AOFCommand {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	reader, err := newAOFReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var commands []biz.AOFCommand
+	for {
+		command, err := reader.Next()
+		if err == io.EOF {
+			return commands
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		commands = append(commands, command)
+	}
+}
+
+func TestQueueFullSpillWritesEveryCommandInOrder(t *testing.T) {
+	const writers, perWriter = 8, 200
+	path := filepath.Join(t.TempDir(), "cache.aof")
+	file, err := openAOF(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aw := NewAsyncAOFWriter(file, AOFWriterOptions{
+		Fsync:     FsyncAlways,
+		QueueFull: QueueFullSpill,
+		QueueSize: 1,
+	}, log.NewHelper(log.NewStdLogger(io.Discard)))
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				command := biz.AOFCommand{Op: biz.AOFSet, Key: fmt.Sprintf("w%d", w), Value: fmt.Sprint(i)}
+				if err := aw.Write(ctx, command); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	aw.Close()
+	if n := aw.QueueRejected(); n != 0 {
+		t.Fatalf("QueueRejected = %d, want 0", n)
+	}
+
+	// 每个写入者的命令都按 Write 的顺序出现在文件中
+	next := make(map[string]int)
+	commands := readAOF(t, path)
+	for _, command := range commands {
+		if want := fmt.Sprint(next[command.Key]); command.Value != want {
+			t.Fatalf("%s: value %s after %d commands, want %s", command.Key, command.Value, next[command.Key], want)
+		}
+		next[command.Key]++
+	}
+	if len(commands) != writers*perWriter {
+		t.Fatalf("%d commands in the AOF, want %d", len(commands), writers*perWriter)
+	}
+}
+
+// failingWriter 接受前 n 个字节后返回 err，跨过边界的那次写入只写入前一部分，模拟写到一半时磁盘写满
+type failingWriter struct {
+	w   io.Writer
+	n   int
+	err error
+}
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if len(p) <= f.n {
+		f.n -= len(p)
+		return f.w.Write(p)
+	}
+	n, _ := f.w.Write(p[:f.n])
+	f.n = 0
+	return n, f.err
+}
+
+// AOF 文件是一个管道，另一端把数据交给 failingWriter，它出错后关闭管道，之后的写入都会失败
+func TestAOFWriterSurfacesFailedWrites(t *testing.T) {
+	ctx := context.Background()
+	first := biz.AOFCommand{Op: biz.AOFSet, Key: "first", Value: "v"}
+	var frame bytes.Buffer
+	if err := encodeRecord(&frame, first); err != nil {
+		t.Fatal(err)
+	}
+	for _, durable := range []bool{true, false} {
+		r, w, err := os.Pipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var written bytes.Buffer
+		// 第二条命令写到一半时出错
+		failing := &failingWriter{w: &written, n: len(aofMagic) + frame.Len() + 3, err: errors.New("no space left on device")}
+		copied := make(chan error, 1)
+		go func() {
+			_, err := io.Copy(failing, r)
+			r.Close()
+			copied <- err
+		}()
+		if _, err := w.WriteString(aofMagic); err != nil {
+			t.Fatal(err)
+		}
+		aw := NewAsyncAOFWriter(w, AOFWriterOptions{Fsync: FsyncNo, Durable: durable}, log.NewHelper(log.NewStdLogger(io.Discard)))
+
+		for _, command := range []biz.AOFCommand{first, {Op: biz.AOFSet, Key: "second", Value: "v"}} {
+			if err := aw.Write(ctx, command); err != nil {
+				t.Fatalf("durable %v: Write(%s) before the failure: %v", durable, command.Key, err)
+			}
+		}
+		if err := <-copied; err != failing.err {
+			t.Fatalf("durable %v: copy err = %v, want %v", durable, err, failing.err)
+		}
+		third := biz.AOFCommand{Op: biz.AOFSet, Key: "third", Value: "v"}
+		err = aw.Write(ctx, third)
+		if !durable {
+			// 默认模式下 Write 入队即返回，落盘失败由之后的 Write 报告
+			deadline := time.Now().Add(5 * time.Second)
+			for aw.LastWriteError() == nil && time.Now().Before(deadline) {
+				time.Sleep(time.Millisecond)
+			}
+			err = aw.Write(ctx, third)
+		}
+		if err == nil {
+			t.Fatalf("durable %v: Write after the failure returned nil", durable)
+		}
+		if aw.LastWriteError() == nil || aw.WriteErrors() == 0 {
+			t.Fatalf("durable %v: LastWriteError = %v, WriteErrors = %d after the failure", durable, aw.LastWriteError(), aw.WriteErrors())
+		}
+		aw.Close()
+		w.Close()
+
+		// 只有第一条命令完整写入，第二条在中间被截断
+		commands, _, err := readAll(written.Bytes())
+		if !errors.Is(err, errCorruptRecord) || len(commands) != 1 || commands[0].Key != "first" {
+			t.Fatalf("durable %v: read back %v, %v, want first and a truncated record", durable, commands, err)
+		}
+	}
+}
+
+// BenchmarkAOFWriterBatching 并发写入在 FsyncAlways 下的吞吐量：每条命令单独写入并 fsync，
+// 或合并为一批只 fsync 一次，批次只取队列中已有的命令或再等待 flushDelay 凑齐更多命令。
+// Write 等到自己的批次落盘才返回，每个 CPU 64 个写入者
+func BenchmarkAOFWriterBatching(b *testing.B) {
+	ctx := context.Background()
+	for _, bench := range []struct {
+		name       string
+		batchSize  int
+		flushDelay time.Duration
+	}{
+		{"per-command", 1, 0},
+		{"batched", defaultBatchSize, 0},
+		{"batched/delay=1ms", defaultBatchSize, time.Millisecond},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			file, err := openAOF(filepath.Join(b.TempDir(), "cache.aof"))
+			if err != nil {
+				b.Fatal(err)
+			}
+			aw := NewAsyncAOFWriter(file, AOFWriterOptions{
+				Fsync:      FsyncAlways,
+				BatchSize:  bench.batchSize,
+				FlushDelay: bench.flushDelay,
+				Durable:    true,
+			}, log.NewHelper(log.NewStdLogger(io.Discard)))
+			defer func() {
+				aw.Close()
+				file.Close()
+			}()
+			command := biz.AOFCommand{Op: biz.AOFSet, Key: "key", Value: "value"}
+			b.SetParallelism(64)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if err := aw.Write(ctx, command); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
@@ -1,12 +1,19 @@
 package data
 
 import (
+	"bufio"
 	"context"
-	"encoding/gob"
+	"errors"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/log"
 	"gocache-service/internal/biz"
+	"gocache-service/internal/conf"
 	"io"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -14,117 +21,400 @@ type cacheRepo struct {
 	data      *Data
 	log       *log.Helper
 	aofWriter *AsyncAOFWriter
-	file      *os.File
+	// mu 保证 CleanupAOF 与 RewriteAOF 不会同时替换 AOF 文件
+	mu   sync.Mutex
+	file *os.File
+	// path AOF 文件路径，快照和重写用的临时文件都放在同一目录
+	path string
 }
 
 const (
 	defaultDataFile = "cache.aof"
 )
 
-func NewCacheRepo(data *Data, logger log.Logger) biz.CacheRepo {
+// diskSpace 返回目录所在文件系统的剩余与总字节数，测试中可以替换
+var diskSpace = statfsDisk
+
+func NewCacheRepo(c *conf.Data, data *Data, metrics biz.Metrics, logger log.Logger) (biz.CacheRepo, error) {
 	cacheR := &cacheRepo{
 		data: data,
 		log:  log.NewHelper(logger),
+		path: c.GetCache().GetDataFile(),
+	}
+	if cacheR.path == "" {
+		cacheR.path = defaultDataFile
+	}
+	policy, err := ParseFsyncPolicy(c.GetCache().GetAofFsync())
+	if err != nil {
+		cacheR.log.Warnf("%v, falling back to %s", err, policy)
+	}
+	queueFull, err := ParseQueueFullPolicy(c.GetCache().GetAofQueueFull())
+	if err != nil {
+		cacheR.log.Warnf("%v, falling back to %s", err, queueFull)
+	}
+	// 转换失败时拒绝启动，之后的记录不能追加到旧格式的文件中
+	converted, dropped, err := convertAOF(cacheR.path)
+	if err != nil {
+		return nil, fmt.Errorf("convert legacy AOF %s: %w", cacheR.path, err)
+	}
+	if converted > 0 || dropped > 0 {
+		cacheR.log.Infof("converted %d commands from legacy AOF format, dropped %d malformed commands", converted, dropped)
+	}
+	file, err := openAOF(cacheR.path)
+	if err != nil {
+		return nil, err
 	}
-	file, _ := os.OpenFile(defaultDataFile, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0666)
-	cacheR.aofWriter = NewAsyncAOFWriter(file, cacheR.log)
+	cacheR.aofWriter = NewAsyncAOFWriter(file, AOFWriterOptions{
+		Fsync:        policy,
+		QueueFull:    queueFull,
+		QueueTimeout: c.GetCache().GetAofQueueTimeout().AsDuration(),
+		QueueSize:    int(c.GetCache().GetAofQueueSize()),
+		BatchSize:    int(c.GetCache().GetAofBatchSize()),
+		FlushDelay:   c.GetCache().GetAofFlushDelay().AsDuration(),
+		Durable:      c.GetCache().GetAofDurableWrites(),
+		Metrics:      metrics,
+	}, cacheR.log)
 	cacheR.file = file
-	cacheR.init()
-	return cacheR
+	return cacheR, nil
 }
 
-func (r *cacheRepo) init() {
-	gob.Register(map[string]interface{}{})
-	gob.Register([]interface{}{})
-	gob.Register("")
-	gob.Register(time.Duration(0))
+// openAOF 以追加方式打开 AOF 文件，新文件先写入文件头
+func openAOF(path string) (*os.File, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if info.Size() == 0 {
+		if _, err := file.WriteString(aofMagic); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	return file, nil
 }
 
-func (r *cacheRepo) GetFile(ctx context.Context) (*os.File, error) {
-	file, err := os.OpenFile(defaultDataFile, os.O_RDONLY, 0666)
-	return file, err
+// Replay 按写入顺序把 AOF 中的命令逐条交给 apply，返回读取的命令数。
+// 文件不存在视为空；遇到不完整或损坏的记录时(通常是进程在写入中途被杀)，
+// 把文件截断到最后一条完整记录之后，之前的命令都已交给 apply。旧格式快照中无法转换的记录被跳过
+func (r *cacheRepo) Replay(ctx context.Context, apply func(command biz.AOFCommand)) (int, error) {
+	file, err := os.Open(r.path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	reader, err := newAOFReader(file)
+	if err != nil {
+		return 0, err
+	}
+	replayed := 0
+	for first := true; ; first = false {
+		command, err := reader.Next()
+		if err == io.EOF {
+			return replayed, nil
+		}
+		if errors.Is(err, errCorruptRecord) {
+			r.log.WithContext(ctx).Warnf("%v, truncating AOF to %d bytes, recovered %d commands", err, reader.offset, replayed)
+			return replayed, os.Truncate(r.path, reader.offset)
+		}
+		if err != nil {
+			return replayed, err
+		}
+		// 以纪元记录开头的 AOF 只包含对应快照之后的命令，需要先加载快照
+		if epoch, ok := epochOf(command); ok && first {
+			loaded, err := replaySnapshot(r.snapshotPath(epoch), epoch, apply)
+			if err != nil {
+				return replayed, fmt.Errorf("load snapshot %s: %w", epoch, err)
+			}
+			r.log.WithContext(ctx).Infof("loaded snapshot %s with %d records", epoch, loaded)
+			replayed += loaded
+			continue
+		}
+		apply(command)
+		replayed++
+	}
+}
+
+// snapshotPrefix 返回快照文件名中纪元之前的部分：AOF 路径去掉扩展名后加 "-"，
+// 默认的 cache.aof 对应 cache-<epoch>.snapshot
+func (r *cacheRepo) snapshotPrefix() string {
+	return strings.TrimSuffix(r.path, filepath.Ext(r.path)) + "-"
+}
+
+// snapshotPath 返回纪元 epoch 的快照文件路径，与 AOF 位于同一目录
+func (r *cacheRepo) snapshotPath(epoch string) string {
+	return r.snapshotPrefix() + epoch + ".snapshot"
+}
+
+// epochOf 判断命令是否为纪元记录
+func epochOf(command biz.AOFCommand) (string, bool) {
+	return command.Value, command.Op == biz.AOFEpoch
 }
 
-func (r *cacheRepo) Write(ctx context.Context, command []interface{}) error {
-	r.aofWriter.Write(command)
+// replaySnapshot 校验快照的纪元后把其中的记录逐条交给 apply。
+// 快照是整体改名生成的，任何读取错误都说明文件已损坏，不做截断
+func replaySnapshot(path, epoch string, apply func(command biz.AOFCommand)) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	reader, err := newRecordReader(file, snapshotMagic, legacySnapshotMagic)
+	if err != nil {
+		return 0, err
+	}
+	command, err := reader.Next()
+	if err != nil {
+		return 0, err
+	}
+	if got, ok := epochOf(command); !ok || got != epoch {
+		return 0, fmt.Errorf("%w: snapshot epoch %v does not match", errCorruptRecord, command)
+	}
+	loaded := 0
+	for {
+		command, err := reader.Next()
+		if err == io.EOF {
+			return loaded, nil
+		}
+		if errors.Is(err, errMalformedRecord) {
+			continue
+		}
+		if err != nil {
+			return loaded, err
+		}
+		apply(command)
+		loaded++
+	}
+}
+
+// removeSnapshots 删除除纪元 keep 以外的快照文件，keep 为空时全部删除
+func (r *cacheRepo) removeSnapshots(ctx context.Context, keep string) {
+	paths, err := filepath.Glob(r.snapshotPath("*"))
+	if err != nil {
+		return
+	}
+	for _, path := range paths {
+		if keep != "" && path == r.snapshotPath(keep) {
+			continue
+		}
+		// 纪元不含 "-"，同一目录下其他 AOF 的快照(例如 cache-b.aof 的 cache-b-<epoch>.snapshot)不能删除
+		if epoch := strings.TrimSuffix(strings.TrimPrefix(path, r.snapshotPrefix()), ".snapshot"); strings.Contains(epoch, "-") {
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			r.log.WithContext(ctx).Errorf("remove stale snapshot %s err: %v", path, err)
+		}
+	}
+}
+
+// Write 把命令交给异步写入器，队列已满或最近一次落盘失败时返回 biz.ErrAOFUnavailable
+func (r *cacheRepo) Write(ctx context.Context, command biz.AOFCommand) error {
+	if err := r.aofWriter.Write(ctx, command); err != nil {
+		return biz.ErrAOFUnavailable.WithCause(err)
+	}
 	return nil
 }
 
-// CleanupAOF 清理 AOF 文件中的过期记录
-func (r *cacheRepo) CleanupAOF(ctx context.Context, expiredKeys []string) error {
-	// 创建一个临时文件
-	dir, err := os.Getwd()
-	r.log.WithContext(ctx).Infof("CleanupAOF expiredKeys :%+v,dir:%s", expiredKeys, dir)
-	tempFile, err := os.CreateTemp("", "cache-aof-temp-*.tmp")
+// commandKeys 返回一条 AOF 命令涉及的所有键
+func commandKeys(command biz.AOFCommand) []string {
+	switch command.Op {
+	case biz.AOFMSet:
+		// Args 为键和值交替
+		keys := make([]string, 0, len(command.Args)/2)
+		for i := 0; i < len(command.Args); i += 2 {
+			keys = append(keys, command.Args[i])
+		}
+		return keys
+	case biz.AOFMDel:
+		return command.Args
+	case biz.AOFMulti:
+		var keys []string
+		for _, sub := range command.Commands {
+			keys = append(keys, commandKeys(sub)...)
+		}
+		return keys
+	case biz.AOFLoad, biz.AOFEpoch, biz.AOFFlushAll:
+		return nil
+	default:
+		return []string{command.Key}
+	}
+}
+
+// CleanupAOF 清理 AOF 文件中的过期记录。旧文件通过独立的只读句柄读取，
+// 清理期间写入的命令由写入器暂存并在替换文件时追加，不会与写入协程争用同一个句柄。
+// expiredKeys 在截取旧文件之后调用，截取点之前的记录中只清除它返回的键。
+// ctx 在复制完成前结束时放弃清理，原文件保持不变
+func (r *cacheRepo) CleanupAOF(ctx context.Context, expiredKeys func() []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cut, err := r.aofWriter.BeginCleanup()
 	if err != nil {
-		r.log.WithContext(ctx).Errorf("CreateTemp err:%v", err)
 		return err
 	}
-	defer tempFile.Close()
+	keys := expiredKeys()
+	r.log.WithContext(ctx).Infof("CleanupAOF expiredKeys :%+v", keys)
 	// 标记过期键
 	expiredKeySet := make(map[string]bool)
-	for _, key := range expiredKeys {
+	for _, key := range keys {
 		expiredKeySet[key] = true
 	}
 
-	// 回到文件开头
-	_, err = r.file.Seek(0, 0)
+	tempFile, err := os.CreateTemp(filepath.Dir(r.path), "cache-aof-temp-*.tmp")
 	if err != nil {
+		r.log.WithContext(ctx).Errorf("CreateTemp err:%v", err)
+		_, _, _ = r.aofWriter.FinishCleanup(r.path, nil, true)
+		return err
+	}
+	copyErr := copyLiveRecords(ctx, tempFile, r.path, cut, expiredKeySet)
+	if copyErr != nil {
+		r.log.WithContext(ctx).Errorf("CleanupAOF copy err:%v", copyErr)
+	}
+	file, _, err := r.aofWriter.FinishCleanup(r.path, tempFile, copyErr != nil)
+	if copyErr != nil {
+		return copyErr
+	}
+	if err != nil {
+		return err
+	}
+	r.file = file
+	return nil
+}
+
+// copyLiveRecords 以只读方式打开 path，把前 size 字节中涉及未过期键的命令写入 dst(含文件头)，
+// ctx 结束时停止并返回 ctx.Err()
+func copyLiveRecords(ctx context.Context, dst io.Writer, path string, size int64, expiredKeySet map[string]bool) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	reader, err := newAOFReader(io.LimitReader(src, size))
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(dst)
+	if _, err := w.WriteString(aofMagic); err != nil {
 		return err
 	}
-	decoder := gob.NewDecoder(r.file)
-	encoder := gob.NewEncoder(tempFile)
 	for {
-		var command []interface{}
-		err = decoder.Decode(&command)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		command, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if errors.Is(err, errMalformedRecord) {
+			continue
+		}
 		if err != nil {
-			r.log.WithContext(ctx).Errorf("CleanupAOF Decode err:%v", err)
-			if err == io.EOF {
-				break
-			}
 			return err
 		}
-		if len(command) == 4 && command[0] == "SET" {
-			key := command[1].(string)
-			// 如果键不是过期键，则写入临时文件
+		// 纪元记录必须保留，只要命令涉及的键不全是过期键，就写入新文件
+		_, keep := epochOf(command)
+		for _, key := range commandKeys(command) {
 			if !expiredKeySet[key] {
-				err := encoder.Encode(command)
-				if err != nil {
-					return err
-				}
+				keep = true
+				break
 			}
-		} else if len(command) == 2 && command[0] == "DEL" {
-			key := command[1].(string)
-			// 如果键不是过期键，则写入临时文件
-			if !expiredKeySet[key] {
-				err := encoder.Encode(command)
-				if err != nil {
-					return err
-				}
+		}
+		if keep {
+			if err := encodeRecord(w, command); err != nil {
+				return err
 			}
 		}
 	}
+	return w.Flush()
+}
 
-	// 关闭原文件
-	err = r.file.Close()
+// RewriteAOF 在同目录的临时文件中用 snapshot 提供的各分片快照重建 AOF，完成后改名替换原文件。
+// 重写期间写入的命令按队列顺序同时追加到新文件，不会丢失；snapshot 返回错误时放弃重写
+func (r *cacheRepo) RewriteAOF(ctx context.Context, snapshot func(emit func(items map[string]biz.CacheItem)) error) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tempFile, err := os.CreateTemp(filepath.Dir(r.path), "cache-aof-rewrite-*.tmp")
 	if err != nil {
-		return err
+		return 0, err
+	}
+	r.aofWriter.BeginRewrite(tempFile)
+	snapshotErr := snapshot(r.aofWriter.WriteSnapshot)
+	file, size, err := r.aofWriter.FinishRewrite(r.path, snapshotErr != nil)
+	if snapshotErr != nil {
+		return 0, snapshotErr
 	}
-	// 删除原文件
-	err = os.Remove(r.file.Name())
 	if err != nil {
-		return err
+		return 0, err
 	}
-	// 将临时文件重命名为原文件
-	err = os.Rename(tempFile.Name(), defaultDataFile)
+	r.file = file
+	// 新的 AOF 包含全部数据且没有纪元记录，之前的快照都已不再需要
+	r.removeSnapshots(ctx, "")
+	return size, nil
+}
+
+// SaveSnapshot 把 snapshot 提供的各分片快照写入新的快照文件，完成后 AOF 换成只记录之后命令的新文件，
+// 返回快照文件的大小。生成期间写入的命令同时追加到快照末尾，不会丢失
+func (r *cacheRepo) SaveSnapshot(ctx context.Context, snapshot func(emit func(items map[string]biz.CacheItem)) error) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tempFile, err := os.CreateTemp(filepath.Dir(r.path), "cache-snapshot-*.tmp")
 	if err != nil {
-		return err
+		return 0, err
+	}
+	epoch := strconv.FormatInt(time.Now().UnixNano(), 36)
+	r.aofWriter.BeginSnapshot(tempFile, epoch)
+	snapshotErr := snapshot(r.aofWriter.WriteSnapshot)
+	file, size, err := r.aofWriter.FinishSnapshot(r.path, r.snapshotPath(epoch), snapshotErr != nil)
+	if snapshotErr != nil {
+		return 0, snapshotErr
 	}
-	// 重新打开原文件
-	r.file, err = os.OpenFile(r.file.Name(), os.O_APPEND|os.O_CREATE|os.O_RDWR, 0666)
 	if err != nil {
+		return 0, err
+	}
+	r.file = file
+	r.removeSnapshots(ctx, epoch)
+	return size, nil
+}
+
+// Truncate 清空 AOF 并删除所有快照，调用方需保证期间没有新的写入
+func (r *cacheRepo) Truncate(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.aofWriter.Truncate(); err != nil {
 		return err
 	}
-	r.aofWriter.file = r.file
+	r.removeSnapshots(ctx, "")
 	return nil
 }
+
+// AOFStats 返回 AOF 文件大小与写入队列中等待的命令数，文件不存在时大小为 0
+func (r *cacheRepo) AOFStats(ctx context.Context) (biz.AOFStats, error) {
+	stats := biz.AOFStats{
+		QueueDepth:    r.aofWriter.QueueDepth(),
+		WriteErrors:   r.aofWriter.WriteErrors(),
+		QueueRejected: r.aofWriter.QueueRejected(),
+	}
+	info, err := os.Stat(r.path)
+	if err != nil && !os.IsNotExist(err) {
+		return stats, err
+	}
+	if err == nil {
+		stats.FileSize = info.Size()
+	}
+	stats.DiskFree, stats.DiskTotal, err = diskSpace(filepath.Dir(r.path))
+	return stats, err
+}
+
+// Close 等待异步写入器把队列中的命令全部落盘后关闭 AOF 文件
+func (r *cacheRepo) Close(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.aofWriter.Close()
+	return r.file.Close()
+}
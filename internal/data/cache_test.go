@@ -0,0 +1,545 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"gocache-service/internal/biz"
+	"gocache-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// openTestCache 在 dir 中的 AOF 之上创建缓存，启动时重放已有的记录。返回的缓存需要调用方关闭，
+// 关闭后用同一个 dir 再次调用相当于重启
+func openTestCache(tb testing.TB, dir string, cfg *conf.Data_Cache) *biz.GoCacheUsecase {
+	tb.Helper()
+	if cfg == nil {
+		cfg = &conf.Data_Cache{}
+	}
+	cfg.DataFile = filepath.Join(dir, defaultDataFile)
+	c := &conf.Data{Cache: cfg}
+	logger := log.NewStdLogger(io.Discard)
+	repo, err := NewCacheRepo(c, &Data{}, nil, logger)
+	if err != nil {
+		tb.Fatalf("NewCacheRepo: %v", err)
+	}
+	cache, _, err := biz.NewGoCacheUsecase(c, repo, nil, nil, logger)
+	if err != nil {
+		tb.Fatalf("NewGoCacheUsecase: %v", err)
+	}
+	return cache
+}
+
+func TestReplayAfterThreeRestarts(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	want := make(map[string]string)
+	for cycle := 0; cycle < 3; cycle++ {
+		cache := openTestCache(t, dir, nil)
+		for key, value := range want {
+			if got, err := cache.Get(ctx, key); err != nil || got != value {
+				t.Fatalf("restart %d: Get(%s) = %q, %v, want %q", cycle, key, got, err, value)
+			}
+		}
+		for i := 0; i < 100; i++ {
+			key, value := fmt.Sprintf("c%d-k%d", cycle, i), fmt.Sprintf("v%d", i)
+			if err := cache.Set(ctx, key, value, 0); err != nil {
+				t.Fatal(err)
+			}
+			want[key] = value
+		}
+		// 覆盖和删除上一轮写入的键，重放时也要生效
+		if cycle > 0 {
+			prev := fmt.Sprintf("c%d-", cycle-1)
+			if err := cache.Set(ctx, prev+"k0", "overwritten", 0); err != nil {
+				t.Fatal(err)
+			}
+			want[prev+"k0"] = "overwritten"
+			if err := cache.Delete(ctx, prev+"k1"); err != nil {
+				t.Fatal(err)
+			}
+			delete(want, prev+"k1")
+		}
+		if err := cache.Close(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cache := openTestCache(t, dir, nil)
+	defer cache.Close(ctx)
+	if n, _ := cache.DBSize(ctx); n != int64(len(want)) {
+		t.Fatalf("DBSize = %d after restarts, want %d", n, len(want))
+	}
+	for key, value := range want {
+		if got, err := cache.Get(ctx, key); err != nil || got != value {
+			t.Fatalf("Get(%s) = %q, %v, want %q", key, got, err, value)
+		}
+	}
+}
+
+func TestReplayTruncatedAOF(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name string
+		// keys 截断后剩下的键数
+		keys   int
+		damage func(path string) error
+	}{
+		{"partial record", 9, func(path string) error {
+			info, err := os.Stat(path)
+			if err != nil {
+				return err
+			}
+			return os.Truncate(path, info.Size()-3)
+		}},
+		{"garbage tail", 10, func(path string) error {
+			file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			_, err = file.Write([]byte{0, 0, 0, 9, 0xff, 0xfe})
+			return err
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			cache := openTestCache(t, dir, nil)
+			for i := 0; i < 10; i++ {
+				if err := cache.Set(ctx, fmt.Sprintf("k%d", i), "v", 0); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := cache.Close(ctx); err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join(dir, defaultDataFile)
+			if err := tc.damage(path); err != nil {
+				t.Fatal(err)
+			}
+
+			cache = openTestCache(t, dir, nil)
+			keys := 0
+			for i := 0; i < 10; i++ {
+				if _, err := cache.Get(ctx, fmt.Sprintf("k%d", i)); err == nil {
+					keys++
+				}
+			}
+			// 截断只会丢掉最后一条不完整的记录
+			if keys != tc.keys {
+				t.Fatalf("recovered %d keys, want %d", keys, tc.keys)
+			}
+			// 截断后追加的记录在下次启动时照常重放
+			if err := cache.Set(ctx, "after", "v", 0); err != nil {
+				t.Fatal(err)
+			}
+			if err := cache.Close(ctx); err != nil {
+				t.Fatal(err)
+			}
+			cache = openTestCache(t, dir, nil)
+			defer cache.Close(ctx)
+			if n, _ := cache.DBSize(ctx); n != int64(keys+1) {
+				t.Fatalf("DBSize = %d after the second restart, want %d", n, keys+1)
+			}
+			if _, err := cache.Get(ctx, "after"); err != nil {
+				t.Fatalf("Get(after): %v", err)
+			}
+		})
+	}
+}
+
+func TestReplayMissingOrEmptyAOF(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name    string
+		prepare func(path string) error
+	}{
+		{"missing file", func(string) error { return nil }},
+		{"empty file", func(path string) error { return os.WriteFile(path, nil, 0o644) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := tc.prepare(filepath.Join(dir, defaultDataFile)); err != nil {
+				t.Fatal(err)
+			}
+			cache := openTestCache(t, dir, nil)
+			if n, _ := cache.DBSize(ctx); n != 0 {
+				t.Fatalf("DBSize = %d on a fresh start, want 0", n)
+			}
+			if stats, _ := cache.Stats(ctx); stats.AOFReplayed != 0 || stats.AOFReplaySkipped != 0 {
+				t.Fatalf("replayed %d, skipped %d on a fresh start", stats.AOFReplayed, stats.AOFReplaySkipped)
+			}
+			if err := cache.Set(ctx, "k", "v", 0); err != nil {
+				t.Fatal(err)
+			}
+			if err := cache.Close(ctx); err != nil {
+				t.Fatal(err)
+			}
+			cache = openTestCache(t, dir, nil)
+			defer cache.Close(ctx)
+			if v, err := cache.Get(ctx, "k"); err != nil || v != "v" {
+				t.Fatalf("Get(k) = %q, %v after restart", v, err)
+			}
+			if stats, _ := cache.Stats(ctx); stats.AOFReplayed != 1 {
+				t.Fatalf("replayed %d commands after restart, want 1", stats.AOFReplayed)
+			}
+		})
+	}
+}
+
+// 旧格式中类型不符的记录在转换时被丢弃，其余记录照常重放
+func TestReplayLegacyWrongTypedRecord(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	var buf bytes.Buffer
+	buf.WriteString(legacyAOFMagic)
+	for _, record := range [][]interface{}{
+		{"SET", "good1", "v", int64(0)},
+		{"SET", int64(42), "v", int64(0)},
+		{"SET", "bad", "v", "never"},
+		{"SET", "good2", "v", int64(0)},
+	} {
+		var payload bytes.Buffer
+		if err := gob.NewEncoder(&payload).Encode(record); err != nil {
+			t.Fatal(err)
+		}
+		if err := writeFrame(&buf, payload.Bytes()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := filepath.Join(dir, defaultDataFile)
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cache := openTestCache(t, dir, nil)
+	defer cache.Close(ctx)
+	for _, key := range []string{"good1", "good2"} {
+		if _, err := cache.Get(ctx, key); err != nil {
+			t.Fatalf("Get(%s): %v", key, err)
+		}
+	}
+	if n, _ := cache.DBSize(ctx); n != 2 {
+		t.Fatalf("DBSize = %d, want 2", n)
+	}
+	if stats, _ := cache.Stats(ctx); stats.AOFReplayed != 2 {
+		t.Fatalf("replayed %d commands, want 2", stats.AOFReplayed)
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(raw, []byte(aofMagic)) {
+		t.Fatalf("AOF not converted to the current format, header %q", raw[:len(aofMagic)])
+	}
+}
+
+// 能解码但无法应用的记录计入 AOFReplaySkipped，开启 aof_replay_fail_fast 后超过上限拒绝启动
+func TestReplaySkipsMalformedRecord(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	raw := encodeAOF(t,
+		biz.AOFCommand{Op: biz.AOFSet, Key: "k1", Value: "v"},
+		biz.AOFCommand{Op: biz.AOFHSet, Key: "h", Value: "v"},
+		biz.AOFCommand{Op: biz.AOFSet, Key: "k2", Value: "v"},
+	)
+	if err := os.WriteFile(filepath.Join(dir, defaultDataFile), raw, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cache := openTestCache(t, dir, &conf.Data_Cache{AofReplayFailFast: true, AofReplayMaxSkipped: 1})
+	stats, _ := cache.Stats(ctx)
+	if stats.AOFReplayed != 2 || stats.AOFReplaySkipped != 1 {
+		t.Fatalf("replayed %d, skipped %d, want 2 and 1", stats.AOFReplayed, stats.AOFReplaySkipped)
+	}
+	if _, err := cache.Get(ctx, "k2"); err != nil {
+		t.Fatalf("Get(k2): %v", err)
+	}
+	if err := cache.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &conf.Data{Cache: &conf.Data_Cache{
+		DataFile:          filepath.Join(dir, defaultDataFile),
+		AofReplayFailFast: true,
+	}}
+	logger := log.NewStdLogger(io.Discard)
+	repo, err := NewCacheRepo(c, &Data{}, nil, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := biz.NewGoCacheUsecase(c, repo, nil, nil, logger); err == nil {
+		t.Fatal("NewGoCacheUsecase started with a skipped record and aof_replay_max_skipped 0")
+	}
+}
+
+// 1MB 的随机字节(含 NUL，不是合法的 UTF-8)经过 AOF 重放、重写和快照后逐字节不变
+func TestBinaryValueSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	blob := make([]byte, 1<<20)
+	if _, err := rand.New(rand.NewSource(1)).Read(blob); err != nil {
+		t.Fatal(err)
+	}
+	blob[0], blob[1] = 0, 0xff
+	if utf8.Valid(blob) {
+		t.Fatal("test blob is valid UTF-8")
+	}
+
+	cache := openTestCache(t, dir, nil)
+	if err := cache.SetBytes(ctx, "blob", blob, 0); err != nil {
+		t.Fatal(err)
+	}
+	for _, step := range []struct {
+		name string
+		run  func(c *biz.GoCacheUsecase) error
+	}{
+		{"AOF replay", func(*biz.GoCacheUsecase) error { return nil }},
+		{"AOF rewrite", func(c *biz.GoCacheUsecase) error { _, err := c.RewriteAOF(ctx); return err }},
+		{"snapshot", func(c *biz.GoCacheUsecase) error { _, err := c.SaveSnapshot(ctx); return err }},
+	} {
+		if err := step.run(cache); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if err := cache.Close(ctx); err != nil {
+			t.Fatal(err)
+		}
+		cache = openTestCache(t, dir, nil)
+		got, err := cache.GetBytes(ctx, "blob")
+		if err != nil {
+			t.Fatalf("GetBytes after %s and restart: %v", step.name, err)
+		}
+		if !bytes.Equal(got, blob) {
+			t.Fatalf("value differs after %s and restart: %d bytes, want %d", step.name, len(got), len(blob))
+		}
+	}
+	if err := cache.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// 用 go test -race 运行时同时检查清理与写入协程之间没有数据竞争
+func TestCleanupAOFDuringConcurrentWrites(t *testing.T) {
+	const writers, perWriter, cleanups = 8, 300, 20
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), defaultDataFile)
+	repo, err := NewCacheRepo(&conf.Data{Cache: &conf.Data_Cache{DataFile: path}}, &Data{}, nil, log.NewStdLogger(io.Discard))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expired []string
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("expired%d", i)
+		expired = append(expired, key)
+		if err := repo.Write(ctx, biz.AOFCommand{Op: biz.AOFSet, Key: key, Value: "v"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				command := biz.AOFCommand{Op: biz.AOFSet, Key: fmt.Sprintf("w%d", w), Value: fmt.Sprint(i)}
+				if err := repo.Write(ctx, command); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(w)
+	}
+	for i := 0; i < cleanups; i++ {
+		if err := repo.CleanupAOF(ctx, func() []string { return expired }); err != nil {
+			t.Fatalf("CleanupAOF: %v", err)
+		}
+	}
+	wg.Wait()
+	if err := repo.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 过期键的记录全部被清理，每个写入者的命令都完整且按顺序保留
+	next := make(map[string]int)
+	for _, command := range readAOF(t, path) {
+		if strings.HasPrefix(command.Key, "expired") {
+			t.Fatalf("record for %s survived the cleanup", command.Key)
+		}
+		if want := fmt.Sprint(next[command.Key]); command.Value != want {
+			t.Fatalf("%s: value %s after %d commands, want %s", command.Key, command.Value, next[command.Key], want)
+		}
+		next[command.Key]++
+	}
+	for w := 0; w < writers; w++ {
+		if key := fmt.Sprintf("w%d", w); next[key] != perWriter {
+			t.Fatalf("%s: %d commands in the AOF, want %d", key, next[key], perWriter)
+		}
+	}
+}
+
+func TestCloseFlushesQueuedWrites(t *testing.T) {
+	const writes = 300
+	ctx := context.Background()
+	dir := t.TempDir()
+	// 批次凑满之前最多等待 1s，Close 时大部分命令还在队列或缓冲区中
+	cache := openTestCache(t, dir, &conf.Data_Cache{
+		AofFsync:      string(FsyncEverySec),
+		AofFlushDelay: durationpb.New(time.Second),
+	})
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < writes; i += 4 {
+				if err := cache.Set(ctx, fmt.Sprintf("k%d", i), "v", 0); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	if err := cache.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	cache = openTestCache(t, dir, nil)
+	defer cache.Close(ctx)
+	stats, err := cache.Stats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.AOFReplayed != writes {
+		t.Fatalf("replayed %d commands, want %d", stats.AOFReplayed, writes)
+	}
+	if n, _ := cache.DBSize(ctx); n != writes {
+		t.Fatalf("DBSize = %d after restart, want %d", n, writes)
+	}
+}
+
+// BenchmarkSetFsyncPolicy 并发 Set 在各 fsync 策略下的吞吐量，AOF 写入临时目录所在的磁盘。
+// durable 的子测试中 Set 等到自己的批次落盘才返回，耗时包括 fsync
+// stallMetrics 在 stall 关闭之前让写入协程停在处理完一批之后的上报处，队列因此只进不出
+type stallMetrics struct {
+	biz.NopMetrics
+	once    sync.Once
+	stalled chan struct{}
+	stall   chan struct{}
+}
+
+func (m *stallMetrics) ObserveAOFQueueDepth(int) {
+	m.once.Do(func() { close(m.stalled) })
+	<-m.stall
+}
+
+// 写入队列已满时 Set 在 ctx 取消后立即返回取消错误，而不是等到 aof_queue_timeout
+func TestSetReturnsWhenCanceledOnFullQueue(t *testing.T) {
+	ctx := context.Background()
+	c := &conf.Data{Cache: &conf.Data_Cache{
+		DataFile:        filepath.Join(t.TempDir(), defaultDataFile),
+		AofQueueSize:    1,
+		AofQueueTimeout: durationpb.New(time.Minute),
+	}}
+	metrics := &stallMetrics{stalled: make(chan struct{}), stall: make(chan struct{})}
+	logger := log.NewStdLogger(io.Discard)
+	repo, err := NewCacheRepo(c, &Data{}, metrics, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache, _, err := biz.NewGoCacheUsecase(c, repo, nil, nil, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cache.Close(ctx)
+	defer close(metrics.stall)
+
+	// 第一条命令写完后写入协程停住，第二条命令占满队列
+	if err := cache.Set(ctx, "k1", "v", 0); err != nil {
+		t.Fatal(err)
+	}
+	<-metrics.stalled
+	if err := cache.Set(ctx, "k2", "v", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	time.AfterFunc(20*time.Millisecond, cancel)
+	start := time.Now()
+	err = cache.Set(canceled, "k3", "v", 0)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Set on a full queue: err = %v, want context.Canceled", err)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Fatalf("Set returned %v after the cancellation", d)
+	}
+}
+
+func BenchmarkSetFsyncPolicy(b *testing.B) {
+	ctx := context.Background()
+	for _, policy := range []FsyncPolicy{FsyncAlways, FsyncEverySec, FsyncNo} {
+		for _, durable := range []bool{false, true} {
+			name := string(policy)
+			if durable {
+				name += "/durable"
+			}
+			b.Run(name, func(b *testing.B) {
+				cache := openTestCache(b, b.TempDir(), &conf.Data_Cache{AofFsync: string(policy), AofDurableWrites: durable})
+				defer cache.Close(ctx)
+				var n atomic.Int64
+				b.ResetTimer()
+				b.RunParallel(func(pb *testing.PB) {
+					for pb.Next() {
+						if err := cache.Set(ctx, fmt.Sprintf("k%d", n.Add(1)%10000), "value", 0); err != nil {
+							b.Error(err)
+							return
+						}
+					}
+				})
+			})
+		}
+	}
+}
+
+// 剩余空间来自 AOF 所在目录的 statfs，低于下限时启动后第一个写入就被拒绝
+func TestDiskSpaceOfDataDir(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	var statted atomic.Value
+	defer func(orig func(string) (int64, int64, error)) { diskSpace = orig }(diskSpace)
+	diskSpace = func(path string) (int64, int64, error) {
+		statted.Store(path)
+		return 100, 1 << 20, nil
+	}
+	cache := openTestCache(t, dir, &conf.Data_Cache{DiskMinFreeBytes: 1000, DiskCheckInterval: durationpb.New(time.Hour)})
+	defer cache.Close(ctx)
+	if path := statted.Load(); path != dir {
+		t.Fatalf("statfs on %q, want the data dir %q", path, dir)
+	}
+	stats, err := cache.Stats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Disk.State != biz.DiskCritical || stats.Disk.FreeBytes != 100 || stats.Disk.TotalBytes != 1<<20 {
+		t.Fatalf("disk = %+v, want critical with 100 of %d bytes free", stats.Disk, 1<<20)
+	}
+	if err := cache.Set(ctx, "k", "v", 0); !errors.Is(err, biz.ErrDiskFull) {
+		t.Fatalf("Set err = %v, want ErrDiskFull", err)
+	}
+}
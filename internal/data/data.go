@@ -8,7 +8,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewCacheRepo)
+var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewCacheRepo, NewLeaderClient)
 
 // Data .
 type Data struct {
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package data
+
+// statfsDisk 在不支持 statfs 的平台上总数为 0，表示剩余空间未知，不做磁盘空间检查
+func statfsDisk(string) (free, total int64, err error) {
+	return 0, 0, nil
+}
@@ -0,0 +1,14 @@
+//go:build unix
+
+package data
+
+import "syscall"
+
+// statfsDisk 返回 dir 所在文件系统中非特权进程可用的字节数与总字节数
+func statfsDisk(dir string) (free, total int64, err error) {
+	var fs syscall.Statfs_t
+	if err := syscall.Statfs(dir, &fs); err != nil {
+		return 0, 0, err
+	}
+	return int64(fs.Bavail) * int64(fs.Bsize), int64(fs.Blocks) * int64(fs.Bsize), nil
+}
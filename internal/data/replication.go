@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"math"
+
+	v1 "gocache-service/api/cache/v1"
+	"gocache-service/internal/biz"
+	"gocache-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	kgrpc "github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc"
+)
+
+// leaderClient 通过主节点的 Replicate 接口接收复制流
+type leaderClient struct {
+	client v1.CacheServiceClient
+}
+
+// NewLeaderClient 未配置 replica_of 时返回 nil，缓存作为主节点运行。
+// 连接在第一次请求时建立，主节点暂时不可用不影响启动
+func NewLeaderClient(c *conf.Data, logger log.Logger) (biz.LeaderClient, func(), error) {
+	addr := c.GetCache().GetReplicaOf()
+	if addr == "" {
+		return nil, func() {}, nil
+	}
+	conn, err := kgrpc.DialInsecure(context.Background(),
+		kgrpc.WithEndpoint(addr),
+		// 单个值和全量同步的 LOAD 记录都可能超过默认的 4MB
+		kgrpc.WithOptions(grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(math.MaxInt32))),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		if err := conn.Close(); err != nil {
+			log.NewHelper(logger).Errorf("close leader connection err: %v", err)
+		}
+	}
+	return &leaderClient{client: v1.NewCacheServiceClient(conn)}, cleanup, nil
+}
+
+func (l *leaderClient) Replicate(ctx context.Context, replicationID string, offset int64, apply func(biz.ReplicationEvent) error) error {
+	stream, err := l.client.Replicate(ctx, &v1.ReplicateRequest{ReplicationId: replicationID, Offset: offset})
+	if err != nil {
+		return err
+	}
+	for {
+		reply, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		ev := biz.ReplicationEvent{
+			ReplicationID: reply.ReplicationId,
+			Offset:        reply.Offset,
+			FullSync:      reply.FullSync,
+		}
+		if len(reply.Command) > 0 {
+			command, err := biz.DecodeCommand(reply.Command)
+			if err != nil {
+				return err
+			}
+			ev.Command = &command
+		}
+		if err := apply(ev); err != nil {
+			return err
+		}
+	}
+}
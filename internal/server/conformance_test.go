@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gocache-service/internal/biz"
+	"gocache-service/internal/conf"
+	"gocache-service/internal/data"
+	"gocache-service/internal/service"
+	"gocache-service/pkg/conformance"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	ggrpc "google.golang.org/grpc"
+)
+
+// grpcInstance serves the cache on a fake clock over gRPC on a free port. The
+// AOF lives in a directory that outlives the instance, so restart can reopen it.
+type grpcInstance struct {
+	t     *testing.T
+	clock biz.Clock
+	path  string
+
+	uc   *biz.GoCacheUsecase
+	srv  *grpc.Server
+	conn *ggrpc.ClientConn
+}
+
+func startGRPC(t *testing.T, clock biz.Clock) *grpcInstance {
+	t.Helper()
+	g := &grpcInstance{t: t, clock: clock, path: filepath.Join(t.TempDir(), "cache.aof")}
+	if err := g.start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { g.stop(context.Background()) })
+	return g
+}
+
+func (g *grpcInstance) start(ctx context.Context) error {
+	logger := log.NewStdLogger(io.Discard)
+	dc := &conf.Data{Cache: &conf.Data_Cache{DataFile: g.path}}
+	repo, err := data.NewCacheRepo(dc, &data.Data{}, nil, logger)
+	if err != nil {
+		return err
+	}
+	g.uc, _, err = biz.NewGoCacheUsecaseWithOptions(dc, repo, nil, nil, logger, biz.WithClock(g.clock))
+	if err != nil {
+		return err
+	}
+	greeter := service.NewGreeterService(biz.NewGreeterUsecase(data.NewGreeterRepo(&data.Data{}, logger), logger))
+	g.srv = NewGRPCServer(&conf.Server{Grpc: &conf.Server_GRPC{Addr: "127.0.0.1:0"}}, greeter, service.NewCacheService(g.uc), logger)
+	endpoint, err := g.srv.Endpoint()
+	if err != nil {
+		return err
+	}
+	go g.srv.Start(ctx)
+	g.conn, err = grpc.DialInsecure(ctx, grpc.WithEndpoint(endpoint.Host))
+	return err
+}
+
+func (g *grpcInstance) stop(ctx context.Context) {
+	if g.conn != nil {
+		g.conn.Close()
+	}
+	g.srv.Stop(ctx)
+	g.uc.Close(ctx)
+}
+
+// restart stops the instance and starts a new one from the same AOF.
+func (g *grpcInstance) restart(ctx context.Context) (ggrpc.ClientConnInterface, error) {
+	g.stop(ctx)
+	if err := g.start(ctx); err != nil {
+		return nil, err
+	}
+	return g.conn, nil
+}
+
+// TestConformance runs the scenario corpus against an in-process server whose
+// clock only moves on advance steps and which restarts from its AOF on restart steps.
+func TestConformance(t *testing.T) {
+	files, err := conformance.Files("../../test/conformance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no scenario files")
+	}
+	clock := biz.NewFakeClock(time.Now())
+	g := startGRPC(t, clock)
+	runner := &conformance.Runner{Conn: g.conn, Advance: clock.Advance, Restart: g.restart}
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			if err := runner.RunFile(context.Background(), file); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 func NewHTTPServer(c *conf.Server,
 	greeter *service.GreeterService,
 	cacheService *service.CacheService,
+	metrics *PrometheusMetrics,
 	logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
@@ -33,5 +34,6 @@ func NewHTTPServer(c *conf.Server,
 	srv := http.NewServer(opts...)
 	v1.RegisterGreeterHTTPServer(srv, greeter)
 	v1cache.RegisterCacheServiceHTTPServer(srv, cacheService)
+	srv.Handle("/metrics", metrics.Handler())
 	return srv
 }
@@ -0,0 +1,184 @@
+package server
+
+import (
+	nethttp "net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"gocache-service/internal/biz"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// PrometheusMetrics implements biz.Metrics with Prometheus collectors kept in
+// their own registry, exposed on the HTTP server at /metrics.
+type PrometheusMetrics struct {
+	registry *prometheus.Registry
+
+	getHit    prometheus.Observer
+	getMiss   prometheus.Observer
+	set       prometheus.Observer
+	del       prometheus.Observer
+	keys      *prometheus.GaugeVec
+	aofQueue  prometheus.Gauge
+	expired   prometheus.Counter
+	wheelTick prometheus.Observer
+	lockWaits *lockWaitCollector
+}
+
+var _ biz.Metrics = (*PrometheusMetrics)(nil)
+
+// NewPrometheusMetrics creates the cache collectors and registers them together
+// with the Go runtime and process collectors.
+func NewPrometheusMetrics() *PrometheusMetrics {
+	latency := []float64{.00001, .00005, .0001, .0005, .001, .005, .01, .05, .1}
+	get := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "gocache_get_duration_seconds",
+		Help:    "Latency of Get calls, partitioned by whether a value was returned.",
+		Buckets: latency,
+	}, []string{"result"})
+	set := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "gocache_set_duration_seconds",
+		Help:    "Latency of Set calls.",
+		Buckets: latency,
+	})
+	del := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "gocache_delete_duration_seconds",
+		Help:    "Latency of Delete calls.",
+		Buckets: latency,
+	})
+	wheelTick := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "gocache_time_wheel_tick_duration_seconds",
+		Help:    "Time spent deleting the keys due in one time wheel tick.",
+		Buckets: prometheus.DefBuckets,
+	})
+	m := &PrometheusMetrics{
+		registry:  prometheus.NewRegistry(),
+		getHit:    get.WithLabelValues("hit"),
+		getMiss:   get.WithLabelValues("miss"),
+		set:       set,
+		del:       del,
+		wheelTick: wheelTick,
+		lockWaits: newLockWaitCollector(),
+		keys: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gocache_keys",
+			Help: "Keys per shard, including expired keys not yet removed; updated after each cleanup pass.",
+		}, []string{"shard"}),
+		aofQueue: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "gocache_aof_queue_depth",
+			Help: "Commands waiting in the AOF write queue.",
+		}),
+		expired: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "gocache_expired_keys_total",
+			Help: "Keys removed because they expired.",
+		}),
+	}
+	m.registry.MustRegister(
+		get, set, del, wheelTick, m.keys, m.aofQueue, m.expired, m.lockWaits,
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// Handler serves the registered metrics in the Prometheus text format.
+func (m *PrometheusMetrics) Handler() nethttp.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *PrometheusMetrics) ObserveGet(hit bool, d time.Duration) {
+	if hit {
+		m.getHit.Observe(d.Seconds())
+	} else {
+		m.getMiss.Observe(d.Seconds())
+	}
+}
+
+func (m *PrometheusMetrics) ObserveSet(d time.Duration) {
+	m.set.Observe(d.Seconds())
+}
+
+func (m *PrometheusMetrics) ObserveDelete(d time.Duration) {
+	m.del.Observe(d.Seconds())
+}
+
+func (m *PrometheusMetrics) SetKeyCount(shard, n int) {
+	m.keys.WithLabelValues(strconv.Itoa(shard)).Set(float64(n))
+}
+
+func (m *PrometheusMetrics) ObserveAOFQueueDepth(n int) {
+	m.aofQueue.Set(float64(n))
+}
+
+func (m *PrometheusMetrics) IncExpired(n int) {
+	m.expired.Add(float64(n))
+}
+
+func (m *PrometheusMetrics) ObserveWheelTick(d time.Duration) {
+	m.wheelTick.Observe(d.Seconds())
+}
+
+func (m *PrometheusMetrics) SetLockWaits(shards []biz.LockWaitSnapshot, timeWheel biz.LockWaitSnapshot) {
+	m.lockWaits.set(shards, timeWheel)
+}
+
+// lockWaitCollector exports the lock wait histograms kept by the cache. They
+// are cumulative already, so the latest snapshots are re-emitted as constant
+// histograms on every scrape instead of being observed again.
+type lockWaitCollector struct {
+	shard     *prometheus.Desc
+	timeWheel *prometheus.Desc
+
+	mu         sync.Mutex
+	shards     []biz.LockWaitSnapshot
+	wheelWaits biz.LockWaitSnapshot
+}
+
+func newLockWaitCollector() *lockWaitCollector {
+	return &lockWaitCollector{
+		shard: prometheus.NewDesc("gocache_shard_lock_wait_seconds",
+			"Time spent waiting for a shard lock, waits below lock_wait_threshold are not counted.",
+			[]string{"shard"}, nil),
+		timeWheel: prometheus.NewDesc("gocache_time_wheel_lock_wait_seconds",
+			"Time spent waiting for the time wheel lock, waits below lock_wait_threshold are not counted.",
+			nil, nil),
+	}
+}
+
+func (c *lockWaitCollector) set(shards []biz.LockWaitSnapshot, timeWheel biz.LockWaitSnapshot) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shards, c.wheelWaits = shards, timeWheel
+}
+
+func (c *lockWaitCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.shard
+	ch <- c.timeWheel
+}
+
+func (c *lockWaitCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	shards, timeWheel := c.shards, c.wheelWaits
+	c.mu.Unlock()
+	if shards == nil {
+		return
+	}
+	for i, s := range shards {
+		ch <- lockWaitHistogram(c.shard, s, strconv.Itoa(i))
+	}
+	ch <- lockWaitHistogram(c.timeWheel, timeWheel)
+}
+
+// lockWaitHistogram converts a snapshot, whose counts are per bucket, into a
+// Prometheus histogram with cumulative bucket counts.
+func lockWaitHistogram(desc *prometheus.Desc, s biz.LockWaitSnapshot, labels ...string) prometheus.Metric {
+	buckets := make(map[float64]uint64, len(s.Buckets))
+	var cumulative uint64
+	for i, bound := range s.Buckets {
+		cumulative += s.Counts[i]
+		buckets[bound.Seconds()] = cumulative
+	}
+	return prometheus.MustNewConstHistogram(desc, s.Count, s.Sum.Seconds(), buckets, labels...)
+}
@@ -0,0 +1,507 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"gocache-service/internal/biz"
+	"gocache-service/internal/conf"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultRESPAddr = "0.0.0.0:6380"
+	// respMaxBulk and respMaxArgs bound what a single command may allocate, like
+	// Redis' proto-max-bulk-len.
+	respMaxBulk = 512 << 20
+	respMaxArgs = 1 << 20
+	// respBufferSize is also the longest line accepted, so inline commands and
+	// length headers cannot grow without bound.
+	respBufferSize = 64 << 10
+)
+
+// errProtocol means the client sent something that is not RESP; the
+// connection is closed after replying, as Redis does.
+var errProtocol = errors.New("Protocol error")
+
+// RESPServer serves a subset of the Redis RESP2 protocol on top of the cache
+// usecase so existing Redis clients can be pointed at it. Commands on a
+// connection are executed in order, replies to pipelined commands are flushed
+// together.
+type RESPServer struct {
+	enabled bool
+	addr    string
+	uc      *biz.GoCacheUsecase
+	log     *log.Helper
+
+	mu     sync.Mutex
+	lis    net.Listener
+	conns  map[net.Conn]struct{}
+	closed bool
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewRESPServer new a RESP server, it does nothing unless server.resp.enabled is set.
+func NewRESPServer(c *conf.Server, uc *biz.GoCacheUsecase, logger log.Logger) *RESPServer {
+	s := &RESPServer{
+		enabled: c.GetResp().GetEnabled(),
+		addr:    c.GetResp().GetAddr(),
+		uc:      uc,
+		log:     log.NewHelper(logger),
+		conns:   make(map[net.Conn]struct{}),
+	}
+	if s.addr == "" {
+		s.addr = defaultRESPAddr
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	uc.SetRESPEnabled(s.enabled)
+	return s
+}
+
+// Start listens and serves connections until Stop is called.
+func (s *RESPServer) Start(ctx context.Context) error {
+	if !s.enabled {
+		return nil
+	}
+	lis, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return lis.Close()
+	}
+	s.lis = lis
+	s.mu.Unlock()
+	s.log.Infof("[RESP] server listening on: %s", lis.Addr().String())
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+			if closed {
+				return nil
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			return err
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			return nil
+		}
+		s.conns[conn] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+		go s.serve(conn)
+	}
+}
+
+// Stop closes the listener and all client connections and waits for the
+// commands in flight to finish.
+func (s *RESPServer) Stop(ctx context.Context) error {
+	if !s.enabled {
+		return nil
+	}
+	s.log.Info("[RESP] server stopping")
+	s.mu.Lock()
+	s.closed = true
+	s.cancel()
+	if s.lis != nil {
+		s.lis.Close()
+	}
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+	return nil
+}
+
+func (s *RESPServer) serve(conn net.Conn) {
+	defer func() {
+		// a bug triggered by one client must not take the whole process down
+		if r := recover(); r != nil {
+			s.log.Errorf("[RESP] panic serving %s: %v\n%s", conn.RemoteAddr(), r, debug.Stack())
+		}
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+		conn.Close()
+		s.wg.Done()
+	}()
+	r := bufio.NewReaderSize(conn, respBufferSize)
+	w := &respWriter{bufio.NewWriter(conn)}
+	for {
+		args, err := readCommand(r)
+		if errors.Is(err, errProtocol) {
+			w.error("ERR " + err.Error())
+			w.Flush()
+			return
+		}
+		if err != nil {
+			return
+		}
+		if len(args) > 0 {
+			if quit := s.exec(w, args); quit {
+				w.Flush()
+				return
+			}
+		}
+		// flush once the pipelined commands already received are answered
+		if r.Buffered() == 0 {
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// respCommand describes a supported command. arity counts the command name;
+// a negative arity is a minimum, as in Redis' COMMAND output.
+type respCommand struct {
+	arity   int
+	handler func(s *RESPServer, ctx context.Context, w *respWriter, args []string)
+}
+
+var respCommands = map[string]respCommand{
+	"PING":      {-1, (*RESPServer).ping},
+	"GET":       {2, (*RESPServer).get},
+	"SET":       {-3, (*RESPServer).set},
+	"DEL":       {-2, (*RESPServer).del},
+	"EXISTS":    {-2, (*RESPServer).exists},
+	"INCR":      {2, (*RESPServer).incr},
+	"TTL":       {2, (*RESPServer).ttl},
+	"EXPIRE":    {3, (*RESPServer).expire},
+	"INFO":      {-1, (*RESPServer).info},
+	"DBSIZE":    {1, (*RESPServer).dbsize},
+	"TYPE":      {2, (*RESPServer).typ},
+	"RANDOMKEY": {1, (*RESPServer).randomKey},
+}
+
+// exec runs one command and reports whether the client asked to close the connection.
+func (s *RESPServer) exec(w *respWriter, args []string) bool {
+	name := strings.ToUpper(args[0])
+	if name == "QUIT" {
+		w.simple("OK")
+		return true
+	}
+	cmd, ok := respCommands[name]
+	if !ok {
+		w.error(fmt.Sprintf("ERR unknown command '%s', with args beginning with: %s", args[0], quoteArgs(args[1:])))
+		return false
+	}
+	if (cmd.arity > 0 && len(args) != cmd.arity) || (cmd.arity < 0 && len(args) < -cmd.arity) {
+		w.error(fmt.Sprintf("ERR wrong number of arguments for '%s' command", strings.ToLower(name)))
+		return false
+	}
+	cmd.handler(s, s.ctx, w, args[1:])
+	return false
+}
+
+func (s *RESPServer) ping(ctx context.Context, w *respWriter, args []string) {
+	switch len(args) {
+	case 0:
+		w.simple("PONG")
+	case 1:
+		w.bulk(args[0])
+	default:
+		w.error("ERR wrong number of arguments for 'ping' command")
+	}
+}
+
+func (s *RESPServer) get(ctx context.Context, w *respWriter, args []string) {
+	value, err := s.uc.Get(ctx, args[0])
+	if errors.Is(err, biz.ErrKeyNotFound) {
+		w.null()
+		return
+	}
+	if err != nil {
+		w.cacheError(err)
+		return
+	}
+	w.bulk(value)
+}
+
+// set supports SET key value [EX seconds | PX milliseconds] [NX].
+func (s *RESPServer) set(ctx context.Context, w *respWriter, args []string) {
+	key, value := args[0], args[1]
+	var ttl time.Duration
+	var nx, hasTTL bool
+	for i := 2; i < len(args); i++ {
+		switch opt := strings.ToUpper(args[i]); {
+		case opt == "NX":
+			nx = true
+		case (opt == "EX" || opt == "PX") && !hasTTL && i+1 < len(args):
+			n, err := strconv.ParseInt(args[i+1], 10, 64)
+			if err != nil {
+				w.error("ERR value is not an integer or out of range")
+				return
+			}
+			if n <= 0 {
+				w.error("ERR invalid expire time in 'set' command")
+				return
+			}
+			unit := time.Second
+			if opt == "PX" {
+				unit = time.Millisecond
+			}
+			ttl, hasTTL = time.Duration(n)*unit, true
+			i++
+		default:
+			w.error("ERR syntax error")
+			return
+		}
+	}
+	if nx {
+		ok, err := s.uc.SetNX(ctx, key, value, ttl)
+		switch {
+		case err != nil:
+			w.cacheError(err)
+		case ok:
+			w.simple("OK")
+		default:
+			w.null()
+		}
+		return
+	}
+	if err := s.uc.SetBytes(ctx, key, []byte(value), ttl); err != nil {
+		w.cacheError(err)
+		return
+	}
+	w.simple("OK")
+}
+
+func (s *RESPServer) del(ctx context.Context, w *respWriter, args []string) {
+	deleted, err := s.uc.MDel(ctx, args)
+	if err != nil {
+		w.cacheError(err)
+		return
+	}
+	w.integer(int64(deleted))
+}
+
+func (s *RESPServer) exists(ctx context.Context, w *respWriter, args []string) {
+	count, err := s.uc.Exists(ctx, args...)
+	if err != nil {
+		w.cacheError(err)
+		return
+	}
+	w.integer(count)
+}
+
+func (s *RESPServer) incr(ctx context.Context, w *respWriter, args []string) {
+	n, err := s.uc.Incr(ctx, args[0], 1)
+	if err != nil {
+		w.cacheError(err)
+		return
+	}
+	w.integer(n)
+}
+
+func (s *RESPServer) ttl(ctx context.Context, w *respWriter, args []string) {
+	ttl, err := s.uc.TTL(ctx, args[0])
+	switch {
+	case errors.Is(err, biz.ErrKeyNotFound):
+		w.integer(-2)
+	case err != nil:
+		w.cacheError(err)
+	case ttl == biz.NoExpiration:
+		w.integer(-1)
+	default:
+		w.integer(int64((ttl + 500*time.Millisecond) / time.Second))
+	}
+}
+
+func (s *RESPServer) expire(ctx context.Context, w *respWriter, args []string) {
+	seconds, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		w.error("ERR value is not an integer or out of range")
+		return
+	}
+	updated, err := s.uc.Expire(ctx, args[0], time.Duration(seconds)*time.Second)
+	if err != nil {
+		w.cacheError(err)
+		return
+	}
+	if updated {
+		w.integer(1)
+	} else {
+		w.integer(0)
+	}
+}
+
+func (s *RESPServer) dbsize(ctx context.Context, w *respWriter, args []string) {
+	keys, _ := s.uc.DBSize(ctx)
+	w.integer(keys)
+}
+
+func (s *RESPServer) typ(ctx context.Context, w *respWriter, args []string) {
+	typ, err := s.uc.Type(ctx, args[0])
+	switch {
+	case errors.Is(err, biz.ErrKeyNotFound):
+		w.simple("none")
+	case err != nil:
+		w.cacheError(err)
+	default:
+		w.simple(typ)
+	}
+}
+
+func (s *RESPServer) randomKey(ctx context.Context, w *respWriter, args []string) {
+	key, err := s.uc.RandomKey(ctx)
+	switch {
+	case errors.Is(err, biz.ErrKeyNotFound):
+		w.null()
+	case err != nil:
+		w.cacheError(err)
+	default:
+		w.bulk(key)
+	}
+}
+
+func (s *RESPServer) info(ctx context.Context, w *respWriter, args []string) {
+	stats, err := s.uc.Stats(ctx)
+	if err != nil {
+		w.cacheError(err)
+		return
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Server\r\nredis_mode:standalone\r\nuptime_in_seconds:%d\r\n", int64(stats.Uptime.Seconds()))
+	fmt.Fprintf(&b, "\r\n# Memory\r\nused_memory:%d\r\nmaxmemory:%d\r\nmaxmemory_policy:%s\r\n",
+		stats.Eviction.UsedBytes, stats.Eviction.MaxBytes, stats.Eviction.Policy)
+	fmt.Fprintf(&b, "\r\n# Stats\r\nkeyspace_hits:%d\r\nkeyspace_misses:%d\r\nexpired_keys:%d\r\nevicted_keys:%d\r\n",
+		stats.Hits, stats.Misses, stats.Expired, stats.Eviction.Evicted)
+	fmt.Fprintf(&b, "\r\n# Keyspace\r\ndb0:keys=%d\r\n", stats.Keys)
+	w.bulk(b.String())
+}
+
+// readCommand reads one command, either a RESP array of bulk strings or an
+// inline command separated by spaces. An empty inline line or a null array
+// yields no arguments.
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 || line[0] != '*' {
+		return strings.Fields(line), nil
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n < -1 || n > respMaxArgs {
+		return nil, fmt.Errorf("%w: invalid multibulk length", errProtocol)
+	}
+	if n == -1 {
+		// a null array carries no command
+		return nil, nil
+	}
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		line, err := readLine(r)
+		if err != nil {
+			return nil, err
+		}
+		if len(line) == 0 || line[0] != '$' {
+			return nil, fmt.Errorf("%w: expected '$', got '%.1s'", errProtocol, line)
+		}
+		size, err := strconv.Atoi(line[1:])
+		if err != nil || size < 0 || size > respMaxBulk {
+			return nil, fmt.Errorf("%w: invalid bulk length", errProtocol)
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		if buf[size] != '\r' || buf[size+1] != '\n' {
+			return nil, fmt.Errorf("%w: bulk string not terminated by CRLF", errProtocol)
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
+
+// readLine reads a line without its trailing CRLF, lines longer than the
+// reader's buffer are a protocol error.
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadSlice('\n')
+	if errors.Is(err, bufio.ErrBufferFull) {
+		return "", fmt.Errorf("%w: too big line", errProtocol)
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(line), "\r\n"), nil
+}
+
+func quoteArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = "'" + arg + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+// respWriter writes RESP2 replies into a buffered connection.
+type respWriter struct {
+	*bufio.Writer
+}
+
+func (w *respWriter) simple(s string) {
+	w.WriteString("+" + s + "\r\n")
+}
+
+// error writes an error reply; msg must start with an error code such as ERR.
+func (w *respWriter) error(msg string) {
+	w.WriteString("-" + strings.NewReplacer("\r", " ", "\n", " ").Replace(msg) + "\r\n")
+}
+
+func (w *respWriter) integer(n int64) {
+	w.WriteString(":" + strconv.FormatInt(n, 10) + "\r\n")
+}
+
+func (w *respWriter) bulk(s string) {
+	w.WriteString("$" + strconv.Itoa(len(s)) + "\r\n")
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
+
+func (w *respWriter) null() {
+	w.WriteString("$-1\r\n")
+}
+
+// cacheError maps usecase errors to Redis error codes where one exists.
+func (w *respWriter) cacheError(err error) {
+	e := kerrors.FromError(err)
+	switch e.Reason {
+	case "WRONG_TYPE":
+		w.error("WRONGTYPE Operation against a key holding the wrong kind of value")
+	case "NOT_AN_INTEGER":
+		w.error("ERR value is not an integer or out of range")
+	case "CACHE_FULL":
+		w.error("OOM " + e.Message)
+	case "READ_ONLY_REPLICA":
+		w.error("READONLY You can't write against a read only replica.")
+	default:
+		w.error("ERR " + e.Message)
+	}
+}
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gocache-service/internal/biz"
+	"gocache-service/internal/conf"
+	"gocache-service/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// startRESPServer starts a RESP server on a free port over a cache in a
+// temporary directory and returns its address.
+func startRESPServer(t *testing.T) string {
+	t.Helper()
+	logger := log.NewStdLogger(io.Discard)
+	dc := &conf.Data{Cache: &conf.Data_Cache{DataFile: filepath.Join(t.TempDir(), "cache.aof")}}
+	repo, err := data.NewCacheRepo(dc, &data.Data{}, nil, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uc, _, err := biz.NewGoCacheUsecase(dc, repo, nil, nil, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewRESPServer(&conf.Server{Resp: &conf.Server_RESP{Enabled: true, Addr: "127.0.0.1:0"}}, uc, logger)
+	errc := make(chan error, 1)
+	go func() { errc <- s.Start(context.Background()) }()
+	var addr string
+	for deadline := time.Now().Add(5 * time.Second); addr == ""; {
+		s.mu.Lock()
+		if s.lis != nil {
+			addr = s.lis.Addr().String()
+		}
+		s.mu.Unlock()
+		select {
+		case err := <-errc:
+			t.Fatalf("Start: %v", err)
+		default:
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("RESP server did not start listening")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Cleanup(func() {
+		s.Stop(context.Background())
+		uc.Close(context.Background())
+	})
+	return addr
+}
+
+// startRESP starts a RESP server like startRESPServer and returns a go-redis
+// client connected to it.
+func startRESP(t *testing.T) *redis.Client {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: startRESPServer(t), Protocol: 2, DisableIndentity: true})
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// rawConn is a plain TCP connection to a RESP server that writes raw bytes
+// and reads replies one line at a time.
+type rawConn struct {
+	t *testing.T
+	net.Conn
+	r *bufio.Reader
+}
+
+func dialRESP(t *testing.T, addr string) *rawConn {
+	t.Helper()
+	conn, err := net.DialTimeout("tcp", addr, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+	return &rawConn{t: t, Conn: conn, r: bufio.NewReader(conn)}
+}
+
+func (c *rawConn) send(s string) {
+	c.t.Helper()
+	if _, err := io.WriteString(c, s); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// expect reads the next reply lines and compares them with want.
+func (c *rawConn) expect(want ...string) {
+	c.t.Helper()
+	for _, w := range want {
+		line, err := c.r.ReadString('\n')
+		if err != nil {
+			c.t.Fatalf("reading reply %q: %v", w, err)
+		}
+		if got := strings.TrimSuffix(line, "\r\n"); got != w {
+			c.t.Fatalf("reply = %q, want %q", got, w)
+		}
+	}
+}
+
+// expectClosed checks that the server closed the connection.
+func (c *rawConn) expectClosed() {
+	c.t.Helper()
+	if line, err := c.r.ReadString('\n'); err != io.EOF {
+		c.t.Fatalf("read %q, %v, want the connection closed", line, err)
+	}
+}
+
+func TestRESPGoRedisRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := startRESP(t)
+
+	if err := client.Ping(ctx).Err(); err != nil {
+		t.Fatalf("PING: %v", err)
+	}
+	if err := client.Set(ctx, "k", "v", time.Minute).Err(); err != nil {
+		t.Fatalf("SET: %v", err)
+	}
+	if v, err := client.Get(ctx, "k").Result(); err != nil || v != "v" {
+		t.Fatalf("GET = %q, %v, want v", v, err)
+	}
+	if ttl, err := client.TTL(ctx, "k").Result(); err != nil || ttl <= 0 || ttl > time.Minute {
+		t.Fatalf("TTL = %v, %v, want up to 1m", ttl, err)
+	}
+	if err := client.SetArgs(ctx, "k", "other", redis.SetArgs{Mode: "NX"}).Err(); err != redis.Nil {
+		t.Fatalf("SET NX on an existing key err = %v, want redis.Nil", err)
+	}
+	if n, err := client.Del(ctx, "k", "missing").Result(); err != nil || n != 1 {
+		t.Fatalf("DEL = %d, %v, want 1", n, err)
+	}
+	if err := client.Get(ctx, "k").Err(); err != redis.Nil {
+		t.Fatalf("GET after DEL err = %v, want redis.Nil", err)
+	}
+	if err := client.Do(ctx, "NOSUCHCOMMAND").Err(); err == nil {
+		t.Fatal("unknown command succeeded")
+	}
+}
+
+func TestRESPGoRedisPipeline(t *testing.T) {
+	ctx := context.Background()
+	client := startRESP(t)
+
+	pipe := client.Pipeline()
+	set := pipe.Set(ctx, "n", "1", 0)
+	incr := pipe.Incr(ctx, "n")
+	get := pipe.Get(ctx, "n")
+	del := pipe.Del(ctx, "n")
+	missing := pipe.Get(ctx, "n")
+	if _, err := pipe.Exec(ctx); err != redis.Nil {
+		t.Fatalf("Exec err = %v, want redis.Nil from the last GET", err)
+	}
+	if set.Err() != nil || incr.Val() != 2 || get.Val() != "2" || del.Val() != 1 || missing.Err() != redis.Nil {
+		t.Fatalf("pipeline replies out of order: %v %v %v %v %v", set, incr, get, del, missing)
+	}
+}
+
+func TestRESPRejectsMalformedLengths(t *testing.T) {
+	addr := startRESPServer(t)
+	for _, tc := range []struct {
+		name, input, reply string
+	}{
+		{"negative multibulk length", "*-5\r\n", "-ERR Protocol error: invalid multibulk length"},
+		{"multibulk length not a number", "*x\r\n", "-ERR Protocol error: invalid multibulk length"},
+		{"multibulk length too large", "*1048577\r\n", "-ERR Protocol error: invalid multibulk length"},
+		{"negative bulk length", "*1\r\n$-3\r\n", "-ERR Protocol error: invalid bulk length"},
+		{"null bulk string as argument", "*1\r\n$-1\r\n", "-ERR Protocol error: invalid bulk length"},
+		{"bulk length too large", "*1\r\n$536870913\r\n", "-ERR Protocol error: invalid bulk length"},
+		{"missing bulk header", "*1\r\nPING\r\n", "-ERR Protocol error: expected '$', got 'P'"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := dialRESP(t, addr)
+			c.send(tc.input)
+			c.expect(tc.reply)
+			c.expectClosed()
+		})
+	}
+
+	// the server keeps serving other clients, and a null array is ignored
+	c := dialRESP(t, addr)
+	c.send("*-1\r\n*1\r\n$4\r\nPING\r\n")
+	c.expect("+PONG")
+}
+
+func TestRESPRecoversFromPanicInCommand(t *testing.T) {
+	respCommands["PANIC"] = respCommand{1, func(*RESPServer, context.Context, *respWriter, []string) {
+		panic("boom")
+	}}
+	defer delete(respCommands, "PANIC")
+	addr := startRESPServer(t)
+
+	c := dialRESP(t, addr)
+	c.send("PANIC\r\n")
+	c.expectClosed()
+
+	c = dialRESP(t, addr)
+	c.send("PING\r\n")
+	c.expect("+PONG")
+}
+
+func TestRESPEmptyValueIsNotNil(t *testing.T) {
+	c := dialRESP(t, startRESPServer(t))
+	c.send("*3\r\n$3\r\nSET\r\n$1\r\nk\r\n$0\r\n\r\n")
+	c.expect("+OK")
+	// an empty bulk string for the stored "", a null bulk string only for a missing key
+	c.send("*2\r\n$3\r\nGET\r\n$1\r\nk\r\n*2\r\n$3\r\nGET\r\n$7\r\nmissing\r\n*3\r\n$6\r\nEXISTS\r\n$1\r\nk\r\n$7\r\nmissing\r\n")
+	c.expect("$0", "", "$-1", ":1")
+}
+
+func TestRESPRawClient(t *testing.T) {
+	c := dialRESP(t, startRESPServer(t))
+
+	c.send("*3\r\n$3\r\nSET\r\n$1\r\nk\r\n$5\r\nhello\r\n")
+	c.expect("+OK")
+	c.send("*2\r\n$3\r\nGET\r\n$1\r\nk\r\n")
+	c.expect("$5", "hello")
+
+	// inline commands, SET options and integer replies
+	c.send("SET k other NX\r\n")
+	c.expect("$-1")
+	c.send("SET n 41 EX 100\r\n")
+	c.expect("+OK")
+	c.send("INCR n\r\n")
+	c.expect(":42")
+	c.send("INCR k\r\n")
+	c.expect("-ERR value is not an integer or out of range")
+	c.send("INCR fresh\r\n")
+	c.expect(":1")
+	c.send("EXISTS k n fresh missing k\r\n")
+	c.expect(":4")
+	c.send("TTL n\r\nTTL k\r\nTTL missing\r\n")
+	c.expect(":100", ":-1", ":-2")
+
+	// pipelined commands in a single write are answered in order
+	c.send("*2\r\n$3\r\nDEL\r\n$1\r\nk\r\n*2\r\n$3\r\nGET\r\n$1\r\nk\r\n*2\r\n$6\r\nEXISTS\r\n$1\r\nk\r\n*2\r\n$3\r\nGET\r\n$1\r\nn\r\n")
+	c.expect(":1", "$-1", ":0", "$2", "42")
+}
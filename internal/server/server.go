@@ -1,8 +1,10 @@
 package server
 
 import (
+	"gocache-service/internal/biz"
+
 	"github.com/google/wire"
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewRESPServer, NewPrometheusMetrics, wire.Bind(new(biz.Metrics), new(*PrometheusMetrics)))
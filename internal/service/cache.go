@@ -2,10 +2,16 @@ package service
 
 import (
 	"context"
+	"io"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	v1 "gocache-service/api/cache/v1"
 	"gocache-service/internal/biz"
+
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/errors"
 )
 
 type CacheService struct {
@@ -18,20 +24,767 @@ func NewCacheService(uc *biz.GoCacheUsecase) *CacheService {
 }
 
 func (s *CacheService) SetString(ctx context.Context, req *v1.SetStringRequest) (*v1.SetStringResponse, error) {
-	ttl := time.Duration(req.TtlSeconds) * time.Second
-	err := s.uc.Set(ctx, req.Key, req.Value, ttl)
+	var err error
+	switch expiry := req.Expiry.(type) {
+	case *v1.SetStringRequest_ExpiresAtUnixMs:
+		var expiresAt time.Time
+		if expiry.ExpiresAtUnixMs != 0 {
+			expiresAt = time.UnixMilli(expiry.ExpiresAtUnixMs)
+		}
+		err = s.uc.SetWithExpiresAt(ctx, req.Key, req.Value, expiresAt)
+	default:
+		ttl := time.Duration(req.GetTtlSeconds()) * time.Second
+		err = s.uc.Set(ctx, req.Key, req.Value, ttl)
+	}
 	return &v1.SetStringResponse{}, err
 }
 
+func (s *CacheService) PSetString(ctx context.Context, req *v1.PSetStringRequest) (*v1.PSetStringResponse, error) {
+	ttl := time.Duration(req.TtlMillis) * time.Millisecond
+	err := s.uc.Set(ctx, req.Key, req.Value, ttl)
+	return &v1.PSetStringResponse{}, err
+}
+
+func (s *CacheService) SetStringNX(ctx context.Context, req *v1.SetStringNXRequest) (*v1.SetStringNXResponse, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	success, err := s.uc.SetNX(ctx, req.Key, req.Value, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SetStringNXResponse{Success: success}, nil
+}
+
+func (s *CacheService) SetStringIfNewer(ctx context.Context, req *v1.SetStringIfNewerRequest) (*v1.SetStringIfNewerResponse, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	applied, eventTime, err := s.uc.SetIfNewer(ctx, req.Key, req.Value, ttl, req.EventTimeMs)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SetStringIfNewerResponse{Applied: applied, EventTimeMs: eventTime}, nil
+}
+
 func (s *CacheService) GetString(ctx context.Context, req *v1.GetStringRequest) (*v1.GetStringResponse, error) {
-	val, err := s.uc.Get(ctx, req.Key)
+	var val string
+	var err error
+	if req.TouchTtlSeconds > 0 {
+		val, err = s.uc.GetAndTouch(ctx, req.Key, time.Duration(req.TouchTtlSeconds)*time.Second)
+	} else {
+		val, err = s.uc.Get(ctx, req.Key)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if !utf8.ValidString(val) {
+		return nil, biz.ErrNotUTF8
+	}
 	return &v1.GetStringResponse{Value: val}, nil
 }
 
+func (s *CacheService) GetStringWithVersion(ctx context.Context, req *v1.GetStringWithVersionRequest) (*v1.GetStringWithVersionResponse, error) {
+	val, version, err := s.uc.GetWithVersion(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.ValidString(val) {
+		return nil, biz.ErrNotUTF8
+	}
+	return &v1.GetStringWithVersionResponse{Value: val, Version: version}, nil
+}
+
+func (s *CacheService) SetStringIfVersion(ctx context.Context, req *v1.SetStringIfVersionRequest) (*v1.SetStringIfVersionResponse, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	applied, version, err := s.uc.SetIfVersion(ctx, req.Key, req.Value, req.ExpectedVersion, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SetStringIfVersionResponse{Applied: applied, Version: version}, nil
+}
+
+func (s *CacheService) SetBytes(ctx context.Context, req *v1.SetBytesRequest) (*v1.SetBytesResponse, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	err := s.uc.SetBytes(ctx, req.Key, req.Value, ttl)
+	return &v1.SetBytesResponse{}, err
+}
+
+func (s *CacheService) GetBytes(ctx context.Context, req *v1.GetBytesRequest) (*v1.GetBytesResponse, error) {
+	val, err := s.uc.GetBytes(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetBytesResponse{Value: val}, nil
+}
+
 func (s *CacheService) DelString(ctx context.Context, req *v1.DelStringRequest) (*v1.DelStringResponse, error) {
 	err := s.uc.Delete(ctx, req.Key)
 	return &v1.DelStringResponse{}, err
 }
+
+func (s *CacheService) CompareAndDelete(ctx context.Context, req *v1.CompareAndDeleteRequest) (*v1.CompareAndDeleteResponse, error) {
+	deleted, err := s.uc.CompareAndDelete(ctx, req.Key, req.ExpectedValue)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CompareAndDeleteResponse{Deleted: deleted}, nil
+}
+
+func (s *CacheService) CompareAndSwap(ctx context.Context, req *v1.CompareAndSwapRequest) (*v1.CompareAndSwapResponse, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	swap := s.uc.CompareAndSwap
+	if req.MissingAsEmpty {
+		swap = s.uc.CompareAndSwapMissingAsEmpty
+	}
+	swapped, err := swap(ctx, req.Key, req.ExpectedValue, req.Value, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CompareAndSwapResponse{Swapped: swapped}, nil
+}
+
+func (s *CacheService) Append(ctx context.Context, req *v1.AppendRequest) (*v1.AppendResponse, error) {
+	n, err := s.uc.Append(ctx, req.Key, req.Suffix, time.Duration(req.TtlSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AppendResponse{Length: int64(n)}, nil
+}
+
+func (s *CacheService) Strlen(ctx context.Context, req *v1.StrlenRequest) (*v1.StrlenResponse, error) {
+	n, err := s.uc.Strlen(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.StrlenResponse{Length: int64(n)}, nil
+}
+
+func (s *CacheService) GetDel(ctx context.Context, req *v1.GetDelRequest) (*v1.GetDelResponse, error) {
+	val, err := s.uc.GetDel(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.ValidString(val) {
+		return nil, biz.ErrNotUTF8
+	}
+	return &v1.GetDelResponse{Value: val}, nil
+}
+
+func (s *CacheService) GetSet(ctx context.Context, req *v1.GetSetRequest) (*v1.GetSetResponse, error) {
+	val, err := s.uc.GetSet(ctx, req.Key, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.ValidString(val) {
+		return nil, biz.ErrNotUTF8
+	}
+	return &v1.GetSetResponse{Value: val}, nil
+}
+
+func (s *CacheService) GetEx(ctx context.Context, req *v1.GetExRequest) (*v1.GetExResponse, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	val, err := s.uc.GetEx(ctx, req.Key, ttl)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.ValidString(val) {
+		return nil, biz.ErrNotUTF8
+	}
+	return &v1.GetExResponse{Value: val}, nil
+}
+
+func (s *CacheService) MSet(ctx context.Context, req *v1.MSetRequest) (*v1.MSetResponse, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	skipped, err := s.uc.MSet(ctx, req.Items, ttl, req.EventTimesMs)
+	return &v1.MSetResponse{Skipped: skipped}, err
+}
+
+func (s *CacheService) MGet(ctx context.Context, req *v1.MGetRequest) (*v1.MGetResponse, error) {
+	items, err := s.uc.MGet(ctx, req.Keys)
+	if err != nil {
+		return nil, err
+	}
+	for _, val := range items {
+		if !utf8.ValidString(val) {
+			return nil, biz.ErrNotUTF8
+		}
+	}
+	// entries 按请求的顺序列出每个键，found 区分空字符串和不存在的键
+	entries := make([]*v1.MGetEntry, len(req.Keys))
+	for i, key := range req.Keys {
+		val, found := items[key]
+		entries[i] = &v1.MGetEntry{Key: key, Value: val, Found: found}
+	}
+	return &v1.MGetResponse{Items: items, Entries: entries}, nil
+}
+
+func (s *CacheService) Exists(ctx context.Context, req *v1.ExistsRequest) (*v1.ExistsResponse, error) {
+	count, err := s.uc.Exists(ctx, req.Keys...)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ExistsResponse{Count: count}, nil
+}
+
+func (s *CacheService) MDel(ctx context.Context, req *v1.MDelRequest) (*v1.MDelResponse, error) {
+	deleted, err := s.uc.MDel(ctx, req.Keys)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MDelResponse{Deleted: int64(deleted)}, nil
+}
+
+func (s *CacheService) Exec(ctx context.Context, req *v1.ExecRequest) (*v1.ExecResponse, error) {
+	cmds := make([]biz.Command, len(req.Commands))
+	for i, cmd := range req.Commands {
+		cmds[i] = biz.Command{
+			Op:    strings.ToUpper(cmd.Op),
+			Key:   cmd.Key,
+			Value: cmd.Value,
+			TTL:   time.Duration(cmd.TtlSeconds) * time.Second,
+		}
+	}
+	results, err := s.uc.Pipeline(ctx, cmds)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ExecResponse{Results: make([]*v1.PipelineResult, len(results))}
+	for i, result := range results {
+		reply.Results[i] = &v1.PipelineResult{Ok: result.OK}
+		if result.Err != nil {
+			e := errors.FromError(result.Err)
+			reply.Results[i].Reason, reply.Results[i].Message = e.Reason, e.Message
+		}
+	}
+	return reply, nil
+}
+
+func (s *CacheService) Replicate(req *v1.ReplicateRequest, stream v1.CacheService_ReplicateServer) error {
+	return s.uc.Replicate(stream.Context(), req.ReplicationId, req.Offset, func(ev biz.ReplicationEvent) error {
+		reply := &v1.ReplicateEvent{ReplicationId: ev.ReplicationID, Offset: ev.Offset, FullSync: ev.FullSync}
+		if ev.Command != nil {
+			reply.Command = biz.EncodeCommand(*ev.Command)
+		}
+		return stream.Send(reply)
+	})
+}
+
+func (s *CacheService) IncrBy(ctx context.Context, req *v1.IncrByRequest) (*v1.IncrByResponse, error) {
+	val, err := s.uc.Incr(ctx, req.Key, req.Delta)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.IncrByResponse{Value: val}, nil
+}
+
+func (s *CacheService) DecrBy(ctx context.Context, req *v1.DecrByRequest) (*v1.DecrByResponse, error) {
+	val, err := s.uc.Decr(ctx, req.Key, req.Delta)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.DecrByResponse{Value: val}, nil
+}
+
+func (s *CacheService) HSet(ctx context.Context, req *v1.HSetRequest) (*v1.HSetResponse, error) {
+	err := s.uc.HSet(ctx, req.Key, req.Field, req.Value)
+	return &v1.HSetResponse{}, err
+}
+
+func (s *CacheService) HGet(ctx context.Context, req *v1.HGetRequest) (*v1.HGetResponse, error) {
+	val, err := s.uc.HGet(ctx, req.Key, req.Field)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.HGetResponse{Value: val}, nil
+}
+
+func (s *CacheService) HDel(ctx context.Context, req *v1.HDelRequest) (*v1.HDelResponse, error) {
+	deleted, err := s.uc.HDel(ctx, req.Key, req.Field)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.HDelResponse{Deleted: deleted}, nil
+}
+
+func (s *CacheService) HGetAll(ctx context.Context, req *v1.HGetAllRequest) (*v1.HGetAllResponse, error) {
+	fields, err := s.uc.HGetAll(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.HGetAllResponse{Fields: fields}, nil
+}
+
+func (s *CacheService) HLen(ctx context.Context, req *v1.HLenRequest) (*v1.HLenResponse, error) {
+	n, err := s.uc.HLen(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.HLenResponse{Length: int64(n)}, nil
+}
+
+func (s *CacheService) LPush(ctx context.Context, req *v1.LPushRequest) (*v1.LPushResponse, error) {
+	n, err := s.uc.LPush(ctx, req.Key, req.Values...)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.LPushResponse{Length: int64(n)}, nil
+}
+
+func (s *CacheService) RPush(ctx context.Context, req *v1.RPushRequest) (*v1.RPushResponse, error) {
+	n, err := s.uc.RPush(ctx, req.Key, req.Values...)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RPushResponse{Length: int64(n)}, nil
+}
+
+func (s *CacheService) LPop(ctx context.Context, req *v1.LPopRequest) (*v1.LPopResponse, error) {
+	val, err := s.uc.LPop(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.LPopResponse{Value: val}, nil
+}
+
+func (s *CacheService) RPop(ctx context.Context, req *v1.RPopRequest) (*v1.RPopResponse, error) {
+	val, err := s.uc.RPop(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RPopResponse{Value: val}, nil
+}
+
+func (s *CacheService) LRange(ctx context.Context, req *v1.LRangeRequest) (*v1.LRangeResponse, error) {
+	values, err := s.uc.LRange(ctx, req.Key, req.Start, req.Stop)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.LRangeResponse{Values: values}, nil
+}
+
+func (s *CacheService) LLen(ctx context.Context, req *v1.LLenRequest) (*v1.LLenResponse, error) {
+	n, err := s.uc.LLen(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.LLenResponse{Length: int64(n)}, nil
+}
+
+func (s *CacheService) Keys(ctx context.Context, req *v1.KeysRequest) (*v1.KeysResponse, error) {
+	pattern := req.Pattern
+	if pattern == "" {
+		pattern = "*"
+	}
+	keys, err := s.uc.Keys(ctx, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.KeysResponse{Keys: keys}, nil
+}
+
+func (s *CacheService) Scan(ctx context.Context, req *v1.ScanRequest) (*v1.ScanResponse, error) {
+	pattern := req.Pattern
+	if pattern == "" {
+		pattern = "*"
+	}
+	keys, cursor, err := s.uc.Scan(ctx, req.Cursor, pattern, int(req.Count))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ScanResponse{Keys: keys, Cursor: cursor}, nil
+}
+
+// ScanStream 从 req.Cursor 开始逐页发送 Scan 的结果，直到遍历结束
+func (s *CacheService) ScanStream(req *v1.ScanRequest, stream v1.CacheService_ScanStreamServer) error {
+	ctx := stream.Context()
+	pattern := req.Pattern
+	if pattern == "" {
+		pattern = "*"
+	}
+	cursor := req.Cursor
+	for {
+		keys, next, err := s.uc.Scan(ctx, cursor, pattern, int(req.Count))
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 || next == 0 {
+			if err := stream.Send(&v1.ScanResponse{Keys: keys, Cursor: next}); err != nil {
+				return err
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+func (s *CacheService) WatchExpired(req *v1.WatchExpiredRequest, stream v1.CacheService_WatchExpiredServer) error {
+	pattern := req.Pattern
+	if pattern == "" {
+		pattern = "*"
+	}
+	watcher, err := s.uc.WatchExpired(pattern)
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events():
+			if !ok {
+				return nil
+			}
+			err := stream.Send(&v1.ExpiredEvent{Key: event.Key, ExpiresAtMs: event.ExpiresAt, Dropped: watcher.Dropped()})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *CacheService) StopStreams() {
+	s.uc.CloseWatchers()
+	s.uc.CloseReplication()
+}
+
+func (s *CacheService) RandomKey(ctx context.Context, req *v1.RandomKeyRequest) (*v1.RandomKeyResponse, error) {
+	key, err := s.uc.RandomKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RandomKeyResponse{Key: key}, nil
+}
+
+func (s *CacheService) Type(ctx context.Context, req *v1.TypeRequest) (*v1.TypeResponse, error) {
+	typ, err := s.uc.Type(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TypeResponse{Type: typ}, nil
+}
+
+func (s *CacheService) DBSize(ctx context.Context, req *v1.DBSizeRequest) (*v1.DBSizeResponse, error) {
+	keys, shardKeys := s.uc.DBSize(ctx)
+	return &v1.DBSizeResponse{Keys: keys, ShardKeys: shardKeys}, nil
+}
+
+func (s *CacheService) MemoryUsage(ctx context.Context, req *v1.MemoryUsageRequest) (*v1.MemoryUsageResponse, error) {
+	bytes, err := s.uc.MemoryUsage(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MemoryUsageResponse{Bytes: bytes}, nil
+}
+
+func (s *CacheService) GetTTL(ctx context.Context, req *v1.GetTTLRequest) (*v1.GetTTLResponse, error) {
+	ttl, err := s.uc.TTL(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ttl == biz.NoExpiration {
+		return &v1.GetTTLResponse{TtlSeconds: -1, Persistent: true}, nil
+	}
+	return &v1.GetTTLResponse{TtlSeconds: int64(ttl / time.Second)}, nil
+}
+
+func (s *CacheService) PTTL(ctx context.Context, req *v1.PTTLRequest) (*v1.PTTLResponse, error) {
+	ttl, err := s.uc.TTL(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ttl == biz.NoExpiration {
+		return &v1.PTTLResponse{TtlMillis: -1, Persistent: true}, nil
+	}
+	return &v1.PTTLResponse{TtlMillis: int64(ttl / time.Millisecond)}, nil
+}
+
+func (s *CacheService) Expire(ctx context.Context, req *v1.ExpireRequest) (*v1.ExpireResponse, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	updated, err := s.uc.Expire(ctx, req.Key, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ExpireResponse{Updated: updated}, nil
+}
+
+func (s *CacheService) Touch(ctx context.Context, req *v1.TouchRequest) (*v1.TouchResponse, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	touched, err := s.uc.Touch(ctx, req.Key, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TouchResponse{Touched: touched}, nil
+}
+
+func (s *CacheService) TouchKeys(ctx context.Context, req *v1.TouchKeysRequest) (*v1.TouchKeysResponse, error) {
+	count, err := s.uc.TouchKeys(ctx, req.Keys...)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TouchKeysResponse{Count: count}, nil
+}
+
+func (s *CacheService) Rename(ctx context.Context, req *v1.RenameRequest) (*v1.RenameResponse, error) {
+	err := s.uc.Rename(ctx, req.Key, req.NewKey)
+	return &v1.RenameResponse{}, err
+}
+
+func (s *CacheService) Persist(ctx context.Context, req *v1.PersistRequest) (*v1.PersistResponse, error) {
+	updated, err := s.uc.Persist(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PersistResponse{Updated: updated}, nil
+}
+
+func (s *CacheService) Pin(ctx context.Context, req *v1.PinRequest) (*v1.PinResponse, error) {
+	err := s.uc.Pin(ctx, req.Key, req.PinTtlOverride)
+	return &v1.PinResponse{}, err
+}
+
+func (s *CacheService) Unpin(ctx context.Context, req *v1.UnpinRequest) (*v1.UnpinResponse, error) {
+	err := s.uc.Unpin(ctx, req.Key)
+	return &v1.UnpinResponse{}, err
+}
+
+func (s *CacheService) ListPinned(ctx context.Context, req *v1.ListPinnedRequest) (*v1.ListPinnedResponse, error) {
+	pinned, err := s.uc.ListPinned(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListPinnedResponse{
+		Keys:  pinned.Keys,
+		Count: int64(len(pinned.Keys)),
+		Bytes: pinned.Bytes,
+	}, nil
+}
+
+func (s *CacheService) Stats(ctx context.Context, req *v1.StatsRequest) (*v1.StatsResponse, error) {
+	stats, err := s.uc.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.StatsResponse{
+		Keys:              int64(stats.Keys),
+		Hits:              stats.Hits,
+		Misses:            stats.Misses,
+		Expired:           stats.Expired,
+		AofFileSize:       stats.AOFFileSize,
+		AofQueueDepth:     int64(stats.AOFQueueDepth),
+		UptimeSeconds:     int64(stats.Uptime / time.Second),
+		AofWriteErrors:    stats.AOFWriteErrors,
+		AofQueueRejected:  stats.AOFQueueRejected,
+		AofReplayed:       int64(stats.AOFReplayed),
+		AofReplaySkipped:  int64(stats.AOFReplaySkipped),
+		Sets:              stats.Sets,
+		Deletes:           stats.Deletes,
+		HitRatio:          stats.HitRatio(),
+		PinnedKeys:        stats.PinnedKeys,
+		PinnedBytes:       stats.PinnedBytes,
+		TimeWheelLockWait: lockWaitStats(stats.TimeWheelLockWait),
+		Shards:            make([]*v1.ShardStats, 0, len(stats.Shards)),
+		Defrag: &v1.DefragStats{
+			Passes:           stats.Defrag.Passes,
+			ShardsRebuilt:    stats.Defrag.ShardsRebuilt,
+			ReclaimedBuckets: stats.Defrag.ReclaimedBuckets,
+		},
+		Eviction: &v1.EvictionStats{
+			Policy:    string(stats.Eviction.Policy),
+			MaxKeys:   int64(stats.Eviction.MaxKeys),
+			MaxBytes:  stats.Eviction.MaxBytes,
+			UsedBytes: stats.Eviction.UsedBytes,
+			Evicted:   stats.Eviction.Evicted,
+			Rejected:  stats.Eviction.Rejected,
+		},
+		Replication: &v1.ReplicationStats{
+			Role:            stats.Replication.Role,
+			ReplicationId:   stats.Replication.ReplicationID,
+			Offset:          stats.Replication.Offset,
+			Followers:       int64(stats.Replication.Followers),
+			LeaderConnected: stats.Replication.LeaderConnected,
+		},
+		ExpireSample: &v1.ExpireSampleStats{
+			Examined:    stats.ExpireSample.Examined,
+			Expired:     stats.ExpireSample.Expired,
+			TimeLimited: stats.ExpireSample.TimeLimited,
+		},
+		Disk: &v1.DiskStats{
+			State:                string(stats.Disk.State),
+			FreeBytes:            stats.Disk.FreeBytes,
+			TotalBytes:           stats.Disk.TotalBytes,
+			GrowthBytesPerSecond: stats.Disk.GrowthBytesPerSecond,
+			TimeToFullSeconds:    int64(stats.Disk.TimeToFull / time.Second),
+			Compactions:          stats.Disk.Compactions,
+			RejectedWrites:       stats.Disk.RejectedWrites,
+			DroppedCommands:      stats.Disk.DroppedCommands,
+		},
+	}
+	for _, shard := range stats.Shards {
+		reply.Shards = append(reply.Shards, &v1.ShardStats{
+			Keys:     int64(shard.Keys),
+			PeakKeys: int64(shard.PeakKeys),
+			LockWait: lockWaitStats(shard.LockWait),
+		})
+	}
+	return reply, nil
+}
+
+// lockWaitStats 把锁等待直方图转换为响应中的格式，时间单位为秒
+func lockWaitStats(s biz.LockWaitSnapshot) *v1.LockWaitStats {
+	reply := &v1.LockWaitStats{
+		BucketSeconds: make([]float64, 0, len(s.Buckets)),
+		Counts:        s.Counts,
+		Count:         s.Count,
+		SumSeconds:    s.Sum.Seconds(),
+	}
+	for _, bucket := range s.Buckets {
+		reply.BucketSeconds = append(reply.BucketSeconds, bucket.Seconds())
+	}
+	return reply
+}
+
+func (s *CacheService) Defrag(ctx context.Context, req *v1.DefragRequest) (*v1.DefragResponse, error) {
+	result, err := s.uc.Defrag(ctx, req.Force)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.DefragResponse{
+		ShardsRebuilt:    int64(result.ShardsRebuilt),
+		ReclaimedBuckets: result.ReclaimedBuckets,
+	}, nil
+}
+
+func (s *CacheService) RewriteAOF(ctx context.Context, req *v1.RewriteAOFRequest) (*v1.RewriteAOFResponse, error) {
+	result, err := s.uc.RewriteAOF(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RewriteAOFResponse{
+		Keys:    int64(result.Keys),
+		OldSize: result.OldSize,
+		NewSize: result.NewSize,
+	}, nil
+}
+
+func (s *CacheService) FlushAll(ctx context.Context, req *v1.FlushAllRequest) (*v1.FlushAllResponse, error) {
+	flushed, err := s.uc.FlushAll(ctx, req.IncludePinned)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.FlushAllResponse{Keys: int64(flushed)}, nil
+}
+
+func (s *CacheService) FlushByPrefix(ctx context.Context, req *v1.FlushByPrefixRequest) (*v1.FlushByPrefixResponse, error) {
+	flushed, err := s.uc.FlushByPrefix(ctx, req.Prefix, req.IncludePinned)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.FlushByPrefixResponse{Keys: int64(flushed)}, nil
+}
+
+func (s *CacheService) Save(ctx context.Context, req *v1.SaveRequest) (*v1.SaveResponse, error) {
+	result, err := s.uc.SaveSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SaveResponse{
+		Keys: int64(result.Keys),
+		Size: result.Size,
+	}, nil
+}
+
+func (s *CacheService) Export(req *v1.ExportRequest, stream v1.CacheService_ExportServer) error {
+	return s.uc.Export(stream.Context(), int(req.BatchSize), func(entries []biz.ExportEntry) error {
+		reply := &v1.ExportResponse{Entries: make([]*v1.ExportEntry, 0, len(entries))}
+		for _, e := range entries {
+			entry := &v1.ExportEntry{Key: e.Key, Value: []byte(e.Value), ExpiresAtMs: e.ExpiresAt, EventTimeMs: e.EventTime}
+			if e.Hash != nil {
+				entry.Hash = make(map[string][]byte, len(e.Hash))
+				for field, value := range e.Hash {
+					entry.Hash[field] = []byte(value)
+				}
+			}
+			for _, value := range e.List {
+				entry.List = append(entry.List, []byte(value))
+			}
+			reply.Entries = append(reply.Entries, entry)
+		}
+		return stream.Send(reply)
+	})
+}
+
+// Import 逐条读取客户端发送的批次并导入，每批按自己的 replace 合并或覆盖，客户端关闭发送后返回累计的计数
+func (s *CacheService) Import(stream v1.CacheService_ImportServer) error {
+	var total biz.ImportResult
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&v1.ImportResponse{
+				Imported:    int64(total.Imported),
+				Skipped:     int64(total.Skipped),
+				Overwritten: int64(total.Overwritten),
+			})
+		}
+		if err != nil {
+			return err
+		}
+		entries := make([]biz.ExportEntry, 0, len(req.Entries))
+		for _, e := range req.Entries {
+			entry := biz.ExportEntry{Key: e.Key, Value: string(e.Value), ExpiresAt: e.ExpiresAtMs, EventTime: e.EventTimeMs}
+			if len(e.Hash) > 0 {
+				entry.Hash = make(map[string]string, len(e.Hash))
+				for field, value := range e.Hash {
+					entry.Hash[field] = string(value)
+				}
+			}
+			for _, value := range e.List {
+				entry.List = append(entry.List, string(value))
+			}
+			entries = append(entries, entry)
+		}
+		result, err := s.uc.Import(stream.Context(), entries, req.Replace)
+		total.Imported += result.Imported
+		total.Skipped += result.Skipped
+		total.Overwritten += result.Overwritten
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *CacheService) TopKeys(ctx context.Context, req *v1.TopKeysRequest) (*v1.TopKeysResponse, error) {
+	stats, err := s.uc.TopKeys(ctx, int(req.N))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.TopKeysResponse{Keys: make([]*v1.KeyStat, 0, len(stats))}
+	for _, stat := range stats {
+		reply.Keys = append(reply.Keys, &v1.KeyStat{
+			Key:    stat.Key,
+			Hits:   stat.Hits,
+			Misses: stat.Misses,
+			Writes: stat.Writes,
+			Qps:    stat.QPS,
+		})
+	}
+	return reply, nil
+}
+
+func (s *CacheService) Capabilities(ctx context.Context, req *v1.CapabilitiesRequest) (*v1.CapabilitiesResponse, error) {
+	caps := s.uc.Capabilities()
+	reply := &v1.CapabilitiesResponse{
+		Features:   caps.Features,
+		AofFormats: caps.AOFFormats,
+		Role:       caps.Role,
+		Limits: &v1.CapabilityLimits{
+			MaxValueSize: caps.Limits.MaxValueSize,
+			MaxKeys:      int64(caps.Limits.MaxKeys),
+			MaxBytes:     caps.Limits.MaxBytes,
+		},
+	}
+	if app, ok := kratos.FromContext(ctx); ok {
+		reply.Version = app.Version()
+	}
+	return reply, nil
+}
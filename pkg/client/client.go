@@ -0,0 +1,202 @@
+// Package client is a gRPC client for the cache service that checks the
+// server's capabilities before using optional features.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "gocache-service/api/cache/v1"
+
+	kgrpc "github.com/go-kratos/kratos/v2/transport/grpc"
+	"google.golang.org/grpc"
+)
+
+// Optional features reported by the Capabilities RPC.
+const (
+	FeatureHash         = "hash"
+	FeatureList         = "list"
+	FeatureWatch        = "watch"
+	FeatureTransactions = "transactions"
+	FeatureReplication  = "replication"
+	FeatureSnapshot     = "snapshot"
+	FeatureHotKeys      = "hot_keys"
+)
+
+// ErrUnsupported is wrapped by the errors returned for calls that need a
+// feature the server does not report.
+var ErrUnsupported = errors.New("feature not supported by server")
+
+// Client fetches the server's capabilities once when it is created. Calls
+// that need an optional feature the server lacks fail locally with an error
+// wrapping ErrUnsupported instead of reaching the server; every other call
+// goes straight to the embedded CacheServiceClient.
+type Client struct {
+	v1.CacheServiceClient
+	caps *v1.CapabilitiesResponse
+	conn *grpc.ClientConn
+}
+
+// Dial connects to the server at endpoint without TLS and fetches its
+// capabilities. Close releases the connection.
+func Dial(ctx context.Context, endpoint string) (*Client, error) {
+	conn, err := kgrpc.DialInsecure(ctx, kgrpc.WithEndpoint(endpoint))
+	if err != nil {
+		return nil, err
+	}
+	c, err := New(ctx, v1.NewCacheServiceClient(conn))
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	c.conn = conn
+	return c, nil
+}
+
+// New wraps an existing CacheServiceClient and fetches the server's capabilities.
+func New(ctx context.Context, api v1.CacheServiceClient) (*Client, error) {
+	caps, err := api.Capabilities(ctx, &v1.CapabilitiesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("client: fetch capabilities: %w", err)
+	}
+	return &Client{CacheServiceClient: api, caps: caps}, nil
+}
+
+// Close closes the connection opened by Dial; it does nothing for clients
+// created with New.
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// ServerCapabilities returns the capabilities fetched when the client was created.
+func (c *Client) ServerCapabilities() *v1.CapabilitiesResponse {
+	return c.caps
+}
+
+// Supports reports whether the server enabled feature.
+func (c *Client) Supports(feature string) bool {
+	return c.caps.GetFeatures()[feature]
+}
+
+func (c *Client) require(feature string) error {
+	if !c.Supports(feature) {
+		return fmt.Errorf("client: server does not support %s: %w", feature, ErrUnsupported)
+	}
+	return nil
+}
+
+func (c *Client) Exec(ctx context.Context, in *v1.ExecRequest, opts ...grpc.CallOption) (*v1.ExecResponse, error) {
+	if err := c.require(FeatureTransactions); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.Exec(ctx, in, opts...)
+}
+
+func (c *Client) Replicate(ctx context.Context, in *v1.ReplicateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.ReplicateEvent], error) {
+	if err := c.require(FeatureReplication); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.Replicate(ctx, in, opts...)
+}
+
+func (c *Client) HSet(ctx context.Context, in *v1.HSetRequest, opts ...grpc.CallOption) (*v1.HSetResponse, error) {
+	if err := c.require(FeatureHash); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.HSet(ctx, in, opts...)
+}
+
+func (c *Client) HGet(ctx context.Context, in *v1.HGetRequest, opts ...grpc.CallOption) (*v1.HGetResponse, error) {
+	if err := c.require(FeatureHash); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.HGet(ctx, in, opts...)
+}
+
+func (c *Client) HDel(ctx context.Context, in *v1.HDelRequest, opts ...grpc.CallOption) (*v1.HDelResponse, error) {
+	if err := c.require(FeatureHash); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.HDel(ctx, in, opts...)
+}
+
+func (c *Client) HGetAll(ctx context.Context, in *v1.HGetAllRequest, opts ...grpc.CallOption) (*v1.HGetAllResponse, error) {
+	if err := c.require(FeatureHash); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.HGetAll(ctx, in, opts...)
+}
+
+func (c *Client) HLen(ctx context.Context, in *v1.HLenRequest, opts ...grpc.CallOption) (*v1.HLenResponse, error) {
+	if err := c.require(FeatureHash); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.HLen(ctx, in, opts...)
+}
+
+func (c *Client) LPush(ctx context.Context, in *v1.LPushRequest, opts ...grpc.CallOption) (*v1.LPushResponse, error) {
+	if err := c.require(FeatureList); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.LPush(ctx, in, opts...)
+}
+
+func (c *Client) RPush(ctx context.Context, in *v1.RPushRequest, opts ...grpc.CallOption) (*v1.RPushResponse, error) {
+	if err := c.require(FeatureList); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.RPush(ctx, in, opts...)
+}
+
+func (c *Client) LPop(ctx context.Context, in *v1.LPopRequest, opts ...grpc.CallOption) (*v1.LPopResponse, error) {
+	if err := c.require(FeatureList); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.LPop(ctx, in, opts...)
+}
+
+func (c *Client) RPop(ctx context.Context, in *v1.RPopRequest, opts ...grpc.CallOption) (*v1.RPopResponse, error) {
+	if err := c.require(FeatureList); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.RPop(ctx, in, opts...)
+}
+
+func (c *Client) LRange(ctx context.Context, in *v1.LRangeRequest, opts ...grpc.CallOption) (*v1.LRangeResponse, error) {
+	if err := c.require(FeatureList); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.LRange(ctx, in, opts...)
+}
+
+func (c *Client) LLen(ctx context.Context, in *v1.LLenRequest, opts ...grpc.CallOption) (*v1.LLenResponse, error) {
+	if err := c.require(FeatureList); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.LLen(ctx, in, opts...)
+}
+
+func (c *Client) WatchExpired(ctx context.Context, in *v1.WatchExpiredRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.ExpiredEvent], error) {
+	if err := c.require(FeatureWatch); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.WatchExpired(ctx, in, opts...)
+}
+
+func (c *Client) Save(ctx context.Context, in *v1.SaveRequest, opts ...grpc.CallOption) (*v1.SaveResponse, error) {
+	if err := c.require(FeatureSnapshot); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.Save(ctx, in, opts...)
+}
+
+func (c *Client) TopKeys(ctx context.Context, in *v1.TopKeysRequest, opts ...grpc.CallOption) (*v1.TopKeysResponse, error) {
+	if err := c.require(FeatureHotKeys); err != nil {
+		return nil, err
+	}
+	return c.CacheServiceClient.TopKeys(ctx, in, opts...)
+}
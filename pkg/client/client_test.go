@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "gocache-service/api/cache/v1"
+
+	"google.golang.org/grpc"
+)
+
+// fakeServer answers Capabilities with features and counts the calls that reach it.
+type fakeServer struct {
+	v1.CacheServiceClient
+	features map[string]bool
+	calls    int
+}
+
+func (f *fakeServer) Capabilities(context.Context, *v1.CapabilitiesRequest, ...grpc.CallOption) (*v1.CapabilitiesResponse, error) {
+	return &v1.CapabilitiesResponse{Features: f.features}, nil
+}
+
+func (f *fakeServer) HSet(context.Context, *v1.HSetRequest, ...grpc.CallOption) (*v1.HSetResponse, error) {
+	f.calls++
+	return &v1.HSetResponse{}, nil
+}
+
+func (f *fakeServer) TopKeys(context.Context, *v1.TopKeysRequest, ...grpc.CallOption) (*v1.TopKeysResponse, error) {
+	f.calls++
+	return &v1.TopKeysResponse{}, nil
+}
+
+func TestClientGatesUnsupportedFeatures(t *testing.T) {
+	ctx := context.Background()
+	server := &fakeServer{features: map[string]bool{FeatureHash: true, FeatureHotKeys: false}}
+	c, err := New(ctx, server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Supports(FeatureHash) || c.Supports(FeatureHotKeys) || c.Supports("unknown") {
+		t.Fatalf("Supports does not match %v", server.features)
+	}
+	if _, err := c.HSet(ctx, &v1.HSetRequest{}); err != nil {
+		t.Fatalf("HSet: %v", err)
+	}
+	_, err = c.TopKeys(ctx, &v1.TopKeysRequest{})
+	if !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("TopKeys err = %v, want ErrUnsupported", err)
+	}
+	if server.calls != 1 {
+		t.Fatalf("server saw %d calls, want only HSet", server.calls)
+	}
+}
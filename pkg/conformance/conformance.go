@@ -0,0 +1,306 @@
+// Package conformance runs the YAML conformance scenarios in test/conformance
+// against a cache service. Scenarios are data only: a new file needs no Go
+// code, and a failure names the file, the scenario and the diverging step.
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	_ "gocache-service/api/cache/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"gopkg.in/yaml.v3"
+)
+
+const serviceName = "cache.v1.CacheService"
+
+// maxPages bounds a paginated step so a cursor that never returns to 0 fails
+// instead of looping forever.
+const maxPages = 10000
+
+// Scenario is one conformance file: a named sequence of steps run in order.
+type Scenario struct {
+	Name  string `yaml:"name"`
+	Steps []Step `yaml:"steps"`
+}
+
+// Step is an RPC call with expectations, a sleep, a clock advance or a restart.
+type Step struct {
+	Call    string                 `yaml:"call"`
+	Request map[string]interface{} `yaml:"request"`
+	// Expect lists response fields (proto names) that must match; other fields are ignored.
+	Expect map[string]interface{} `yaml:"expect"`
+	// Error is the expected gRPC status code name, eg: NotFound.
+	Error string `yaml:"error"`
+	// Reason is the expected kratos error reason, eg: KEY_NOT_FOUND.
+	Reason string `yaml:"reason"`
+	// Paginate repeats the call until the cursor returns to 0.
+	Paginate *Paginate `yaml:"paginate"`
+	// Sleep waits in real time.
+	Sleep time.Duration `yaml:"sleep"`
+	// Advance moves the server's clock forward, see Runner.Advance.
+	Advance time.Duration `yaml:"advance"`
+	// Restart restarts the server from its persisted state, see Runner.Restart.
+	Restart bool `yaml:"restart"`
+}
+
+// Paginate describes a cursor-paginated call. The runner starts from the
+// cursor in the request (0 when absent), feeds each response's cursor into the
+// next request and stops when the server returns 0. An item returned twice is
+// a failure. Expect is matched against a response whose Collect field holds
+// every item gathered, sorted.
+type Paginate struct {
+	// Cursor is the request and response field holding the cursor.
+	Cursor string `yaml:"cursor"`
+	// Collect is the repeated response field gathered across pages.
+	Collect string `yaml:"collect"`
+	// MaxPage, when set, is the most items one page may hold.
+	MaxPage int `yaml:"max_page"`
+}
+
+// Runner runs scenarios over a connection to a cache service.
+type Runner struct {
+	Conn grpc.ClientConnInterface
+	// Advance moves the server's clock forward for advance steps. Servers on
+	// the real clock leave it nil, and the runner sleeps for the same time.
+	Advance func(time.Duration)
+	// Restart restarts the server from its persisted state for restart steps
+	// and returns a connection to the new instance. When it is nil, scenarios
+	// with restart steps fail with ErrSkipped before any step runs.
+	Restart func(ctx context.Context) (grpc.ClientConnInterface, error)
+
+	service protoreflect.ServiceDescriptor
+}
+
+// ErrSkipped is returned for scenarios the runner cannot perform.
+var ErrSkipped = stderrors.New("conformance: scenario needs restart steps and the runner cannot restart the server")
+
+// Files returns the scenario files in dir in the order they should run.
+func Files(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// RunFile runs every step of the scenario in file and returns the first
+// divergence, naming the step that caused it.
+func (r *Runner) RunFile(ctx context.Context, file string) error {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	var sc Scenario
+	if err := yaml.Unmarshal(raw, &sc); err != nil {
+		return fmt.Errorf("%s: %v", file, err)
+	}
+	for _, step := range sc.Steps {
+		if step.Restart && r.Restart == nil {
+			return fmt.Errorf("%s (%s): %w", file, sc.Name, ErrSkipped)
+		}
+	}
+	for i, step := range sc.Steps {
+		if err := r.runStep(ctx, step); err != nil {
+			return fmt.Errorf("%s (%s) step %d %s: %v", file, sc.Name, i+1, step.name(), err)
+		}
+	}
+	return nil
+}
+
+func (s Step) name() string {
+	switch {
+	case s.Call != "":
+		return s.Call
+	case s.Advance > 0:
+		return "advance " + s.Advance.String()
+	case s.Restart:
+		return "restart"
+	default:
+		return "sleep " + s.Sleep.String()
+	}
+}
+
+func (r *Runner) runStep(ctx context.Context, step Step) error {
+	if step.Restart {
+		conn, err := r.Restart(ctx)
+		if err != nil {
+			return err
+		}
+		r.Conn = conn
+		return nil
+	}
+	if step.Call == "" {
+		if step.Advance > 0 && r.Advance != nil {
+			r.Advance(step.Advance)
+		} else {
+			time.Sleep(step.Sleep + step.Advance)
+		}
+		return nil
+	}
+	if r.service == nil {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(serviceName)
+		if err != nil {
+			return err
+		}
+		r.service = desc.(protoreflect.ServiceDescriptor)
+	}
+	method := r.service.Methods().ByName(protoreflect.Name(step.Call))
+	if method == nil {
+		return fmt.Errorf("unknown method")
+	}
+	if step.Paginate != nil {
+		return r.paginate(ctx, method, step)
+	}
+	actual, err := r.invoke(ctx, method, step.Request)
+	if step.Error != "" || step.Reason != "" {
+		return expectError(step, err)
+	}
+	if err != nil {
+		return fmt.Errorf("unexpected error: %v", err)
+	}
+	return match("", step.Expect, actual)
+}
+
+// invoke calls method with request, given as proto field names and values,
+// and returns the response with every field populated.
+func (r *Runner) invoke(ctx context.Context, method protoreflect.MethodDescriptor, request map[string]interface{}) (map[string]interface{}, error) {
+	in := dynamicpb.NewMessage(method.Input())
+	out := dynamicpb.NewMessage(method.Output())
+	if request != nil {
+		body, err := json.Marshal(request)
+		if err != nil {
+			return nil, err
+		}
+		if err := protojson.Unmarshal(body, in); err != nil {
+			return nil, fmt.Errorf("bad request: %v", err)
+		}
+	}
+	fullMethod := fmt.Sprintf("/%s/%s", r.service.FullName(), method.Name())
+	if err := r.Conn.Invoke(ctx, fullMethod, in, out); err != nil {
+		return nil, err
+	}
+	body, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	var actual map[string]interface{}
+	if err := json.Unmarshal(body, &actual); err != nil {
+		return nil, err
+	}
+	return actual, nil
+}
+
+func (r *Runner) paginate(ctx context.Context, method protoreflect.MethodDescriptor, step Step) error {
+	p := step.Paginate
+	request := make(map[string]interface{}, len(step.Request)+1)
+	for k, v := range step.Request {
+		request[k] = v
+	}
+	seen := make(map[string]bool)
+	var items []string
+	for page := 1; ; page++ {
+		if page > maxPages {
+			return fmt.Errorf("cursor did not return to 0 after %d pages", maxPages)
+		}
+		actual, err := r.invoke(ctx, method, request)
+		if err != nil {
+			if step.Error != "" || step.Reason != "" {
+				return expectError(step, err)
+			}
+			return fmt.Errorf("page %d: unexpected error: %v", page, err)
+		}
+		got, ok := actual[p.Collect].([]interface{})
+		if !ok {
+			return fmt.Errorf("page %d: %s is not a list: %v", page, p.Collect, actual[p.Collect])
+		}
+		if p.MaxPage > 0 && len(got) > p.MaxPage {
+			return fmt.Errorf("page %d: %d items, want at most %d", page, len(got), p.MaxPage)
+		}
+		for _, item := range got {
+			key := fmt.Sprint(item)
+			if seen[key] {
+				return fmt.Errorf("page %d: %s returned twice", page, key)
+			}
+			seen[key] = true
+			items = append(items, key)
+		}
+		cursor := fmt.Sprint(actual[p.Cursor])
+		if cursor == "0" {
+			break
+		}
+		request[p.Cursor] = cursor
+	}
+	if step.Error != "" || step.Reason != "" {
+		return expectError(step, nil)
+	}
+	sort.Strings(items)
+	collected := make([]interface{}, len(items))
+	for i, item := range items {
+		collected[i] = item
+	}
+	return match("", step.Expect, map[string]interface{}{p.Collect: collected})
+}
+
+func expectError(step Step, err error) error {
+	if err == nil {
+		return fmt.Errorf("expected error %s%s, got success", step.Error, step.Reason)
+	}
+	if code := status.Code(err).String(); step.Error != "" && code != step.Error {
+		return fmt.Errorf("expected code %s, got %s (%v)", step.Error, code, err)
+	}
+	if reason := errors.FromError(err).Reason; step.Reason != "" && reason != step.Reason {
+		return fmt.Errorf("expected reason %s, got %s", step.Reason, reason)
+	}
+	return nil
+}
+
+// match checks that every expected field is present in actual with an equal value.
+// Scalars are compared by their printed form so that YAML ints and protojson's
+// string-encoded int64s compare equal.
+func match(path string, expected, actual interface{}) error {
+	switch want := expected.(type) {
+	case map[string]interface{}:
+		got, ok := actual.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected object, got %v", strings.TrimPrefix(path, "."), actual)
+		}
+		for k, v := range want {
+			if err := match(path+"."+k, v, got[k]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		got, ok := actual.([]interface{})
+		if !ok || len(got) != len(want) {
+			return fmt.Errorf("%s: expected %v, got %v", strings.TrimPrefix(path, "."), want, actual)
+		}
+		for i := range want {
+			if err := match(fmt.Sprintf("%s[%d]", path, i), want[i], got[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		if fmt.Sprint(expected) != fmt.Sprint(actual) {
+			return fmt.Errorf("%s: expected %v, got %v", strings.TrimPrefix(path, "."), expected, actual)
+		}
+		return nil
+	}
+}